@@ -0,0 +1,104 @@
+package v1alpha2
+
+import "sort"
+
+type AccessLogStrategy string
+
+const (
+	AccessLogStrategyMerge   AccessLogStrategy = "merge"
+	AccessLogStrategyReplace AccessLogStrategy = "replace"
+
+	DefaultAccessLogProviderName     = "kyma-default-logger"
+	DefaultOtelAccessLogProviderName = "kyma-default-otel-logger"
+
+	defaultOtelAccessLogService = "telemetry-otlp-logs.kyma-system.svc.cluster.local"
+	defaultOtelAccessLogPort    = 4317
+)
+
+// AccessLog defines the log format of the kyma-default-logger and kyma-default-otel-logger extension providers.
+type AccessLog struct {
+	// Defines how the labels are applied to the default log format. With "merge", the labels are added to the default labels
+	// and override the values of existing keys. With "replace", the labels replace all the default labels.
+	// If not specified, "merge" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=merge;replace
+	Strategy AccessLogStrategy `json:"strategy,omitempty"`
+
+	// Defines structured keys and their values included in the access log. Envoy command operators, such as "%REQ(X-TENANT)%", can be used as values.
+	// Keys with empty values are ignored. If no labels are specified, the default log format is used.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// EmptyLabelKeys returns the keys of labels that have an empty value and are ignored in the log format.
+func (a *AccessLog) EmptyLabelKeys() []string {
+	var keys []string
+	for k, v := range a.Labels {
+		if v == "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// LogFormatLabels returns the labels of the access log format, after applying the configured strategy to the default labels.
+func (a *AccessLog) LogFormatLabels() map[string]string {
+	labels := defaultAccessLogLabels()
+	if a == nil {
+		return labels
+	}
+
+	customLabels := make(map[string]string)
+	for k, v := range a.Labels {
+		if v != "" {
+			customLabels[k] = v
+		}
+	}
+
+	if len(customLabels) == 0 {
+		return labels
+	}
+
+	if a.Strategy == AccessLogStrategyReplace {
+		return customLabels
+	}
+
+	for k, v := range customLabels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// defaultAccessLogLabels returns the default log format of the kyma-default-logger and kyma-default-otel-logger extension providers.
+// The kyma-default-logger replaces the stdout-json extension provider, so this is the only definition of the default log format.
+func defaultAccessLogLabels() map[string]string {
+	return map[string]string{
+		"start_time":                        "%START_TIME%",
+		"method":                            "%REQ(:METHOD)%",
+		"path":                              "%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%",
+		"protocol":                          "%PROTOCOL%",
+		"response_code":                     "%RESPONSE_CODE%",
+		"response_flags":                    "%RESPONSE_FLAGS%",
+		"response_code_details":             "%RESPONSE_CODE_DETAILS%",
+		"connection_termination_details":    "%CONNECTION_TERMINATION_DETAILS%",
+		"upstream_transport_failure_reason": "%UPSTREAM_TRANSPORT_FAILURE_REASON%",
+		"bytes_received":                    "%BYTES_RECEIVED%",
+		"bytes_sent":                        "%BYTES_SENT%",
+		"duration":                          "%DURATION%",
+		"upstream_service_time":             "%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%",
+		"x_forwarded_for":                   "%REQ(X-FORWARDED-FOR)%",
+		"user_agent":                        "%REQ(USER-AGENT)%",
+		"request_id":                        "%REQ(X-REQUEST-ID)%",
+		"authority":                         "%REQ(:AUTHORITY)%",
+		"upstream_host":                     "%UPSTREAM_HOST%",
+		"upstream_cluster":                  "%UPSTREAM_CLUSTER%",
+		"upstream_local_address":            "%UPSTREAM_LOCAL_ADDRESS%",
+		"downstream_local_address":          "%DOWNSTREAM_LOCAL_ADDRESS%",
+		"downstream_remote_address":         "%DOWNSTREAM_REMOTE_ADDRESS%",
+		"requested_server_name":             "%REQUESTED_SERVER_NAME%",
+		"route_name":                        "%ROUTE_NAME%",
+		"traceparent":                       "%REQ(TRACEPARENT)%",
+		"tracestate":                        "%REQ(TRACESTATE)%",
+	}
+}
@@ -1,11 +1,26 @@
 package v1alpha2
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type AuthorizerProtocol string
+
+const (
+	AuthorizerProtocolHTTP AuthorizerProtocol = "HTTP"
+	AuthorizerProtocolGRPC AuthorizerProtocol = "GRPC"
+)
+
 type Authorizer struct {
 	// A unique name identifying the extension authorization provider.
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
-	// Specifies the service that implements the Envoy ext_authz HTTP authorization service.
+	// Specifies the protocol used to communicate with the authorization service. Valid values are "HTTP" and "GRPC".
+	// If not specified, "HTTP" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=HTTP;GRPC
+	Protocol AuthorizerProtocol `json:"protocol,omitempty"`
+
+	// Specifies the service that implements the Envoy ext_authz HTTP or gRPC authorization service.
 	// The format is "[<Namespace>/]<Hostname>".
 	// The specification of "<Namespace>"
 	// is required only when it is insufficient to unambiguously resolve a service in the service registry.
@@ -20,7 +35,59 @@ type Authorizer struct {
 	Port uint32 `json:"port"`
 
 	// Specifies headers to be included, added or forwarded during authorization.
+	// Applicable only to HTTP authorizers.
 	Headers *Headers `json:"headers,omitempty"`
+
+	// Specifies the prefix added to the value of the *Path* header in the authorization request.
+	// For example, setting this to "/auth" for an original request at path "/users" causes the
+	// authorization request to be sent to the authorization service at the path "/auth/users".
+	// If not specified, Istio's default is used.
+	// Applicable only to HTTP authorizers.
+	// +kubebuilder:validation:Optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+
+	// Specifies the maximum duration that the proxy waits for a response from the authorization service.
+	// The value must be a valid duration, for example "500ms", "10s" or "1m30s".
+	// If not specified, Istio's default timeout of 600s is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// If true, the request is allowed even if the communication with the authorization service has failed,
+	// or if the authorization service has returned an HTTP 5xx error.
+	// If not specified, the request is rejected.
+	// +kubebuilder:validation:Optional
+	FailOpen *bool `json:"failOpen,omitempty"`
+
+	// Sets the HTTP status that is returned to the client when there is a network error between the proxy and the authorization service.
+	// Has no effect if failOpen is enabled.
+	// If not specified, "403" (Forbidden) is returned.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[1-5][0-9]{2}$`
+	StatusOnError *string `json:"statusOnError,omitempty"`
+
+	// Specifies whether the body of the request is included in the authorization request.
+	// If not specified, the body is not sent to the authorization service.
+	// +kubebuilder:validation:Optional
+	IncludeRequestBodyInCheck *RequestBody `json:"includeRequestBodyInCheck,omitempty"`
+}
+
+// IsGRPC returns true if the authorizer uses the gRPC protocol.
+func (a *Authorizer) IsGRPC() bool {
+	return a.Protocol == AuthorizerProtocolGRPC
+}
+
+type RequestBody struct {
+	// Sets the maximum size of the request body, in bytes, that is buffered and sent to the authorization service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestBytes uint32 `json:"maxRequestBytes"`
+
+	// If true, only the first MaxRequestBytes of the body are sent to the authorization service when the body exceeds the limit.
+	// If false, requests with a body larger than MaxRequestBytes are rejected with HTTP 413.
+	// +kubebuilder:validation:Optional
+	AllowPartialMessage bool `json:"allowPartialMessage,omitempty"`
 }
 
 // Exact, prefix and suffix matches are supported (similar to the authorization policy rule syntax except the presence match
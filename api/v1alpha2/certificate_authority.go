@@ -0,0 +1,99 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+
+	iopv1alpha1 "istio.io/istio/operator/pkg/apis"
+	"istio.io/istio/operator/pkg/values"
+)
+
+const (
+	caAddressValuePath    = "global.caAddress"
+	enableCAServerEnvName = "ENABLE_CA_SERVER"
+)
+
+// CertificateAuthority defines the certificate authority that signs the workload certificates of the mesh. Either a plug-in CA
+// certificate stored in a Secret or an external CA can be used.
+// +kubebuilder:validation:XValidation:rule="has(self.secret) != has(self.caAddress)",message="exactly one of secret and caAddress must be set"
+type CertificateAuthority struct {
+	// References the Secret with the plug-in CA certificates. The Secret must contain the keys "ca-cert.pem", "ca-key.pem", "root-cert.pem"
+	// and "cert-chain.pem". The Istio module validates the certificates and copies them to the cacerts Secret in the istio-system namespace.
+	// +kubebuilder:validation:Optional
+	Secret *CASecretReference `json:"secret,omitempty"`
+
+	// Defines the address of an external CA, for example, cert-manager istio-csr. The CA server of istiod is disabled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	CAAddress *string `json:"caAddress,omitempty"`
+}
+
+type CASecretReference struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// PluginCASecret returns the reference to the Secret with the plug-in CA certificates, or nil if no plug-in CA is configured.
+func (c Config) PluginCASecret() *CASecretReference {
+	if c.CertificateAuthority == nil {
+		return nil
+	}
+	return c.CertificateAuthority.Secret
+}
+
+func (m *meshConfigBuilder) BuildTrustDomain(trustDomain *string, trustDomainAliases []string) *meshConfigBuilder {
+	if trustDomain != nil {
+		err := m.c.SetPath("trustDomain", *trustDomain)
+		if err != nil {
+			return nil
+		}
+	}
+
+	if len(trustDomainAliases) > 0 {
+		aliases := make([]interface{}, 0, len(trustDomainAliases))
+		for _, alias := range trustDomainAliases {
+			aliases = append(aliases, alias)
+		}
+		err := m.c.SetPath("trustDomainAliases", aliases)
+		if err != nil {
+			return nil
+		}
+	}
+
+	return m
+}
+
+// mergeCertificateAuthority configures istiod and the proxies to use the external CA. The plug-in CA needs no configuration,
+// because istiod uses the cacerts Secret in the istio-system namespace if it exists.
+func (i *Istio) mergeCertificateAuthority(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioOperator, error) {
+	ca := i.Spec.Config.CertificateAuthority
+	if ca == nil || ca.CAAddress == nil {
+		return op, nil
+	}
+
+	valuesMap, err := values.MapFromObject(op.Spec.Values)
+	if err != nil {
+		return op, err
+	}
+	if valuesMap == nil {
+		valuesMap = make(values.Map)
+	}
+	err = valuesMap.SetPath(caAddressValuePath, *ca.CAAddress)
+	if err != nil {
+		return op, err
+	}
+	op.Spec.Values, err = values.ConvertMap[json.RawMessage](valuesMap)
+	if err != nil {
+		return op, err
+	}
+
+	setPilotEnv(&op, enableCAServerEnvName, "false")
+
+	return op, nil
+}
@@ -21,21 +21,24 @@ func ConditionFromReason(reason ReasonWithMessage) *metav1.Condition {
 
 //nolint:gochecknoglobals // TODO: conditions should be defined as constant, not as a single map
 var conditionReasons = map[ConditionReason]conditionMeta{
-	ConditionReasonReconcileSucceeded: {Type: ConditionTypeReady, Status: metav1.ConditionTrue, Message: ConditionReasonReconcileSucceededMessage},
-	ConditionReasonReconcileFailed:    {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonReconcileFailedMessage},
-	ConditionReasonReconcileUnknown:   {Type: ConditionTypeReady, Status: metav1.ConditionUnknown, Message: ConditionReasonReconcileUnknownMessage},
-	ConditionReasonReconcileRequeued:  {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonReconcileRequeuedMessage},
-	ConditionReasonValidationFailed:   {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonValidationFailedMessage},
-	ConditionReasonOlderCRExists:      {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonOlderCRExistsMessage},
-	ConditionReasonOldestCRNotFound:   {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonOldestCRNotFoundMessage},
-
-	ConditionReasonIstioInstallNotNeeded:        {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioInstallNotNeededMessage},
-	ConditionReasonIstioInstallSucceeded:        {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioInstallSucceededMessage},
-	ConditionReasonIstioUninstallSucceeded:      {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioUninstallSucceededMessage},
-	ConditionReasonIstioInstallUninstallFailed:  {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioInstallUninstallFailedMessage},
-	ConditionReasonCustomResourceMisconfigured:  {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonCustomResourceMisconfiguredMessage},
-	ConditionReasonIstioCRsDangling:             {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioCRsDanglingMessage},
-	ConditionReasonIstioVersionUpdateNotAllowed: {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioVersionUpdateNotAllowedMessage},
+	ConditionReasonReconcileSucceeded:   {Type: ConditionTypeReady, Status: metav1.ConditionTrue, Message: ConditionReasonReconcileSucceededMessage},
+	ConditionReasonReconcileFailed:      {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonReconcileFailedMessage},
+	ConditionReasonReconcileUnknown:     {Type: ConditionTypeReady, Status: metav1.ConditionUnknown, Message: ConditionReasonReconcileUnknownMessage},
+	ConditionReasonReconcileRequeued:    {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonReconcileRequeuedMessage},
+	ConditionReasonValidationFailed:     {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonValidationFailedMessage},
+	ConditionReasonOlderCRExists:        {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonOlderCRExistsMessage},
+	ConditionReasonOldestCRNotFound:     {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonOldestCRNotFoundMessage},
+	ConditionReasonComponentsNotHealthy: {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonComponentsNotHealthyMessage},
+
+	ConditionReasonIstioInstallNotNeeded:             {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioInstallNotNeededMessage},
+	ConditionReasonIstioInstallSucceeded:             {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioInstallSucceededMessage},
+	ConditionReasonIstioUninstallSucceeded:           {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioUninstallSucceededMessage},
+	ConditionReasonIstioInstallUninstallFailed:       {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioInstallUninstallFailedMessage},
+	ConditionReasonCustomResourceMisconfigured:       {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonCustomResourceMisconfiguredMessage},
+	ConditionReasonIstioCRsDangling:                  {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioCRsDanglingMessage},
+	ConditionReasonIstioVersionUpdateNotAllowed:      {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioVersionUpdateNotAllowedMessage},
+	ConditionReasonIstioVersionChangePreflightFailed: {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonIstioVersionChangePreflightFailedMessage},
+	ConditionReasonPluginCAInvalid:                   {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonPluginCAInvalidMessage},
 
 	ConditionReasonCRsReconcileSucceeded: {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonCRsReconcileSucceededMessage},
 	ConditionReasonCRsReconcileFailed:    {Type: ConditionTypeReady, Status: metav1.ConditionFalse, Message: ConditionReasonCRsReconcileFailedMessage},
@@ -82,6 +85,47 @@ var conditionReasons = map[ConditionReason]conditionMeta{
 		Status:  metav1.ConditionUnknown,
 		Message: ConditionReasonIngressTargetingUserResourceDetectionFailedMessage,
 	},
+
+	ConditionReasonServiceEntriesNotFound: {
+		Type:    ConditionTypeOutboundTrafficBlocked,
+		Status:  metav1.ConditionTrue,
+		Message: ConditionReasonServiceEntriesNotFoundMessage,
+	},
+	ConditionReasonServiceEntriesFound: {
+		Type:    ConditionTypeOutboundTrafficBlocked,
+		Status:  metav1.ConditionFalse,
+		Message: ConditionReasonServiceEntriesFoundMessage,
+	},
+	ConditionReasonServiceEntriesDetectionFailed: {
+		Type:    ConditionTypeOutboundTrafficBlocked,
+		Status:  metav1.ConditionUnknown,
+		Message: ConditionReasonServiceEntriesDetectionFailedMessage,
+	},
+
+	ConditionReasonProviderServiceAnnotationsOverridden: {
+		Type:    ConditionTypeProviderServiceAnnotationsOverridden,
+		Status:  metav1.ConditionTrue,
+		Message: ConditionReasonProviderServiceAnnotationsOverriddenMessage,
+	},
+	ConditionReasonProviderServiceAnnotationsNotOverridden: {
+		Type:    ConditionTypeProviderServiceAnnotationsOverridden,
+		Status:  metav1.ConditionFalse,
+		Message: ConditionReasonProviderServiceAnnotationsNotOverriddenMessage,
+	},
+
+	ConditionReasonCanaryRevisionInstalled: {Type: ConditionTypeCanaryUpgrade, Status: metav1.ConditionFalse, Message: ConditionReasonCanaryRevisionInstalledMessage},
+	ConditionReasonCanaryNamespaceMigrated: {Type: ConditionTypeCanaryUpgrade, Status: metav1.ConditionFalse, Message: ConditionReasonCanaryNamespaceMigratedMessage},
+	ConditionReasonCanaryWaitingForProxies: {Type: ConditionTypeCanaryUpgrade, Status: metav1.ConditionFalse, Message: ConditionReasonCanaryWaitingForProxiesMessage},
+	ConditionReasonCanaryUpgradeSucceeded:  {Type: ConditionTypeCanaryUpgrade, Status: metav1.ConditionTrue, Message: ConditionReasonCanaryUpgradeSucceededMessage},
+
+	ConditionReasonCACertificatesValid:          {Type: ConditionTypeCACertificatesExpiring, Status: metav1.ConditionFalse, Message: ConditionReasonCACertificatesValidMessage},
+	ConditionReasonCACertificatesExpiringSoon:   {Type: ConditionTypeCACertificatesExpiring, Status: metav1.ConditionTrue, Message: ConditionReasonCACertificatesExpiringSoonMessage},
+	ConditionReasonCACertificatesExpiryCritical: {Type: ConditionTypeCACertificatesExpiring, Status: metav1.ConditionTrue, Message: ConditionReasonCACertificatesExpiryCriticalMessage},
+	ConditionReasonCACertificatesCheckFailed:    {Type: ConditionTypeCACertificatesExpiring, Status: metav1.ConditionUnknown, Message: ConditionReasonCACertificatesCheckFailedMessage},
+
+	ConditionReasonRootCARotationInProgress: {Type: ConditionTypeRootCARotation, Status: metav1.ConditionFalse, Message: ConditionReasonRootCARotationInProgressMessage},
+	ConditionReasonRootCARotationSucceeded:  {Type: ConditionTypeRootCARotation, Status: metav1.ConditionTrue, Message: ConditionReasonRootCARotationSucceededMessage},
+	ConditionReasonRootCARotationFailed:     {Type: ConditionTypeRootCARotation, Status: metav1.ConditionUnknown, Message: ConditionReasonRootCARotationFailedMessage},
 }
 
 type conditionMeta struct {
@@ -0,0 +1,5 @@
+package v1alpha2
+
+// Hub marks v1alpha2 as the version that the other versions of the Istio CR are converted to and from.
+// The reconciliation works on v1alpha2, so every version must be convertible to it without data loss.
+func (*Istio) Hub() {}
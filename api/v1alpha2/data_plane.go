@@ -0,0 +1,81 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+
+	iopv1alpha1 "istio.io/istio/operator/pkg/apis"
+	"istio.io/istio/operator/pkg/values"
+)
+
+type DataPlaneMode string
+
+const (
+	DataPlaneModeSidecar DataPlaneMode = "sidecar"
+	DataPlaneModeAmbient DataPlaneMode = "ambient"
+
+	// DataPlaneModeLabel is the label of namespaces and Pods that are part of the ambient mesh.
+	DataPlaneModeLabel = "istio.io/dataplane-mode"
+
+	ztunnelNamespace           = "istio-system"
+	enableAmbientEnvName       = "PILOT_ENABLE_AMBIENT"
+	proxyMetadataEnableHBONE   = "ISTIO_META_ENABLE_HBONE"
+	cniAmbientEnabledValuePath = "cni.ambient.enabled"
+)
+
+// IsAmbient returns true if the ambient data plane is installed.
+func (s IstioSpec) IsAmbient() bool {
+	return s.DataPlaneMode == DataPlaneModeAmbient
+}
+
+// mergeDataPlaneMode enables the ztunnel component and the ambient settings of istiod and CNI if the ambient data plane is configured.
+// The settings correspond to the ambient profile of Istio, without changing the image variant.
+func (i *Istio) mergeDataPlaneMode(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioOperator, error) {
+	if !i.Spec.IsAmbient() {
+		return op, nil
+	}
+
+	if op.Spec.Components == nil {
+		op.Spec.Components = &iopv1alpha1.IstioComponentSpec{}
+	}
+	enabled := iopv1alpha1.BoolValue{}
+	err := enabled.UnmarshalJSON([]byte("true"))
+	if err != nil {
+		return op, err
+	}
+	if op.Spec.Components.Ztunnel == nil {
+		op.Spec.Components.Ztunnel = &iopv1alpha1.ComponentSpec{}
+	}
+	op.Spec.Components.Ztunnel.Enabled = &enabled
+	op.Spec.Components.Ztunnel.Namespace = ztunnelNamespace
+
+	setPilotEnv(&op, enableAmbientEnvName, "true")
+
+	valuesMap, err := values.MapFromObject(op.Spec.Values)
+	if err != nil {
+		return op, err
+	}
+	if valuesMap == nil {
+		valuesMap = make(values.Map)
+	}
+	err = valuesMap.SetPath(cniAmbientEnabledValuePath, true)
+	if err != nil {
+		return op, err
+	}
+	op.Spec.Values, err = values.ConvertMap[json.RawMessage](valuesMap)
+	if err != nil {
+		return op, err
+	}
+
+	// Sidecars must use HBONE to communicate with workloads in the ambient mesh.
+	mcb, err := newMeshConfigBuilder(op)
+	if err != nil {
+		return op, err
+	}
+	mcb, err = mcb.AddProxyMetadata(proxyMetadataEnableHBONE, "true")
+	if err != nil {
+		return op, err
+	}
+	op.Spec.MeshConfig = mcb.Build()
+
+	return op, nil
+}
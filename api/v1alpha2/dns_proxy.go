@@ -0,0 +1,45 @@
+package v1alpha2
+
+import "strconv"
+
+const (
+	ProxyMetadataDNSCapture      = "ISTIO_META_DNS_CAPTURE"
+	ProxyMetadataDNSAutoAllocate = "ISTIO_META_DNS_AUTO_ALLOCATE"
+)
+
+// DNSProxy defines DNS proxying of the Istio sidecar proxies.
+// +kubebuilder:validation:XValidation:rule="self.enabled || !has(self.autoAllocate) || !self.autoAllocate",message="autoAllocate requires enabled to be true"
+type DNSProxy struct {
+	// Enables DNS proxying. The sidecar proxies capture the DNS requests of the workloads and answer them for hosts known to the mesh,
+	// for example, hosts of ServiceEntries.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// Enables automatic allocation of virtual IP addresses for ServiceEntries without addresses. This allows routing of TCP traffic
+	// to external services without stable IPs. Requires DNS proxying to be enabled.
+	// +kubebuilder:validation:Optional
+	AutoAllocate bool `json:"autoAllocate,omitempty"`
+}
+
+// proxyMetadata returns the proxy metadata that configures DNS proxying of the sidecar proxies.
+func (d *DNSProxy) proxyMetadata() map[string]string {
+	return map[string]string{
+		ProxyMetadataDNSCapture:      strconv.FormatBool(d.Enabled),
+		ProxyMetadataDNSAutoAllocate: strconv.FormatBool(d.Enabled && d.AutoAllocate),
+	}
+}
+
+func (m *meshConfigBuilder) BuildDNSProxyConfiguration(dnsProxy *DNSProxy) *meshConfigBuilder {
+	if dnsProxy == nil {
+		return m
+	}
+
+	for key, value := range dnsProxy.proxyMetadata() {
+		_, err := m.AddProxyMetadata(key, value)
+		if err != nil {
+			return nil
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,95 @@
+package v1alpha2
+
+import "k8s.io/utils/ptr"
+
+type IngressGatewayServiceType string
+
+const (
+	IngressGatewayServiceTypeLoadBalancer IngressGatewayServiceType = "LoadBalancer"
+	IngressGatewayServiceTypeNodePort     IngressGatewayServiceType = "NodePort"
+	IngressGatewayServiceTypeClusterIP    IngressGatewayServiceType = "ClusterIP"
+)
+
+// IngressGateway defines configuration for Istio Ingress Gateway.
+type IngressGateway struct {
+	// +kubebuilder:validation:Optional
+	K8s *KubernetesResourcesConfig `json:"k8s,omitempty"`
+
+	// Defines the configuration of the istio-ingressgateway Service.
+	// +kubebuilder:validation:Optional
+	Service *IngressGatewayService `json:"service,omitempty"`
+}
+
+// IngressGatewayService defines configuration for the Service of Istio Ingress Gateway.
+type IngressGatewayService struct {
+	// Defines the type of the Service. If not specified, "LoadBalancer" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=LoadBalancer;NodePort;ClusterIP
+	Type IngressGatewayServiceType `json:"type,omitempty"`
+
+	// Defines ports exposed by the Service in addition to the default status-port, http2 and https ports.
+	// +kubebuilder:validation:Optional
+	Ports []IngressGatewayServicePort `json:"ports,omitempty"`
+
+	// Defines the client IP ranges in CIDR notation that are allowed to access the load balancer. Only applies to the "LoadBalancer" type.
+	// +kubebuilder:validation:Optional
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+
+	// Defines the class of the load balancer implementation. Only applies to the "LoadBalancer" type.
+	// +kubebuilder:validation:Optional
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+
+	// Defines annotations of the Service. The annotations take precedence over the annotations set by the module,
+	// including the ones detected for the cluster provider.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type IngressGatewayServicePort struct {
+	// Name of the port.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Port exposed by the Service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Port of the gateway container. If not specified, the value of port is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	TargetPort *int32 `json:"targetPort,omitempty"`
+
+	// Port on each node on which the Service is exposed. Only applies to the "NodePort" and "LoadBalancer" types.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	NodePort *int32 `json:"nodePort,omitempty"`
+
+	// Protocol of the port. If not specified, "TCP" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=TCP;UDP
+	Protocol *string `json:"protocol,omitempty"`
+}
+
+// ServiceType returns the type of the Service, which is "LoadBalancer" if it is not configured.
+func (s *IngressGatewayService) ServiceType() IngressGatewayServiceType {
+	if s == nil || s.Type == "" {
+		return IngressGatewayServiceTypeLoadBalancer
+	}
+	return s.Type
+}
+
+// DefaultIngressGatewayServicePorts returns the ports of the istio-ingressgateway Service defined in the Istio gateway chart.
+// Istio replaces the default ports when ports are configured, so they must always be set together with the additional ports.
+func DefaultIngressGatewayServicePorts() []IngressGatewayServicePort {
+	return []IngressGatewayServicePort{
+		{Name: "status-port", Port: 15021, TargetPort: ptr.To[int32](15021)},
+		{Name: "http2", Port: 80, TargetPort: ptr.To[int32](8080)},
+		{Name: "https", Port: 443, TargetPort: ptr.To[int32](8443)},
+	}
+}
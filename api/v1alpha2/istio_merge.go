@@ -2,20 +2,33 @@ package v1alpha2
 
 import (
 	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
 
 	"istio.io/istio/operator/pkg/values"
 	"istio.io/istio/pkg/util/protomarshal"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
 	iopv1alpha1 "istio.io/istio/operator/pkg/apis"
 )
 
+const (
+	pilotDeploymentName = "istiod"
+
+	enableNativeSidecarsEnvName = "ENABLE_NATIVE_SIDECARS"
+
+	ProxyMetadataExitOnZeroActiveConnections = "EXIT_ON_ZERO_ACTIVE_CONNECTIONS"
+)
+
 func (i *Istio) MergeInto(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioOperator, error) {
 	mergedConfigOp, err := i.mergeConfig(op)
 	if err != nil {
@@ -27,6 +40,20 @@ func (i *Istio) MergeInto(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioOperat
 		return op, err
 	}
 
+	mergedResourcesOp = applyGatewayExternalTrafficPolicy(mergedResourcesOp, i)
+
+	mergedResourcesOp, err = i.mergeDataPlaneMode(mergedResourcesOp)
+	if err != nil {
+		return op, err
+	}
+
+	mergedResourcesOp, err = i.mergeCertificateAuthority(mergedResourcesOp)
+	if err != nil {
+		return op, err
+	}
+
+	mergedResourcesOp = i.mergeWorkloadCertificates(mergedResourcesOp)
+
 	if i.Spec.CompatibilityMode {
 		compatibleIop, setErr := setCompatibilityMode(mergedResourcesOp)
 		if setErr != nil {
@@ -76,6 +103,60 @@ func (m *meshConfigBuilder) BuildPrometheusMergeConfig(prometheusMerge bool) *me
 	return m
 }
 
+func (m *meshConfigBuilder) BuildOutboundTrafficPolicy(outboundTrafficPolicy *string) *meshConfigBuilder {
+	if outboundTrafficPolicy == nil {
+		return m
+	}
+
+	err := m.c.SetPath("outboundTrafficPolicy.mode", *outboundTrafficPolicy)
+	if err != nil {
+		return nil
+	}
+
+	return m
+}
+
+func (m *meshConfigBuilder) BuildProxyLifecycleConfiguration(proxy *ProxyComponent) *meshConfigBuilder {
+	if proxy == nil {
+		return m
+	}
+
+	if proxy.HoldApplicationUntilProxyStarts != nil {
+		err := m.c.SetPath("defaultConfig.holdApplicationUntilProxyStarts", *proxy.HoldApplicationUntilProxyStarts)
+		if err != nil {
+			return nil
+		}
+	}
+
+	if proxy.TerminationDrainDuration != nil {
+		err := m.c.SetPath("defaultConfig.terminationDrainDuration", protoDuration(proxy.TerminationDrainDuration.Duration))
+		if err != nil {
+			return nil
+		}
+	}
+
+	if proxy.Concurrency != nil {
+		err := m.c.SetPath("defaultConfig.concurrency", *proxy.Concurrency)
+		if err != nil {
+			return nil
+		}
+	}
+
+	if proxy.ExitOnZeroActiveConnections != nil {
+		_, err := m.AddProxyMetadata(ProxyMetadataExitOnZeroActiveConnections, strconv.FormatBool(*proxy.ExitOnZeroActiveConnections))
+		if err != nil {
+			return nil
+		}
+	}
+
+	return m
+}
+
+// protoDuration formats the duration the way the protobuf JSON mapping expects it, for example "90s" instead of "1m30s".
+func protoDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
 func (m *meshConfigBuilder) AddProxyMetadata(key, value string) (*meshConfigBuilder, error) {
 	err := m.c.SetPath("defaultConfig.proxyMetadata."+key, value)
 	if err != nil {
@@ -128,6 +209,72 @@ func setupHeaders(envoyXAuthProvider *meshv1alpha1.MeshConfig_ExtensionProvider_
 	}
 }
 
+func httpAuthorizationProvider(authorizer *Authorizer) *meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExtAuthzHttp {
+	var envoyXAuthProvider meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExtAuthzHttp
+	envoyXAuthProvider.EnvoyExtAuthzHttp = &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExternalAuthorizationHttpProvider{
+		Service: authorizer.Service,
+		Port:    authorizer.Port,
+	}
+
+	if authorizer.PathPrefix != nil {
+		envoyXAuthProvider.EnvoyExtAuthzHttp.PathPrefix = *authorizer.PathPrefix
+	}
+
+	if authorizer.Timeout != nil {
+		envoyXAuthProvider.EnvoyExtAuthzHttp.Timeout = durationpb.New(authorizer.Timeout.Duration)
+	}
+
+	if authorizer.FailOpen != nil {
+		envoyXAuthProvider.EnvoyExtAuthzHttp.FailOpen = *authorizer.FailOpen
+	}
+
+	if authorizer.StatusOnError != nil {
+		envoyXAuthProvider.EnvoyExtAuthzHttp.StatusOnError = *authorizer.StatusOnError
+	}
+
+	if authorizer.IncludeRequestBodyInCheck != nil {
+		envoyXAuthProvider.EnvoyExtAuthzHttp.IncludeRequestBodyInCheck = requestBody(authorizer.IncludeRequestBodyInCheck)
+	}
+
+	headers := authorizer.Headers
+	setupHeaders(&envoyXAuthProvider, headers)
+
+	return &envoyXAuthProvider
+}
+
+func grpcAuthorizationProvider(authorizer *Authorizer) *meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExtAuthzGrpc {
+	var envoyXAuthProvider meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExtAuthzGrpc
+	envoyXAuthProvider.EnvoyExtAuthzGrpc = &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExternalAuthorizationGrpcProvider{
+		Service: authorizer.Service,
+		Port:    authorizer.Port,
+	}
+
+	if authorizer.Timeout != nil {
+		envoyXAuthProvider.EnvoyExtAuthzGrpc.Timeout = durationpb.New(authorizer.Timeout.Duration)
+	}
+
+	if authorizer.FailOpen != nil {
+		envoyXAuthProvider.EnvoyExtAuthzGrpc.FailOpen = *authorizer.FailOpen
+	}
+
+	if authorizer.StatusOnError != nil {
+		envoyXAuthProvider.EnvoyExtAuthzGrpc.StatusOnError = *authorizer.StatusOnError
+	}
+
+	if authorizer.IncludeRequestBodyInCheck != nil {
+		envoyXAuthProvider.EnvoyExtAuthzGrpc.IncludeRequestBodyInCheck = requestBody(authorizer.IncludeRequestBodyInCheck)
+	}
+
+	return &envoyXAuthProvider
+}
+
+func requestBody(body *RequestBody) *meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExternalAuthorizationRequestBody {
+	return &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExternalAuthorizationRequestBody{
+		MaxRequestBytes:     body.MaxRequestBytes,
+		AllowPartialMessage: body.AllowPartialMessage,
+	}
+}
+
 func (m *meshConfigBuilder) BuildExternalAuthorizerConfiguration(authorizers []*Authorizer) *meshConfigBuilder {
 	extensionProviders := values.TryGetPathAs[[]interface{}](m.c, "extensionProviders")
 
@@ -137,35 +284,183 @@ func (m *meshConfigBuilder) BuildExternalAuthorizerConfiguration(authorizers []*
 		}
 		var authorizationProvider meshv1alpha1.MeshConfig_ExtensionProvider
 		authorizationProvider.Name = authorizer.Name
-		var envoyXAuthProvider meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExtAuthzHttp
-		envoyXAuthProvider.EnvoyExtAuthzHttp = &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyExternalAuthorizationHttpProvider{
-			Service: authorizer.Service,
-			Port:    authorizer.Port,
-		}
 
-		headers := authorizer.Headers
-		setupHeaders(&envoyXAuthProvider, headers)
+		if authorizer.IsGRPC() {
+			authorizationProvider.Provider = grpcAuthorizationProvider(authorizer)
+		} else {
+			authorizationProvider.Provider = httpAuthorizationProvider(authorizer)
+		}
 
-		authorizationProvider.Provider = &envoyXAuthProvider
-		marshaledProvider, err := protomarshal.Marshal(&authorizationProvider)
+		providerMap, err := extensionProviderToMap(&authorizationProvider)
 		if err != nil {
 			return nil
 		}
-		var providerMap map[string]interface{}
-		err = json.Unmarshal(marshaledProvider, &providerMap)
+		extensionProviders = append(extensionProviders, providerMap)
+	}
+
+	err := m.c.SetPath("extensionProviders", extensionProviders)
+	if err != nil {
+		return nil
+	}
+	return m
+}
+
+func (m *meshConfigBuilder) BuildAccessLogConfiguration(accessLog *AccessLog) *meshConfigBuilder {
+	extensionProviders := values.TryGetPathAs[[]interface{}](m.c, "extensionProviders")
+
+	labels, err := structpb.NewStruct(toInterfaceMap(accessLog.LogFormatLabels()))
+	if err != nil {
+		return nil
+	}
+
+	fileProvider := &meshv1alpha1.MeshConfig_ExtensionProvider{
+		Name: DefaultAccessLogProviderName,
+		Provider: &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyFileAccessLog{
+			EnvoyFileAccessLog: &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyFileAccessLogProvider{
+				Path: "/dev/stdout",
+				LogFormat: &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyFileAccessLogProvider_LogFormat{
+					LogFormat: &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyFileAccessLogProvider_LogFormat_Labels{
+						Labels: labels,
+					},
+				},
+			},
+		},
+	}
+
+	otelProvider := &meshv1alpha1.MeshConfig_ExtensionProvider{
+		Name: DefaultOtelAccessLogProviderName,
+		Provider: &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyOtelAls{
+			EnvoyOtelAls: &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyOpenTelemetryLogProvider{
+				Service: defaultOtelAccessLogService,
+				Port:    defaultOtelAccessLogPort,
+				LogName: DefaultOtelAccessLogProviderName,
+				LogFormat: &meshv1alpha1.MeshConfig_ExtensionProvider_EnvoyOpenTelemetryLogProvider_LogFormat{
+					Labels: labels,
+				},
+			},
+		},
+	}
+
+	for _, provider := range []*meshv1alpha1.MeshConfig_ExtensionProvider{fileProvider, otelProvider} {
+		providerMap, mapErr := extensionProviderToMap(provider)
+		if mapErr != nil {
+			return nil
+		}
+		extensionProviders = setExtensionProvider(extensionProviders, providerMap)
+	}
+
+	err = m.c.SetPath("extensionProviders", extensionProviders)
+	if err != nil {
+		return nil
+	}
+	return m
+}
+
+func (m *meshConfigBuilder) BuildTracingConfiguration(tracing *Tracing) *meshConfigBuilder {
+	if tracing == nil {
+		return m
+	}
+
+	extensionProviders := values.TryGetPathAs[[]interface{}](m.c, "extensionProviders")
+
+	var providerNames []interface{}
+	for _, provider := range tracing.Providers {
+		if provider == nil {
+			continue
+		}
+		providerMap, err := extensionProviderToMap(tracingProvider(provider))
 		if err != nil {
 			return nil
 		}
-		extensionProviders = append(extensionProviders, providerMap)
+		extensionProviders = setExtensionProvider(extensionProviders, providerMap)
+		providerNames = append(providerNames, provider.Name)
 	}
 
-	err := m.c.SetPath("extensionProviders", &extensionProviders)
+	err := m.c.SetPath("extensionProviders", extensionProviders)
 	if err != nil {
 		return nil
 	}
+
+	// The default providers are used by workloads that are not selected by a Telemetry resource with tracing providers, so users can
+	// still override the mesh-wide tracing with their own Telemetry resources.
+	if tracing.MeshWide && len(providerNames) > 0 {
+		err = m.c.SetPath("defaultProviders.tracing", providerNames)
+		if err != nil {
+			return nil
+		}
+	}
+
+	if tracing.SamplingPercentage != nil {
+		err = m.c.SetPath("defaultConfig.tracing.sampling", float64(*tracing.SamplingPercentage))
+		if err != nil {
+			return nil
+		}
+	}
+
 	return m
 }
 
+func tracingProvider(provider *TracingProvider) *meshv1alpha1.MeshConfig_ExtensionProvider {
+	var maxTagLength uint32
+	if provider.MaxTagLength != nil {
+		maxTagLength = *provider.MaxTagLength
+	}
+
+	extensionProvider := &meshv1alpha1.MeshConfig_ExtensionProvider{Name: provider.Name}
+	if provider.Type == TracingProviderZipkin {
+		extensionProvider.Provider = &meshv1alpha1.MeshConfig_ExtensionProvider_Zipkin{
+			Zipkin: &meshv1alpha1.MeshConfig_ExtensionProvider_ZipkinTracingProvider{
+				Service:      provider.Service,
+				Port:         provider.Port,
+				MaxTagLength: maxTagLength,
+			},
+		}
+	} else {
+		extensionProvider.Provider = &meshv1alpha1.MeshConfig_ExtensionProvider_Opentelemetry{
+			Opentelemetry: &meshv1alpha1.MeshConfig_ExtensionProvider_OpenTelemetryTracingProvider{
+				Service:      provider.Service,
+				Port:         provider.Port,
+				MaxTagLength: maxTagLength,
+			},
+		}
+	}
+
+	return extensionProvider
+}
+
+func extensionProviderToMap(provider *meshv1alpha1.MeshConfig_ExtensionProvider) (map[string]interface{}, error) {
+	marshaledProvider, err := protomarshal.Marshal(provider)
+	if err != nil {
+		return nil, err
+	}
+	var providerMap map[string]interface{}
+	err = json.Unmarshal(marshaledProvider, &providerMap)
+	if err != nil {
+		return nil, err
+	}
+	return providerMap, nil
+}
+
+// setExtensionProvider replaces the extension provider with the same name or appends it, if it does not exist yet.
+func setExtensionProvider(extensionProviders []interface{}, provider map[string]interface{}) []interface{} {
+	for i, existing := range extensionProviders {
+		existingMap, ok := existing.(map[string]interface{})
+		if ok && existingMap["name"] == provider["name"] {
+			extensionProviders[i] = provider
+			return extensionProviders
+		}
+	}
+	return append(extensionProviders, provider)
+}
+
+func toInterfaceMap(m map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
+
 func (i *Istio) mergeConfig(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioOperator, error) {
 	mcb, err := newMeshConfigBuilder(op)
 	if err != nil {
@@ -175,37 +470,39 @@ func (i *Istio) mergeConfig(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioOper
 	newMeshConfig := mcb.
 		BuildNumTrustedProxies(i.Spec.Config.NumTrustedProxies).
 		BuildExternalAuthorizerConfiguration(i.Spec.Config.Authorizers).
+		BuildOutboundTrafficPolicy(i.Spec.Config.OutboundTrafficPolicy).
 		BuildPrometheusMergeConfig(i.Spec.Config.Telemetry.Metrics.PrometheusMerge).
+		BuildAccessLogConfiguration(i.Spec.Config.AccessLog).
+		BuildTracingConfiguration(i.Spec.Config.Telemetry.Tracing).
+		BuildProxyLifecycleConfiguration(i.Spec.Components.proxy()).
+		BuildDNSProxyConfiguration(i.Spec.Config.DNSProxy).
+		BuildLocalityLoadBalancing(i.Spec.Config.LocalityLoadBalancing).
+		BuildTrustDomain(i.Spec.Config.TrustDomain, i.Spec.Config.TrustDomainAliases).
+		BuildWorkloadCertificates(i.Spec.Config.WorkloadCertificates).
 		Build()
 
 	op.Spec.MeshConfig = newMeshConfig
 
-	op = applyGatewayExternalTrafficPolicy(op, i)
-
 	return op, nil
 }
 
+// applyGatewayExternalTrafficPolicy sets the externalTrafficPolicy of the Services of all ingress gateways managed by the Istio CR.
+// It is applied after the additional ingress gateways are created, so that every gateway gets its own overlay.
 func applyGatewayExternalTrafficPolicy(op iopv1alpha1.IstioOperator, i *Istio) iopv1alpha1.IstioOperator {
-	if i.Spec.Config.GatewayExternalTrafficPolicy != nil {
-		if op.Spec.Components == nil {
-			op.Spec.Components = &iopv1alpha1.IstioComponentSpec{}
-		}
-		if len(op.Spec.Components.IngressGateways) == 0 {
-			op.Spec.Components.IngressGateways = append(op.Spec.Components.IngressGateways, iopv1alpha1.GatewayComponentSpec{})
-		}
-		if op.Spec.Components.IngressGateways[0].Kubernetes == nil {
-			op.Spec.Components.IngressGateways[0].Kubernetes = &iopv1alpha1.KubernetesResources{}
-		}
+	if i.Spec.Config.GatewayExternalTrafficPolicy == nil {
+		return op
+	}
 
-		const kind = "Service"
-		const version = "v1"
-		const istioIngressGateway = "istio-ingressgateway"
-		const path = "spec.externalTrafficPolicy"
+	const kind = "Service"
+	const version = "v1"
+	const path = "spec.externalTrafficPolicy"
 
-		op.Spec.Components.IngressGateways[0].Kubernetes.Overlays = append(op.Spec.Components.IngressGateways[0].Kubernetes.Overlays, iopv1alpha1.KubernetesOverlay{
+	for _, name := range i.IngressGatewayNames() {
+		gateway := ingressGatewayComponent(&op, name)
+		gateway.Kubernetes.Overlays = append(gateway.Kubernetes.Overlays, iopv1alpha1.KubernetesOverlay{
 			ApiVersion: version,
 			Kind:       kind,
-			Name:       istioIngressGateway,
+			Name:       name,
 			Patches: []iopv1alpha1.Patch{
 				{
 					Path:  path,
@@ -217,28 +514,60 @@ func applyGatewayExternalTrafficPolicy(op iopv1alpha1.IstioOperator, i *Istio) i
 	return op
 }
 
+// ingressGatewayComponent returns the ingress gateway component with the given name. The component is added if it does not exist yet.
+func ingressGatewayComponent(op *iopv1alpha1.IstioOperator, name string) *iopv1alpha1.GatewayComponentSpec {
+	if op.Spec.Components == nil {
+		op.Spec.Components = &iopv1alpha1.IstioComponentSpec{}
+	}
+	index := slices.IndexFunc(op.Spec.Components.IngressGateways, func(gateway iopv1alpha1.GatewayComponentSpec) bool {
+		return gateway.Name == name
+	})
+	if index < 0 {
+		op.Spec.Components.IngressGateways = append(op.Spec.Components.IngressGateways, iopv1alpha1.GatewayComponentSpec{Name: name})
+		index = len(op.Spec.Components.IngressGateways) - 1
+	}
+	gateway := &op.Spec.Components.IngressGateways[index]
+	if gateway.Kubernetes == nil {
+		gateway.Kubernetes = &iopv1alpha1.KubernetesResources{}
+	}
+	return gateway
+}
+
 //nolint:gocognit,gocyclo,cyclop,funlen // cognitive complexity 189 of func `(*Istio).mergeResources` is high (> 20), cyclomatic complexity 70 of func `(*Istio).mergeResources` is high (> 30), Function 'mergeResources' has too many statements (129 > 50) TODO: refactor this function
 func (i *Istio) mergeResources(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioOperator, error) {
 	if i.Spec.Components == nil {
 		return op, nil
 	}
 
-	//nolint:nestif // `if i.Spec.Components.IngressGateway != nil` has complex nested blocks (complexity: 6) TODO refactor
+	// The additional ingress gateways are based on the default istio-ingressgateway before the configuration of the Istio CR is applied to it.
+	ingressGatewayBase, err := defaultIngressGatewayK8s(op)
+	if err != nil {
+		return op, err
+	}
+
 	if i.Spec.Components.IngressGateway != nil {
-		if op.Spec.Components == nil {
-			op.Spec.Components = &iopv1alpha1.IstioComponentSpec{}
+		ingressGateway := ingressGatewayComponent(&op, DefaultIngressGatewayName)
+		if i.Spec.Components.IngressGateway.K8s != nil {
+			err := mergeK8sConfig(ingressGateway.Kubernetes, *i.Spec.Components.IngressGateway.K8s, DefaultIngressGatewayName)
+			if err != nil {
+				return op, err
+			}
 		}
-		if len(op.Spec.Components.IngressGateways) == 0 {
-			op.Spec.Components.IngressGateways = append(op.Spec.Components.IngressGateways, iopv1alpha1.GatewayComponentSpec{})
+		if i.Spec.Components.IngressGateway.Service != nil {
+			mergeIngressGatewayService(ingressGateway.Kubernetes, *i.Spec.Components.IngressGateway.Service)
 		}
-		if op.Spec.Components.IngressGateways[0].Kubernetes == nil {
-			op.Spec.Components.IngressGateways[0].Kubernetes = &iopv1alpha1.KubernetesResources{}
+	}
+
+	if len(i.Spec.Components.AdditionalIngressGateways) > 0 {
+		if op.Spec.Components == nil {
+			op.Spec.Components = &iopv1alpha1.IstioComponentSpec{}
 		}
-		if i.Spec.Components.IngressGateway.K8s != nil {
-			err := mergeK8sConfig(op.Spec.Components.IngressGateways[0].Kubernetes, *i.Spec.Components.IngressGateway.K8s)
+		for _, gateway := range i.Spec.Components.AdditionalIngressGateways {
+			gatewaySpec, err := additionalIngressGatewaySpec(ingressGatewayBase, gateway)
 			if err != nil {
 				return op, err
 			}
+			op.Spec.Components.IngressGateways = setIngressGateway(op.Spec.Components.IngressGateways, gatewaySpec)
 		}
 	}
 
@@ -254,7 +583,7 @@ func (i *Istio) mergeResources(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioO
 			op.Spec.Components.EgressGateways[0].Kubernetes = &iopv1alpha1.KubernetesResources{}
 		}
 		if i.Spec.Components.EgressGateway.K8s != nil {
-			err := mergeK8sConfig(op.Spec.Components.EgressGateways[0].Kubernetes, *i.Spec.Components.EgressGateway.K8s)
+			err := mergeK8sConfig(op.Spec.Components.EgressGateways[0].Kubernetes, *i.Spec.Components.EgressGateway.K8s, DefaultEgressGatewayName)
 			if err != nil {
 				return op, err
 			}
@@ -294,7 +623,7 @@ func (i *Istio) mergeResources(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioO
 			op.Spec.Components.Pilot.Kubernetes = &iopv1alpha1.KubernetesResources{}
 		}
 		if i.Spec.Components.Pilot.K8s != nil {
-			err := mergeK8sConfig(op.Spec.Components.Pilot.Kubernetes, *i.Spec.Components.Pilot.K8s)
+			err := mergeK8sConfig(op.Spec.Components.Pilot.Kubernetes, *i.Spec.Components.Pilot.K8s, revisionedPilotDeploymentName(op.Spec.Revision))
 			if err != nil {
 				return op, err
 			}
@@ -350,6 +679,10 @@ func (i *Istio) mergeResources(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioO
 		}
 	}
 
+	if i.Spec.Components.Proxy != nil && i.Spec.Components.Proxy.NativeSidecar != nil {
+		setPilotEnv(&op, enableNativeSidecarsEnvName, strconv.FormatBool(*i.Spec.Components.Proxy.NativeSidecar))
+	}
+
 	//nolint:nestif // `if i.Spec.Components.Cni != nil` has complex nested blocks (complexity: 63) TODO refactor
 	if i.Spec.Components.Cni != nil {
 		if op.Spec.Components == nil {
@@ -445,13 +778,182 @@ func (i *Istio) mergeResources(op iopv1alpha1.IstioOperator) (iopv1alpha1.IstioO
 				}
 			}
 		}
+
+		if i.Spec.Components.Cni.K8S != nil {
+			mergeCniSchedulingConfig(op.Spec.Components.Cni.Kubernetes, *i.Spec.Components.Cni.K8S)
+		}
 	}
 
 	return op, nil
 }
 
+func mergeCniSchedulingConfig(base *iopv1alpha1.KubernetesResources, newConfig CniK8sConfig) {
+	if newConfig.NodeSelector != nil {
+		base.NodeSelector = newConfig.NodeSelector
+	}
+	if newConfig.Tolerations != nil {
+		base.Tolerations = toTolerationPointers(newConfig.Tolerations)
+	}
+	if newConfig.PriorityClassName != nil {
+		base.PriorityClassName = *newConfig.PriorityClassName
+	}
+}
+
+// mergeIngressGatewayService applies the Service configuration to the Kubernetes configuration of the ingress gateway.
+// The annotations are set as component service annotations, which Istio patches into the rendered Service. Therefore, they take precedence
+// over the serviceAnnotations values set by the module and by the cluster provider configuration.
+func mergeIngressGatewayService(k8s *iopv1alpha1.KubernetesResources, service IngressGatewayService) {
+	if len(service.Annotations) > 0 {
+		if k8s.ServiceAnnotations == nil {
+			k8s.ServiceAnnotations = make(map[string]string, len(service.Annotations))
+		}
+		for k, v := range service.Annotations {
+			k8s.ServiceAnnotations[k] = v
+		}
+	}
+
+	if service.Type == "" && len(service.Ports) == 0 && len(service.LoadBalancerSourceRanges) == 0 && service.LoadBalancerClass == nil {
+		return
+	}
+
+	if k8s.Service == nil {
+		k8s.Service = &corev1.ServiceSpec{}
+	}
+	if service.Type != "" {
+		k8s.Service.Type = corev1.ServiceType(service.Type)
+	}
+	if len(service.Ports) > 0 {
+		k8s.Service.Ports = toServicePorts(append(DefaultIngressGatewayServicePorts(), service.Ports...))
+	}
+	if len(service.LoadBalancerSourceRanges) > 0 {
+		k8s.Service.LoadBalancerSourceRanges = service.LoadBalancerSourceRanges
+	}
+	if service.LoadBalancerClass != nil {
+		k8s.Service.LoadBalancerClass = service.LoadBalancerClass
+	}
+}
+
+func toServicePorts(ports []IngressGatewayServicePort) []corev1.ServicePort {
+	servicePorts := make([]corev1.ServicePort, 0, len(ports))
+	for _, port := range ports {
+		servicePort := corev1.ServicePort{
+			Name:     port.Name,
+			Port:     port.Port,
+			Protocol: corev1.ProtocolTCP,
+		}
+		if port.TargetPort != nil {
+			servicePort.TargetPort = intstr.FromInt32(*port.TargetPort)
+		}
+		if port.NodePort != nil {
+			servicePort.NodePort = *port.NodePort
+		}
+		if port.Protocol != nil {
+			servicePort.Protocol = corev1.Protocol(*port.Protocol)
+		}
+		servicePorts = append(servicePorts, servicePort)
+	}
+	return servicePorts
+}
+
+// defaultIngressGatewayK8s returns the marshaled Kubernetes configuration of the default istio-ingressgateway or nil, if it is not configured.
+func defaultIngressGatewayK8s(op iopv1alpha1.IstioOperator) ([]byte, error) {
+	if op.Spec.Components == nil {
+		return nil, nil
+	}
+	for _, ingressGateway := range op.Spec.Components.IngressGateways {
+		if ingressGateway.Name == DefaultIngressGatewayName && ingressGateway.Kubernetes != nil {
+			return json.Marshal(ingressGateway.Kubernetes)
+		}
+	}
+	return nil, nil
+}
+
+// additionalIngressGatewaySpec creates the component of an additional ingress gateway. The Kubernetes configuration of the default
+// istio-ingressgateway is used as a base, so that the additional gateway has the same defaults, for example security context and HPA metrics.
+func additionalIngressGatewaySpec(base []byte, gateway AdditionalIngressGateway) (iopv1alpha1.GatewayComponentSpec, error) {
+	k8s := &iopv1alpha1.KubernetesResources{}
+	if base != nil {
+		err := json.Unmarshal(base, k8s)
+		if err != nil {
+			return iopv1alpha1.GatewayComponentSpec{}, err
+		}
+		renameIngressGatewayReferences(k8s, gateway.Name)
+		// Service annotations of the default gateway component are not inherited, only the ones of the additional gateway are applied.
+		k8s.ServiceAnnotations = nil
+	}
+
+	if len(gateway.ServiceAnnotations) > 0 {
+		k8s.ServiceAnnotations = make(map[string]string, len(gateway.ServiceAnnotations))
+		for k, v := range gateway.ServiceAnnotations {
+			k8s.ServiceAnnotations[k] = v
+		}
+	}
+
+	if gateway.K8s != nil {
+		err := mergeK8sConfig(k8s, *gateway.K8s, gateway.Name)
+		if err != nil {
+			return iopv1alpha1.GatewayComponentSpec{}, err
+		}
+	}
+
+	enabled := iopv1alpha1.BoolValue{}
+	err := enabled.UnmarshalJSON([]byte("true"))
+	if err != nil {
+		return iopv1alpha1.GatewayComponentSpec{}, err
+	}
+
+	return iopv1alpha1.GatewayComponentSpec{
+		ComponentSpec: iopv1alpha1.ComponentSpec{
+			Enabled:    &enabled,
+			Namespace:  "istio-system",
+			Kubernetes: k8s,
+		},
+		Name:  gateway.Name,
+		Label: gateway.PodLabels(),
+	}, nil
+}
+
+// renameIngressGatewayReferences replaces the references to the default istio-ingressgateway with the given gateway name.
+func renameIngressGatewayReferences(k8s *iopv1alpha1.KubernetesResources, name string) {
+	if k8s.HpaSpec != nil {
+		k8s.HpaSpec.ScaleTargetRef.Name = name
+	}
+
+	for i := range k8s.Overlays {
+		if k8s.Overlays[i].Name == DefaultIngressGatewayName {
+			k8s.Overlays[i].Name = name
+		}
+	}
+
+	if k8s.Affinity != nil && k8s.Affinity.PodAntiAffinity != nil {
+		for _, term := range k8s.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			if term.PodAffinityTerm.LabelSelector == nil {
+				continue
+			}
+			for _, expression := range term.PodAffinityTerm.LabelSelector.MatchExpressions {
+				for j, value := range expression.Values {
+					if value == DefaultIngressGatewayName {
+						expression.Values[j] = name
+					}
+				}
+			}
+		}
+	}
+}
+
+// setIngressGateway replaces the ingress gateway with the same name or appends it, if it does not exist yet.
+func setIngressGateway(ingressGateways []iopv1alpha1.GatewayComponentSpec, gateway iopv1alpha1.GatewayComponentSpec) []iopv1alpha1.GatewayComponentSpec {
+	for i := range ingressGateways {
+		if ingressGateways[i].Name == gateway.Name {
+			ingressGateways[i] = gateway
+			return ingressGateways
+		}
+	}
+	return append(ingressGateways, gateway)
+}
+
 //nolint:gocognit,funlen // cognitive complexity 61 of func `mergeK8sConfig` is high (> 20), Function 'mergeK8sConfig' has too many statements (52 > 50) TODO: refactor this function
-func mergeK8sConfig(base *iopv1alpha1.KubernetesResources, newConfig KubernetesResourcesConfig) error {
+func mergeK8sConfig(base *iopv1alpha1.KubernetesResources, newConfig KubernetesResourcesConfig, deploymentName string) error {
 	//nolint:nestif // `if newConfig.Resources != nil` has complex nested blocks (complexity: 27) TODO refactor
 	if newConfig.Resources != nil {
 		if base.Resources == nil {
@@ -554,5 +1056,108 @@ func mergeK8sConfig(base *iopv1alpha1.KubernetesResources, newConfig KubernetesR
 			}
 		}
 	}
+
+	return mergeSchedulingConfig(base, newConfig, deploymentName)
+}
+
+// mergeSchedulingConfig applies the scheduling configuration of a component. The topology spread constraints are not supported
+// by the Kubernetes configuration of the IstioOperator, so they are applied with an overlay to the Deployment of the component.
+func mergeSchedulingConfig(base *iopv1alpha1.KubernetesResources, newConfig KubernetesResourcesConfig, deploymentName string) error {
+	if newConfig.NodeSelector != nil {
+		base.NodeSelector = newConfig.NodeSelector
+	}
+	if newConfig.Tolerations != nil {
+		base.Tolerations = toTolerationPointers(newConfig.Tolerations)
+	}
+	if newConfig.PriorityClassName != nil {
+		base.PriorityClassName = *newConfig.PriorityClassName
+	}
+	if newConfig.PodDisruptionBudget != nil {
+		base.PodDisruptionBudget = &policyv1.PodDisruptionBudgetSpec{
+			MinAvailable:   newConfig.PodDisruptionBudget.MinAvailable,
+			MaxUnavailable: newConfig.PodDisruptionBudget.MaxUnavailable,
+		}
+	}
+
+	if newConfig.TopologySpreadConstraints != nil {
+		value, err := toStructValue(newConfig.TopologySpreadConstraints)
+		if err != nil {
+			return err
+		}
+		base.Overlays = append(base.Overlays, iopv1alpha1.KubernetesOverlay{
+			ApiVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       deploymentName,
+			Patches: []iopv1alpha1.Patch{
+				{
+					Path:  "spec.template.spec.topologySpreadConstraints",
+					Value: value,
+				},
+			},
+		})
+	}
 	return nil
 }
+
+func toTolerationPointers(tolerations []corev1.Toleration) []*corev1.Toleration {
+	result := make([]*corev1.Toleration, 0, len(tolerations))
+	for i := range tolerations {
+		result = append(result, tolerations[i].DeepCopy())
+	}
+	return result
+}
+
+// toStructValue converts the given object to a protobuf value by its JSON representation.
+func toStructValue(obj interface{}) (*structpb.Value, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	err = json.Unmarshal(b, &generic)
+	if err != nil {
+		return nil, err
+	}
+	return structpb.NewValue(generic)
+}
+
+// setPilotEnv sets the environment variable of istiod, replacing the value already defined in the IstioOperator.
+func setPilotEnv(op *iopv1alpha1.IstioOperator, name, value string) {
+	if op.Spec.Components == nil {
+		op.Spec.Components = &iopv1alpha1.IstioComponentSpec{}
+	}
+	if op.Spec.Components.Pilot == nil {
+		op.Spec.Components.Pilot = &iopv1alpha1.ComponentSpec{}
+	}
+	if op.Spec.Components.Pilot.Kubernetes == nil {
+		op.Spec.Components.Pilot.Kubernetes = &iopv1alpha1.KubernetesResources{}
+	}
+
+	for _, env := range op.Spec.Components.Pilot.Kubernetes.Env {
+		if env != nil && env.Name == name {
+			env.Value = value
+			env.ValueFrom = nil
+			return
+		}
+	}
+
+	op.Spec.Components.Pilot.Kubernetes.Env = append(op.Spec.Components.Pilot.Kubernetes.Env, &corev1.EnvVar{
+		Name:  name,
+		Value: value,
+	})
+}
+
+func (c *Components) proxy() *ProxyComponent {
+	if c == nil {
+		return nil
+	}
+	return c.Proxy
+}
+
+// revisionedPilotDeploymentName returns the name of the istiod Deployment, which Istio suffixes with the revision for non-default revisions.
+func revisionedPilotDeploymentName(revision string) string {
+	if revision == "" || revision == DefaultRevision {
+		return pilotDeploymentName
+	}
+	return pilotDeploymentName + "-" + revision
+}
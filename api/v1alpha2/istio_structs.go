@@ -2,6 +2,7 @@ package v1alpha2
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -23,22 +24,83 @@ type Config struct {
 	// +kubebuilder:validation:Enum=Local;Cluster
 	GatewayExternalTrafficPolicy *string `json:"gatewayExternalTrafficPolicy,omitempty"`
 
+	// Defines the outbound traffic policy of the mesh. With "REGISTRY_ONLY", the sidecar proxies only allow traffic to hosts registered in the service registry,
+	// for example, through ServiceEntries. With "ALLOW_ANY", traffic to unknown hosts is passed through. If not specified, "ALLOW_ANY" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ALLOW_ANY;REGISTRY_ONLY
+	OutboundTrafficPolicy *string `json:"outboundTrafficPolicy,omitempty"`
+
 	// Defines the telemetry configuration of Istio.
 	// +kubebuilder:validation:Optional
 	Telemetry Telemetry `json:"telemetry,omitempty"`
+
+	// Defines the log format of the kyma-default-logger and kyma-default-otel-logger access log providers.
+	// +kubebuilder:validation:Optional
+	AccessLog *AccessLog `json:"accessLog,omitempty"`
+
+	// Defines the mesh-wide mutual TLS mode and the namespaces that use a different mode.
+	// +kubebuilder:validation:Optional
+	MTLS *MTLS `json:"mtls,omitempty"`
+
+	// Defines DNS proxying of the Istio sidecar proxies. Changing the configuration restarts the sidecar proxies.
+	// +kubebuilder:validation:Optional
+	DNSProxy *DNSProxy `json:"dnsProxy,omitempty"`
+
+	// Defines locality-aware load balancing of the mesh, which keeps traffic in the locality of the client and defines the failover
+	// to other localities.
+	// +kubebuilder:validation:Optional
+	LocalityLoadBalancing *LocalityLoadBalancing `json:"localityLoadBalancing,omitempty"`
+
+	// Defines the certificate authority that signs the workload certificates. If not specified, istiod uses its self-signed root certificate.
+	// +kubebuilder:validation:Optional
+	CertificateAuthority *CertificateAuthority `json:"certificateAuthority,omitempty"`
+
+	// Defines the trust domain of the mesh, which is part of the SPIFFE identity of the workloads. If not specified, "cluster.local" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	TrustDomain *string `json:"trustDomain,omitempty"`
+
+	// Defines the trust domains that are treated as equal to the trust domain of the mesh, for example, the previous trust domain
+	// during a migration.
+	// +kubebuilder:validation:Optional
+	TrustDomainAliases []string `json:"trustDomainAliases,omitempty"`
+
+	// Defines the expiry monitoring of the CA certificates of the mesh and the rotation of the root CA.
+	// +kubebuilder:validation:Optional
+	RootCA *RootCA `json:"rootCA,omitempty"`
+
+	// Defines the lifetime and the private key of the workload certificates that istiod issues to the Istio proxies.
+	// +kubebuilder:validation:Optional
+	WorkloadCertificates *WorkloadCertificates `json:"workloadCertificates,omitempty"`
+}
+
+const (
+	DefaultIngressGatewayName = "istio-ingressgateway"
+	DefaultEgressGatewayName  = "istio-egressgateway"
+
+	OutboundTrafficPolicyAllowAny     = "ALLOW_ANY"
+	OutboundTrafficPolicyRegistryOnly = "REGISTRY_ONLY"
+)
+
+// IsOutboundTrafficRegistryOnly returns true if the outbound traffic is restricted to hosts in the service registry.
+func (c Config) IsOutboundTrafficRegistryOnly() bool {
+	return c.OutboundTrafficPolicy != nil && *c.OutboundTrafficPolicy == OutboundTrafficPolicyRegistryOnly
 }
 
 type Components struct {
 	// Pilot defines component configuration for Istiod
 	Pilot *IstioComponent `json:"pilot,omitempty"`
 	// IngressGateway defines component configurations for Istio Ingress Gateway
-	IngressGateway *IstioComponent `json:"ingressGateway,omitempty"`
+	IngressGateway *IngressGateway `json:"ingressGateway,omitempty"`
 	// Cni defines component configuration for Istio CNI DaemonSet
 	Cni *CniComponent `json:"cni,omitempty"`
 	// Proxy defines component configuration for Istio proxy sidecar
 	Proxy *ProxyComponent `json:"proxy,omitempty"`
 	// +kubebuilder:validation:Optional
 	EgressGateway *EgressGateway `json:"egressGateway,omitempty"`
+	// AdditionalIngressGateways defines Istio Ingress Gateways that are installed next to the default istio-ingressgateway, for example an internal-only gateway
+	// +kubebuilder:validation:Optional
+	AdditionalIngressGateways []AdditionalIngressGateway `json:"additionalIngressGateways,omitempty"`
 }
 
 // KubernetesResourcesConfig is a subset of https://istio.io/latest/docs/reference/config/istio.operator.v1alpha1/#KubernetesResourcesSpec
@@ -46,12 +108,74 @@ type KubernetesResourcesConfig struct {
 	HPASpec   *HPASpec   `json:"hpaSpec,omitempty"`
 	Strategy  *Strategy  `json:"strategy,omitempty"`
 	Resources *Resources `json:"resources,omitempty"`
+
+	// Defines the node labels that a node must have to run the Pods of the component.
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Defines the tolerations of the Pods of the component.
+	// +kubebuilder:validation:Optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Defines how the Pods of the component are spread across topology domains, for example, zones.
+	// +kubebuilder:validation:Optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Defines the PriorityClass of the Pods of the component.
+	// +kubebuilder:validation:Optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// +kubebuilder:validation:Optional
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+}
+
+// PodDisruptionBudget defines the PodDisruptionBudget of a component: https://kubernetes.io/docs/tasks/run-application/configure-pdb/
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="only one of minAvailable and maxUnavailable can be set"
+type PodDisruptionBudget struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern="^((100|[0-9]{1,2})%|[0-9]+)$"
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern="^((100|[0-9]{1,2})%|[0-9]+)$"
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 // ProxyComponent defines configuration for Istio proxies.
 type ProxyComponent struct {
-	// +kubebuilder:validation:Required
-	K8S *ProxyK8sConfig `json:"k8s"`
+	// +kubebuilder:validation:Optional
+	K8S *ProxyK8sConfig `json:"k8s,omitempty"`
+
+	// Defines whether the application container starts only after the Istio proxy has started.
+	// If not specified, "true" is used.
+	// +kubebuilder:validation:Optional
+	HoldApplicationUntilProxyStarts *bool `json:"holdApplicationUntilProxyStarts,omitempty"`
+
+	// Defines how long the Istio proxy drains existing connections on shutdown, for example "5s" or "1m".
+	// If not specified, Istio's default of 5s is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	TerminationDrainDuration *metav1.Duration `json:"terminationDrainDuration,omitempty"`
+
+	// Defines whether the Istio proxy exits as soon as there are no active connections left during shutdown,
+	// instead of waiting for the whole termination drain duration.
+	// +kubebuilder:validation:Optional
+	ExitOnZeroActiveConnections *bool `json:"exitOnZeroActiveConnections,omitempty"`
+
+	// Defines the number of worker threads of the Istio proxy. If set to 0, a worker thread is started for each CPU core.
+	// If not specified, the number of worker threads is derived from the CPU limit of the Istio proxy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Concurrency *int32 `json:"concurrency,omitempty"`
+
+	// Defines whether the Istio proxy is injected as a native sidecar container, which is an init container with restartPolicy set to Always.
+	// If not specified, the default sidecar type of the Istio module version is used.
+	// The sidecar.istio.io/nativeSidecar annotation of a Pod takes precedence over this setting.
+	// +kubebuilder:validation:Optional
+	NativeSidecar *bool `json:"nativeSidecar,omitempty"`
+}
+
+// IsNativeSidecar returns true if the Istio proxy is injected as a native sidecar container.
+func (p *ProxyComponent) IsNativeSidecar() bool {
+	return p != nil && p.NativeSidecar != nil && *p.NativeSidecar
 }
 
 // ProxyK8sConfig is a subset of https://istio.io/latest/docs/reference/config/istio.operator.v1alpha1/#KubernetesResourcesSpec
@@ -69,6 +193,16 @@ type CniComponent struct {
 type CniK8sConfig struct {
 	Affinity  *corev1.Affinity `json:"affinity,omitempty"`
 	Resources *Resources       `json:"resources,omitempty"`
+
+	// Defines the node labels that a node must have to run the CNI Pods.
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Defines the tolerations of the CNI Pods, for example, to run them on tainted dedicated nodes.
+	// +kubebuilder:validation:Optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Defines the PriorityClass of the CNI Pods.
+	// +kubebuilder:validation:Optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
 }
 
 // HPASpec defines configuration for HorizontalPodAutoscaler.
@@ -120,6 +254,48 @@ type ResourceClaims struct {
 	Memory *string `json:"memory,omitempty"`
 }
 
+// AdditionalIngressGateway defines configuration for an Istio Ingress Gateway installed next to the default istio-ingressgateway.
+type AdditionalIngressGateway struct {
+	// Name of the gateway Deployment and Service in the istio-system namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// Labels of the gateway Pods. The labels "app" and "istio" are set to the gateway name, unless they are overridden.
+	// Gateway resources select the gateway by these labels.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations of the gateway Service, for example, to provision an internal load balancer.
+	// +kubebuilder:validation:Optional
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+	// +kubebuilder:validation:Optional
+	K8s *KubernetesResourcesConfig `json:"k8s,omitempty"`
+}
+
+// PodLabels returns the labels of the gateway Pods.
+func (g AdditionalIngressGateway) PodLabels() map[string]string {
+	podLabels := map[string]string{
+		"app":   g.Name,
+		"istio": g.Name,
+	}
+	for k, v := range g.Labels {
+		podLabels[k] = v
+	}
+	return podLabels
+}
+
+// IngressGatewayNames returns the names of all ingress gateways managed by the Istio CR, starting with the default istio-ingressgateway.
+func (i *Istio) IngressGatewayNames() []string {
+	names := []string{DefaultIngressGatewayName}
+	if i.Spec.Components == nil {
+		return names
+	}
+	for _, gateway := range i.Spec.Components.AdditionalIngressGateways {
+		names = append(names, gateway.Name)
+	}
+	return names
+}
+
 // EgressGateway defines configuration for Istio egressGateway.
 type EgressGateway struct {
 	// +kubebuilder:validation:Optional
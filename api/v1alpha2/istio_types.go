@@ -32,41 +32,56 @@ const (
 	Deleting   State = "Deleting"
 	Warning    State = "Warning"
 
-	ConditionTypeReady                             ConditionType = "Ready"
-	ConditionTypeProxySidecarRestartSucceeded      ConditionType = "ProxySidecarRestartSucceeded"
-	ConditionTypeIngressTargetingUserResourceFound ConditionType = "IngressTargetingUserResourceFound"
+	ConditionTypeReady                                ConditionType = "Ready"
+	ConditionTypeProxySidecarRestartSucceeded         ConditionType = "ProxySidecarRestartSucceeded"
+	ConditionTypeIngressTargetingUserResourceFound    ConditionType = "IngressTargetingUserResourceFound"
+	ConditionTypeOutboundTrafficBlocked               ConditionType = "OutboundTrafficBlocked"
+	ConditionTypeProviderServiceAnnotationsOverridden ConditionType = "ProviderServiceAnnotationsOverridden"
+	ConditionTypeCanaryUpgrade                        ConditionType = "CanaryUpgrade"
+	ConditionTypeCACertificatesExpiring               ConditionType = "CACertificatesExpiring"
+	ConditionTypeRootCARotation                       ConditionType = "RootCARotation"
 
 	// general.
-	ConditionReasonReconcileSucceeded        ConditionReason = "ReconcileSucceeded"
-	ConditionReasonReconcileSucceededMessage                 = "Reconciliation succeeded"
-	ConditionReasonReconcileUnknown          ConditionReason = "ReconcileUnknown"
-	ConditionReasonReconcileUnknownMessage                   = "Module readiness is unknown. Either a reconciliation is progressing, or failed previously. Check status of other conditions"
-	ConditionReasonReconcileRequeued         ConditionReason = "ReconcileRequeued"
-	ConditionReasonReconcileRequeuedMessage                  = "Proxy reset is still ongoing. Reconciliation requeued"
-	ConditionReasonReconcileFailed           ConditionReason = "ReconcileFailed"
-	ConditionReasonReconcileFailedMessage                    = "Reconciliation failed"
-	ConditionReasonValidationFailed          ConditionReason = "ValidationFailed"
-	ConditionReasonValidationFailedMessage                   = "Reconciliation did not happen as Istio Custom Resource failed to validate"
-	ConditionReasonOlderCRExists             ConditionReason = "OlderCRExists"
-	ConditionReasonOlderCRExistsMessage                      = "This Istio custom resource is not the oldest one and does not represent the module state"
-	ConditionReasonOldestCRNotFound          ConditionReason = "OldestCRNotFound"
-	ConditionReasonOldestCRNotFoundMessage                   = "Oldest Istio custom resource could not be found"
+	ConditionReasonReconcileSucceeded          ConditionReason = "ReconcileSucceeded"
+	ConditionReasonReconcileSucceededMessage                   = "Reconciliation succeeded"
+	ConditionReasonReconcileUnknown            ConditionReason = "ReconcileUnknown"
+	ConditionReasonReconcileUnknownMessage                     = "Module readiness is unknown. Either a reconciliation is progressing, or failed previously. Check status of other conditions"
+	ConditionReasonReconcileRequeued           ConditionReason = "ReconcileRequeued"
+	ConditionReasonReconcileRequeuedMessage                    = "Proxy reset is still ongoing. Reconciliation requeued"
+	ConditionReasonReconcileFailed             ConditionReason = "ReconcileFailed"
+	ConditionReasonReconcileFailedMessage                      = "Reconciliation failed"
+	ConditionReasonValidationFailed            ConditionReason = "ValidationFailed"
+	ConditionReasonValidationFailedMessage                     = "Reconciliation did not happen as Istio Custom Resource failed to validate"
+	ConditionReasonOlderCRExists               ConditionReason = "OlderCRExists"
+	ConditionReasonOlderCRExistsMessage                        = "This Istio custom resource is not the oldest one and does not represent the module state"
+	ConditionReasonOldestCRNotFound            ConditionReason = "OldestCRNotFound"
+	ConditionReasonOldestCRNotFoundMessage                     = "Oldest Istio custom resource could not be found"
+	ConditionReasonComponentsNotHealthy        ConditionReason = "ComponentsNotHealthy"
+	ConditionReasonComponentsNotHealthyMessage                 = "Some Istio components are not healthy. Check status.components"
 
 	// install / uninstall.
-	ConditionReasonIstioInstallNotNeeded               ConditionReason = "IstioInstallNotNeeded"
-	ConditionReasonIstioInstallNotNeededMessage                        = "Istio installation is not needed"
-	ConditionReasonIstioInstallSucceeded               ConditionReason = "IstioInstallSucceeded"
-	ConditionReasonIstioInstallSucceededMessage                        = "Istio installation succeeded"
-	ConditionReasonIstioUninstallSucceeded             ConditionReason = "IstioUninstallSucceeded"
-	ConditionReasonIstioUninstallSucceededMessage                      = "Istio uninstallation succeded"
-	ConditionReasonIstioInstallUninstallFailed         ConditionReason = "IstioInstallUninstallFailed"
-	ConditionReasonIstioInstallUninstallFailedMessage                  = "Istio install or uninstall failed"
-	ConditionReasonCustomResourceMisconfigured         ConditionReason = "IstioCustomResourceMisconfigured"
-	ConditionReasonCustomResourceMisconfiguredMessage                  = "Istio custom resource has invalid configuration"
-	ConditionReasonIstioCRsDangling                    ConditionReason = "IstioCustomResourcesDangling"
-	ConditionReasonIstioCRsDanglingMessage                             = "Istio deletion blocked because of existing Istio custom resources"
-	ConditionReasonIstioVersionUpdateNotAllowed        ConditionReason = "IstioVersionUpdateNotAllowed"
-	ConditionReasonIstioVersionUpdateNotAllowedMessage                 = "Update to the new Istio version is not allowed"
+	ConditionReasonIstioInstallNotNeeded                    ConditionReason = "IstioInstallNotNeeded"
+	ConditionReasonIstioInstallNotNeededMessage                             = "Istio installation is not needed"
+	ConditionReasonIstioInstallSucceeded                    ConditionReason = "IstioInstallSucceeded"
+	ConditionReasonIstioInstallSucceededMessage                             = "Istio installation succeeded"
+	ConditionReasonIstioUninstallSucceeded                  ConditionReason = "IstioUninstallSucceeded"
+	ConditionReasonIstioUninstallSucceededMessage                           = "Istio uninstallation succeded"
+	ConditionReasonIstioInstallUninstallFailed              ConditionReason = "IstioInstallUninstallFailed"
+	ConditionReasonIstioInstallUninstallFailedMessage                       = "Istio install or uninstall failed"
+	ConditionReasonCustomResourceMisconfigured              ConditionReason = "IstioCustomResourceMisconfigured"
+	ConditionReasonCustomResourceMisconfiguredMessage                       = "Istio custom resource has invalid configuration"
+	ConditionReasonIstioCRsDangling                         ConditionReason = "IstioCustomResourcesDangling"
+	ConditionReasonIstioCRsDanglingMessage                                  = "Istio deletion blocked because of existing Istio custom resources"
+	ConditionReasonIstioVersionUpdateNotAllowed             ConditionReason = "IstioVersionUpdateNotAllowed"
+	ConditionReasonIstioVersionUpdateNotAllowedMessage                      = "Update to the new Istio version is not allowed"
+	ConditionReasonIstioVersionChangePreflightFailed        ConditionReason = "IstioVersionChangePreflightFailed"
+	ConditionReasonIstioVersionChangePreflightFailedMessage                 = "Preflight checks of the approved Istio version change failed"
+	ConditionReasonPluginCAInvalid                          ConditionReason = "PluginCAInvalid"
+	ConditionReasonPluginCAInvalidMessage                                   = "Plug-in CA certificates cannot be applied"
+
+	// install / uninstall Events, no condition reflects these milestones.
+	ConditionReasonIstioInstallStarted ConditionReason = "IstioInstallStarted"
+	ConditionReasonIstioVersionUpdate  ConditionReason = "IstioVersionUpdate"
 
 	// Istio CRs.
 	ConditionReasonCRsReconcileSucceeded        ConditionReason = "CustomResourcesReconcileSucceeded"
@@ -103,6 +118,46 @@ const (
 	ConditionReasonIngressTargetingUserResourceNotFoundMessage                        = "Resources targeting Istio Ingress Gateway not found"
 	ConditionReasonIngressTargetingUserResourceDetectionFailed        ConditionReason = "IngressTargetingUserResourceDetectionFailed"
 	ConditionReasonIngressTargetingUserResourceDetectionFailedMessage                 = "Resource targeting Istio Ingress Gateway detection failed"
+
+	// outbound traffic.
+	ConditionReasonServiceEntriesNotFound               ConditionReason = "ServiceEntriesNotFound"
+	ConditionReasonServiceEntriesNotFoundMessage                        = "Outbound traffic policy is REGISTRY_ONLY, but no ServiceEntry exists. Traffic to all external hosts is blocked"
+	ConditionReasonServiceEntriesFound                  ConditionReason = "ServiceEntriesFound"
+	ConditionReasonServiceEntriesFoundMessage                           = "Outbound traffic policy is REGISTRY_ONLY and ServiceEntries exist"
+	ConditionReasonServiceEntriesDetectionFailed        ConditionReason = "ServiceEntriesDetectionFailed"
+	ConditionReasonServiceEntriesDetectionFailedMessage                 = "ServiceEntries detection failed"
+
+	// ingress gateway service.
+	ConditionReasonProviderServiceAnnotationsOverridden           ConditionReason = "ProviderServiceAnnotationsOverridden"
+	ConditionReasonProviderServiceAnnotationsOverriddenMessage                    = "Istio Ingress Gateway Service annotations override annotations detected for the cluster provider"
+	ConditionReasonProviderServiceAnnotationsNotOverridden        ConditionReason = "ProviderServiceAnnotationsNotOverridden"
+	ConditionReasonProviderServiceAnnotationsNotOverriddenMessage                 = "Istio Ingress Gateway Service annotations do not override annotations detected for the cluster provider"
+
+	// Canary upgrade.
+	ConditionReasonCanaryRevisionInstalled        ConditionReason = "CanaryRevisionInstalled"
+	ConditionReasonCanaryRevisionInstalledMessage                 = "New Istio revision is installed next to the current revision"
+	ConditionReasonCanaryNamespaceMigrated        ConditionReason = "CanaryNamespaceMigrated"
+	ConditionReasonCanaryNamespaceMigratedMessage                 = "Namespace is moved to the new Istio revision"
+	ConditionReasonCanaryWaitingForProxies        ConditionReason = "CanaryWaitingForProxies"
+	ConditionReasonCanaryWaitingForProxiesMessage                 = "Waiting for all Istio proxies to be restarted with the new Istio revision"
+	ConditionReasonCanaryUpgradeSucceeded         ConditionReason = "CanaryUpgradeSucceeded"
+	ConditionReasonCanaryUpgradeSucceededMessage                  = "Canary upgrade succeeded and the previous Istio revision is removed"
+
+	// CA certificates.
+	ConditionReasonCACertificatesValid                 ConditionReason = "CACertificatesValid"
+	ConditionReasonCACertificatesValidMessage                          = "Root and intermediate CA certificates of the mesh are valid"
+	ConditionReasonCACertificatesExpiringSoon          ConditionReason = "CACertificatesExpiringSoon"
+	ConditionReasonCACertificatesExpiringSoonMessage                   = "A CA certificate of the mesh expires soon"
+	ConditionReasonCACertificatesExpiryCritical        ConditionReason = "CACertificatesExpiryCritical"
+	ConditionReasonCACertificatesExpiryCriticalMessage                 = "A CA certificate of the mesh is about to expire"
+	ConditionReasonCACertificatesCheckFailed           ConditionReason = "CACertificatesCheckFailed"
+	ConditionReasonCACertificatesCheckFailedMessage                    = "Expiry of the CA certificates of the mesh could not be checked"
+	ConditionReasonRootCARotationInProgress            ConditionReason = "RootCARotationInProgress"
+	ConditionReasonRootCARotationInProgressMessage                     = "Root CA rotation is in progress"
+	ConditionReasonRootCARotationSucceeded             ConditionReason = "RootCARotationSucceeded"
+	ConditionReasonRootCARotationSucceededMessage                      = "Root CA rotation succeeded and the old root CA is removed"
+	ConditionReasonRootCARotationFailed                ConditionReason = "RootCARotationFailed"
+	ConditionReasonRootCARotationFailedMessage                         = "Root CA rotation failed"
 )
 
 type ReasonWithMessage struct {
@@ -120,6 +175,18 @@ type IstioSpec struct {
 	Experimental *Experimental `json:"experimental,omitempty"`
 	// +kubebuilder:validation:Optional
 	CompatibilityMode bool `json:"compatibilityMode,omitempty"`
+	// Defines the data plane mode of the service mesh. In the "ambient" mode, the ztunnel component is installed
+	// and workloads in namespaces labeled with istio.io/dataplane-mode=ambient are part of the mesh without sidecar proxies.
+	// If not specified, "sidecar" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=sidecar;ambient
+	DataPlaneMode DataPlaneMode `json:"dataPlaneMode,omitempty"`
+	// Defines how Istio is upgraded to a new version. With "InPlace", the running Istio control plane is upgraded directly.
+	// With "Canary", the new version is installed as a separate revision and workloads are moved to it namespace by namespace.
+	// The previous revision is removed after all proxies run the new version. If not specified, "InPlace" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=InPlace;Canary
+	UpgradeStrategy UpgradeStrategy `json:"upgradeStrategy,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -157,6 +224,68 @@ type IstioStatus struct {
 	Conditions *[]metav1.Condition `json:"conditions,omitempty"`
 	// Description of Istio status
 	Description string `json:"description,omitempty"`
+	// Number of workloads running in each data plane mode.
+	DataPlane *DataPlaneStatus `json:"dataPlane,omitempty"`
+	// Health of the Istio workloads managed by the module.
+	Components []ComponentStatus `json:"components,omitempty"`
+	// Workloads whose Istio sidecar proxies could not be restarted by the module in the last reconciliation and must be restarted manually.
+	ManualRestartWorkloads []ManualRestartWorkload `json:"manualRestartWorkloads,omitempty"`
+}
+
+// DataPlaneStatus defines the number of Pods running in each data plane mode.
+type DataPlaneStatus struct {
+	// Number of Pods running with an Istio sidecar proxy.
+	SidecarWorkloads int `json:"sidecarWorkloads"`
+	// Number of Pods that are part of the ambient mesh.
+	AmbientWorkloads int `json:"ambientWorkloads"`
+}
+
+type ComponentHealth string
+
+const (
+	// ComponentHealthy means that all desired replicas of the component are ready.
+	ComponentHealthy ComponentHealth = "Healthy"
+	// ComponentDegraded means that some, but not all desired replicas of the component are ready.
+	ComponentDegraded ComponentHealth = "Degraded"
+	// ComponentUnavailable means that no desired replica of the component is ready.
+	ComponentUnavailable ComponentHealth = "Unavailable"
+)
+
+// ComponentStatus defines the health of an Istio workload managed by the module, for example istiod or the ingress gateway.
+type ComponentStatus struct {
+	// Name of the Deployment or DaemonSet of the component.
+	Name string `json:"name"`
+	// Kind of the workload of the component, either Deployment or DaemonSet.
+	Kind string `json:"kind"`
+	// Number of replicas that the component should run. For a DaemonSet, the number of nodes that should run the component.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	// Number of ready replicas of the component.
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// Istio version of the component image.
+	Version string `json:"version,omitempty"`
+	// Health of the component. Value can be one of ("Healthy", "Degraded", "Unavailable").
+	// +kubebuilder:validation:Enum=Healthy;Degraded;Unavailable
+	Health ComponentHealth `json:"health"`
+}
+
+// ManualRestartWorkload defines a workload whose Istio sidecar proxies could not be restarted by the module.
+type ManualRestartWorkload struct {
+	// Name of the workload.
+	Name string `json:"name"`
+	// Namespace of the workload.
+	Namespace string `json:"namespace"`
+	// Kind of the workload, for example Deployment, Job, or Pod.
+	Kind string `json:"kind"`
+	// Reason why the sidecar proxies could not be restarted.
+	// Value can be one of ("OwnerNotFound", "OwnedByJob", "OwnedByCronJob", "NotReadyReplicaSetExists", "RestartFailed").
+	Reason string `json:"reason"`
+	// Details why the sidecar proxies could not be restarted.
+	Message string `json:"message,omitempty"`
+}
+
+// IsHealthy returns true if all desired replicas of the component are ready.
+func (c ComponentStatus) IsHealthy() bool {
+	return c.Health == ComponentHealthy
 }
 
 //nolint:gochecknoinits // this is a scaffolded file. TODO: remove init function
@@ -0,0 +1,83 @@
+package v1alpha2
+
+// LocalityLoadBalancing defines locality-aware load balancing of the mesh. The locality of a workload is derived from the
+// topology.kubernetes.io/region and topology.kubernetes.io/zone labels and the topology.istio.io/subzone label of its node,
+// and has the format "region/zone/subzone".
+type LocalityLoadBalancing struct {
+	// Enables locality-aware load balancing. Traffic is kept in the locality of the client as long as healthy endpoints are available there.
+	// Failover requires outlier detection to be configured in the DestinationRule of the service.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// Defines the region that traffic is sent to when the endpoints in the region of the client are unhealthy.
+	// Cannot be combined with distribute.
+	// +kubebuilder:validation:Optional
+	Failover []LocalityFailover `json:"failover,omitempty"`
+
+	// Defines how the traffic of clients in a locality is distributed across localities.
+	// Cannot be combined with failover.
+	// +kubebuilder:validation:Optional
+	Distribute []LocalityDistribute `json:"distribute,omitempty"`
+}
+
+type LocalityFailover struct {
+	// The region of the clients.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+
+	// The region that the traffic fails over to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	To string `json:"to"`
+}
+
+type LocalityDistribute struct {
+	// The locality of the clients, for example "us-west/zone1/*". The wildcard "*" matches all localities on its level.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+
+	// Defines the localities that receive the traffic and the percentage of the traffic each of them receives.
+	// The percentages must add up to 100.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	To map[string]uint32 `json:"to"`
+}
+
+func (m *meshConfigBuilder) BuildLocalityLoadBalancing(localityLoadBalancing *LocalityLoadBalancing) *meshConfigBuilder {
+	if localityLoadBalancing == nil {
+		return m
+	}
+
+	setting := map[string]interface{}{
+		"enabled": localityLoadBalancing.Enabled,
+	}
+
+	if len(localityLoadBalancing.Failover) > 0 {
+		failover := make([]interface{}, 0, len(localityLoadBalancing.Failover))
+		for _, f := range localityLoadBalancing.Failover {
+			failover = append(failover, map[string]interface{}{"from": f.From, "to": f.To})
+		}
+		setting["failover"] = failover
+	}
+
+	if len(localityLoadBalancing.Distribute) > 0 {
+		distribute := make([]interface{}, 0, len(localityLoadBalancing.Distribute))
+		for _, d := range localityLoadBalancing.Distribute {
+			to := make(map[string]interface{}, len(d.To))
+			for locality, weight := range d.To {
+				to[locality] = weight
+			}
+			distribute = append(distribute, map[string]interface{}{"from": d.From, "to": to})
+		}
+		setting["distribute"] = distribute
+	}
+
+	err := m.c.SetPath("localityLbSetting", setting)
+	if err != nil {
+		return nil
+	}
+
+	return m
+}
@@ -3,6 +3,7 @@ package v1alpha2_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	istiov1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
 
@@ -14,6 +15,7 @@ import (
 	"istio.io/istio/operator/pkg/values"
 	"istio.io/istio/pkg/config/mesh"
 	"istio.io/istio/pkg/util/protomarshal"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -287,219 +289,552 @@ var _ = Describe("Merge", func() {
 
 			Expect(foundAuthorizer).To(BeTrue(), "Could not find the authorizer by the name")
 		})
-	})
 
-	It("should update numTrustedProxies on IstioOperator from 1 to 5", func() {
-		// given
-		m := mesh.DefaultMeshConfig()
-		m.DefaultConfig.GatewayTopology = &meshv1alpha1.Topology{NumTrustedProxies: 1}
-		meshConfigRaw := convert(m)
+		It("should set pathPrefix and timeout for authorizer", func() {
+			// given
+			m := mesh.DefaultMeshConfig()
+			meshConfigRaw := convert(m)
 
-		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{
-				MeshConfig: meshConfigRaw,
-			},
-		}
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: meshConfigRaw,
+				},
+			}
 
-		numProxies := 5
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+			provName := "test-authorizer"
 
-		// when
-		out, err := istioCR.MergeInto(iop)
+			authorizer := istiov1alpha2.Authorizer{
+				Name:       provName,
+				Service:    "xauth",
+				Port:       1337,
+				PathPrefix: ptr.To("/auth"),
+				Timeout:    &metav1.Duration{Duration: 1500 * time.Millisecond},
+			}
 
-		// then
-		Expect(err).ShouldNot(HaveOccurred())
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{Authorizers: []*istiov1alpha2.Authorizer{
+				&authorizer,
+			}}}}
 
-		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
-		Expect(err).ShouldNot(HaveOccurred())
+			// when
+			out, err := istioCR.MergeInto(iop)
 
-		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
-		Expect(exists).To(BeTrue())
-		Expect(numTrustedProxies).To(Equal(float64(5)))
-	})
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
 
-	It("should set numTrustedProxies on IstioOperator to 5 when no GatewayTopology is configured", func() {
-		// given
-		m := mesh.DefaultMeshConfig()
-		meshConfigRaw := convert(m)
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
 
-		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{
-				MeshConfig: meshConfigRaw,
-			},
-		}
+			extensionProvidersInt, exists := meshConfig.GetPath("extensionProviders")
+			Expect(exists).To(BeTrue())
 
-		numProxies := 5
+			extensionProviders := extensionProvidersInt.([]interface{})
 
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+			var foundAuthorizer bool
+			for _, extensionProviderInt := range extensionProviders {
+				extensionProvider, ok := extensionProviderInt.(map[string]interface{})
+				Expect(ok).To(BeTrue())
 
-		// when
-		out, err := istioCR.MergeInto(iop)
+				if extensionProvider["name"] == provName {
+					extensionProviderMap, errMap := values.MapFromObject(extensionProvider)
+					Expect(errMap).ShouldNot(HaveOccurred())
 
-		// then
-		Expect(err).ShouldNot(HaveOccurred())
+					authProvider, okGetPath := extensionProviderMap.GetPathMap("envoyExtAuthzHttp")
+					Expect(okGetPath).To(BeTrue())
 
-		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
-		Expect(err).ShouldNot(HaveOccurred())
+					Expect(authProvider).ShouldNot(BeNil())
+					Expect(authProvider["pathPrefix"]).To(Equal("/auth"))
+					Expect(authProvider["timeout"]).To(Equal("1.500s"))
 
-		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
-		Expect(exists).To(BeTrue())
-		Expect(numTrustedProxies).To(Equal(float64(numProxies)))
-	})
+					foundAuthorizer = true
+					break
+				}
+			}
 
-	It("should set numTrustedProxies on IstioOperator to 5 when IstioOperator has nil spec", func() {
-		// given
-		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{},
-		}
+			Expect(foundAuthorizer).To(BeTrue(), "Could not find the authorizer by the name")
+		})
 
-		numProxies := 5
+		It("should set gRPC authorizer", func() {
+			// given
+			m := mesh.DefaultMeshConfig()
+			meshConfigRaw := convert(m)
 
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: meshConfigRaw,
+				},
+			}
 
-		// when
-		out, err := istioCR.MergeInto(iop)
+			provName := "test-grpc-authorizer"
 
-		// then
-		Expect(err).ShouldNot(HaveOccurred())
+			authorizer := istiov1alpha2.Authorizer{
+				Name:     provName,
+				Service:  "opa",
+				Port:     9191,
+				Protocol: istiov1alpha2.AuthorizerProtocolGRPC,
+				Timeout:  &metav1.Duration{Duration: 2 * time.Second},
+				FailOpen: ptr.To(true),
+				IncludeRequestBodyInCheck: &istiov1alpha2.RequestBody{
+					MaxRequestBytes:     4096,
+					AllowPartialMessage: true,
+				},
+			}
 
-		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
-		Expect(err).ShouldNot(HaveOccurred())
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{Authorizers: []*istiov1alpha2.Authorizer{
+				&authorizer,
+			}}}}
 
-		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
-		Expect(exists).To(BeTrue())
-		Expect(numTrustedProxies).To(Equal(float64(numProxies)))
-	})
+			// when
+			out, err := istioCR.MergeInto(iop)
 
-	It("should set numTrustedProxies on IstioOperator to 5 when IstioOperator has nil mesh config", func() {
-		// given
-		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{
-				MeshConfig: nil,
-			},
-		}
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
 
-		numProxies := 5
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
 
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+			extensionProvidersInt, exists := meshConfig.GetPath("extensionProviders")
+			Expect(exists).To(BeTrue())
 
-		// when
-		out, err := istioCR.MergeInto(iop)
+			extensionProviders := extensionProvidersInt.([]interface{})
 
-		// then
-		Expect(err).ShouldNot(HaveOccurred())
+			var foundAuthorizer bool
+			for _, extensionProviderInt := range extensionProviders {
+				extensionProvider, ok := extensionProviderInt.(map[string]interface{})
+				Expect(ok).To(BeTrue())
 
-		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
-		Expect(err).ShouldNot(HaveOccurred())
+				if extensionProvider["name"] == provName {
+					extensionProviderMap, errMap := values.MapFromObject(extensionProvider)
+					Expect(errMap).ShouldNot(HaveOccurred())
 
-		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
-		Expect(exists).To(BeTrue())
-		Expect(numTrustedProxies).To(Equal(float64(numProxies)))
-	})
+					_, isHTTP := extensionProviderMap.GetPathMap("envoyExtAuthzHttp")
+					Expect(isHTTP).To(BeFalse())
 
-	It("should change nothing if config is empty", func() {
-		// given
-		m := mesh.DefaultMeshConfig()
-		m.DefaultConfig.GatewayTopology = &meshv1alpha1.Topology{NumTrustedProxies: 1}
-		meshConfigRaw := convert(m)
+					authProvider, okGetPath := extensionProviderMap.GetPathMap("envoyExtAuthzGrpc")
+					Expect(okGetPath).To(BeTrue())
 
-		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{
-				MeshConfig: meshConfigRaw,
-			},
-		}
+					Expect(authProvider).ShouldNot(BeNil())
+					Expect(authProvider["port"]).To(BeEquivalentTo(9191))
+					Expect(authProvider["service"]).To(Equal("opa"))
+					Expect(authProvider["timeout"]).To(Equal("2s"))
+					Expect(authProvider["failOpen"]).To(BeTrue())
+					Expect(authProvider["includeRequestBodyInCheck"]).To(HaveKeyWithValue("maxRequestBytes", BeEquivalentTo(4096)))
+					Expect(authProvider["includeRequestBodyInCheck"]).To(HaveKeyWithValue("allowPartialMessage", true))
 
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{}}
+					foundAuthorizer = true
+					break
+				}
+			}
 
-		// when
-		out, err := istioCR.MergeInto(iop)
+			Expect(foundAuthorizer).To(BeTrue(), "Could not find the authorizer by the name")
+		})
 
-		// then
-		Expect(err).ShouldNot(HaveOccurred())
+		It("should set failOpen for HTTP authorizer", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
 
-		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
-		Expect(err).ShouldNot(HaveOccurred())
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{Authorizers: []*istiov1alpha2.Authorizer{
+				{
+					Name:     "test-authorizer",
+					Service:  "xauth",
+					Port:     1337,
+					FailOpen: ptr.To(true),
+				},
+			}}}}
 
-		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
-		Expect(exists).To(BeTrue())
-		Expect(numTrustedProxies).To(Equal(float64(1)))
-	})
-	It("should set numTrustedProxies on IstioOperator to 5 when there is no defaultConfig in meshConfig", func() {
-		// given
-		m := &meshv1alpha1.MeshConfig{
-			EnableTracing: true,
-		}
-		meshConfigRaw := convert(m)
+			// when
+			out, err := istioCR.MergeInto(iop)
 
-		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{
-				MeshConfig: meshConfigRaw,
-			},
-		}
-		numProxies := 5
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
 
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+			authProvider := getExtensionProvider(out, "test-authorizer", "envoyExtAuthzHttp")
+			Expect(authProvider["failOpen"]).To(BeTrue())
+		})
 
-		// when
-		out, err := istioCR.MergeInto(iop)
+		It("should set statusOnError for HTTP authorizer", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
 
-		// then
-		Expect(err).ShouldNot(HaveOccurred())
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{Authorizers: []*istiov1alpha2.Authorizer{
+				{
+					Name:          "test-authorizer",
+					Service:       "xauth",
+					Port:          1337,
+					StatusOnError: ptr.To("503"),
+				},
+			}}}}
 
-		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
-		Expect(err).ShouldNot(HaveOccurred())
+			// when
+			out, err := istioCR.MergeInto(iop)
 
-		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
-		Expect(exists).To(BeTrue())
-		Expect(numTrustedProxies).To(Equal(float64(5)))
-	})
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
 
-	It("should set prometheusMerge on IstioOperator Telemetry Metrics to true when meshConfig has a enablePrometheusMerge with true", func() {
-		// given
-		m := &meshv1alpha1.MeshConfig{
-			EnablePrometheusMerge: wrapperspb.Bool(false),
-		}
-		meshConfigRaw := convert(m)
+			authProvider := getExtensionProvider(out, "test-authorizer", "envoyExtAuthzHttp")
+			Expect(authProvider["statusOnError"]).To(Equal("503"))
+			Expect(authProvider).ToNot(HaveKey("failOpen"))
+		})
 
-		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{
-				MeshConfig: meshConfigRaw,
-			},
-		}
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
-			Telemetry: istiov1alpha2.Telemetry{
-				Metrics: istiov1alpha2.Metrics{
-					PrometheusMerge: true,
+		It("should set includeRequestBodyInCheck for HTTP authorizer", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
 				},
-			},
-		}}}
+			}
 
-		// when
-		out, err := istioCR.MergeInto(iop)
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{Authorizers: []*istiov1alpha2.Authorizer{
+				{
+					Name:    "test-authorizer",
+					Service: "xauth",
+					Port:    1337,
+					IncludeRequestBodyInCheck: &istiov1alpha2.RequestBody{
+						MaxRequestBytes:     8192,
+						AllowPartialMessage: true,
+					},
+				},
+			}}}}
 
-		// then
-		Expect(err).ShouldNot(HaveOccurred())
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			authProvider := getExtensionProvider(out, "test-authorizer", "envoyExtAuthzHttp")
+			Expect(authProvider["includeRequestBodyInCheck"]).To(HaveKeyWithValue("maxRequestBytes", BeEquivalentTo(8192)))
+			Expect(authProvider["includeRequestBodyInCheck"]).To(HaveKeyWithValue("allowPartialMessage", true))
+		})
+
+		It("should set statusOnError for gRPC authorizer", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{Authorizers: []*istiov1alpha2.Authorizer{
+				{
+					Name:          "test-authorizer",
+					Service:       "opa",
+					Port:          9191,
+					Protocol:      istiov1alpha2.AuthorizerProtocolGRPC,
+					StatusOnError: ptr.To("500"),
+				},
+			}}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			authProvider := getExtensionProvider(out, "test-authorizer", "envoyExtAuthzGrpc")
+			Expect(authProvider["statusOnError"]).To(Equal("500"))
+		})
+
+		It("should not set pathPrefix and timeout for authorizer when they are not configured", func() {
+			// given
+			m := mesh.DefaultMeshConfig()
+			meshConfigRaw := convert(m)
+
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: meshConfigRaw,
+				},
+			}
+
+			provName := "test-authorizer"
+
+			authorizer := istiov1alpha2.Authorizer{
+				Name:    provName,
+				Service: "xauth",
+				Port:    1337,
+			}
+
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{Authorizers: []*istiov1alpha2.Authorizer{
+				&authorizer,
+			}}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			extensionProvidersInt, exists := meshConfig.GetPath("extensionProviders")
+			Expect(exists).To(BeTrue())
+
+			extensionProviders := extensionProvidersInt.([]interface{})
+
+			var foundAuthorizer bool
+			for _, extensionProviderInt := range extensionProviders {
+				extensionProvider, ok := extensionProviderInt.(map[string]interface{})
+				Expect(ok).To(BeTrue())
+
+				if extensionProvider["name"] == provName {
+					extensionProviderMap, errMap := values.MapFromObject(extensionProvider)
+					Expect(errMap).ShouldNot(HaveOccurred())
+
+					authProvider, okGetPath := extensionProviderMap.GetPathMap("envoyExtAuthzHttp")
+					Expect(okGetPath).To(BeTrue())
+
+					Expect(authProvider).ShouldNot(BeNil())
+					Expect(authProvider).ToNot(HaveKey("pathPrefix"))
+					Expect(authProvider).ToNot(HaveKey("timeout"))
+
+					foundAuthorizer = true
+					break
+				}
+			}
+
+			Expect(foundAuthorizer).To(BeTrue(), "Could not find the authorizer by the name")
+		})
+	})
+
+	Context("AccessLog", func() {
+		getLabels := func(op iopv1alpha1.IstioOperator, name, providerType string) map[string]interface{} {
+			provider := getExtensionProvider(op, name, providerType)
+			providerMap, err := values.MapFromObject(provider)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			labels, found := providerMap.GetPathMap("logFormat.labels")
+			Expect(found).To(BeTrue())
+			return labels
+		}
+
+		It("should set default labels for default loggers when access log is not configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fileLabels := getLabels(out, istiov1alpha2.DefaultAccessLogProviderName, "envoyFileAccessLog")
+			Expect(fileLabels).To(HaveLen(26))
+			Expect(fileLabels).To(HaveKeyWithValue("method", "%REQ(:METHOD)%"))
+			Expect(fileLabels).To(HaveKeyWithValue("upstream_transport_failure_reason", "%UPSTREAM_TRANSPORT_FAILURE_REASON%"))
+
+			otelLabels := getLabels(out, istiov1alpha2.DefaultOtelAccessLogProviderName, "envoyOtelAls")
+			Expect(otelLabels).To(Equal(fileLabels))
+
+			otelProvider := getExtensionProvider(out, istiov1alpha2.DefaultOtelAccessLogProviderName, "envoyOtelAls")
+			Expect(otelProvider["service"]).To(Equal("telemetry-otlp-logs.kyma-system.svc.cluster.local"))
+			Expect(otelProvider["port"]).To(BeEquivalentTo(4317))
+		})
+
+		It("should merge labels with default labels when strategy is merge", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				AccessLog: &istiov1alpha2.AccessLog{
+					Strategy: istiov1alpha2.AccessLogStrategyMerge,
+					Labels: map[string]string{
+						"tenant": "%REQ(X-TENANT)%",
+						"method": "%REQ(X-METHOD)%",
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for name, providerType := range map[string]string{
+				istiov1alpha2.DefaultAccessLogProviderName:     "envoyFileAccessLog",
+				istiov1alpha2.DefaultOtelAccessLogProviderName: "envoyOtelAls",
+			} {
+				labels := getLabels(out, name, providerType)
+				Expect(labels).To(HaveLen(27))
+				Expect(labels).To(HaveKeyWithValue("tenant", "%REQ(X-TENANT)%"))
+				Expect(labels).To(HaveKeyWithValue("method", "%REQ(X-METHOD)%"))
+				Expect(labels).To(HaveKeyWithValue("path", "%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%"))
+			}
+		})
+
+		It("should merge labels with default labels when strategy is not set", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				AccessLog: &istiov1alpha2.AccessLog{
+					Labels: map[string]string{"tenant": "%REQ(X-TENANT)%"},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			labels := getLabels(out, istiov1alpha2.DefaultAccessLogProviderName, "envoyFileAccessLog")
+			Expect(labels).To(HaveLen(27))
+			Expect(labels).To(HaveKeyWithValue("tenant", "%REQ(X-TENANT)%"))
+		})
+
+		It("should replace default labels when strategy is replace", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				AccessLog: &istiov1alpha2.AccessLog{
+					Strategy: istiov1alpha2.AccessLogStrategyReplace,
+					Labels:   map[string]string{"tenant": "%REQ(X-TENANT)%"},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for name, providerType := range map[string]string{
+				istiov1alpha2.DefaultAccessLogProviderName:     "envoyFileAccessLog",
+				istiov1alpha2.DefaultOtelAccessLogProviderName: "envoyOtelAls",
+			} {
+				labels := getLabels(out, name, providerType)
+				Expect(labels).To(Equal(map[string]interface{}{"tenant": "%REQ(X-TENANT)%"}))
+			}
+		})
+
+		It("should ignore labels with empty values and apply default labels when no labels are left", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				AccessLog: &istiov1alpha2.AccessLog{
+					Strategy: istiov1alpha2.AccessLogStrategyReplace,
+					Labels:   map[string]string{"tenant": ""},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			labels := getLabels(out, istiov1alpha2.DefaultAccessLogProviderName, "envoyFileAccessLog")
+			Expect(labels).To(HaveLen(26))
+			Expect(labels).ToNot(HaveKey("tenant"))
+		})
+
+		It("should replace existing default logger providers instead of adding duplicates", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				AccessLog: &istiov1alpha2.AccessLog{
+					Labels: map[string]string{"tenant": "%REQ(X-TENANT)%"},
+				},
+			}}}
+			defaultIstioCR := istiov1alpha2.Istio{}
+			firstOut, err := defaultIstioCR.MergeInto(iop)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// when
+			out, err := istioCR.MergeInto(firstOut)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var count int
+			for _, provider := range values.TryGetPathAs[[]interface{}](meshConfig, "extensionProviders") {
+				if provider.(map[string]interface{})["name"] == istiov1alpha2.DefaultAccessLogProviderName {
+					count++
+				}
+			}
+			Expect(count).To(Equal(1))
+			Expect(getLabels(out, istiov1alpha2.DefaultAccessLogProviderName, "envoyFileAccessLog")).To(HaveKey("tenant"))
+		})
+	})
+
+	It("should update numTrustedProxies on IstioOperator from 1 to 5", func() {
+		// given
+		m := mesh.DefaultMeshConfig()
+		m.DefaultConfig.GatewayTopology = &meshv1alpha1.Topology{NumTrustedProxies: 1}
+		meshConfigRaw := convert(m)
+
+		iop := iopv1alpha1.IstioOperator{
+			Spec: iopv1alpha1.IstioOperatorSpec{
+				MeshConfig: meshConfigRaw,
+			},
+		}
+
+		numProxies := 5
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+
+		// when
+		out, err := istioCR.MergeInto(iop)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
 
 		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
 		Expect(err).ShouldNot(HaveOccurred())
 
-		enabledPrometheusMerge, exists := meshConfig.GetPath("enablePrometheusMerge")
+		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
 		Expect(exists).To(BeTrue())
-		Expect(enabledPrometheusMerge).To(BeTrue())
-
+		Expect(numTrustedProxies).To(Equal(float64(5)))
 	})
 
-	It("should create IngressGateway overlay with externalTrafficPolicy set to Local", func() {
+	It("should set numTrustedProxies on IstioOperator to 5 when no GatewayTopology is configured", func() {
 		// given
+		m := mesh.DefaultMeshConfig()
+		meshConfigRaw := convert(m)
 
 		iop := iopv1alpha1.IstioOperator{
-			Spec: iopv1alpha1.IstioOperatorSpec{},
-		}
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{
-			Config: istiov1alpha2.Config{
-				GatewayExternalTrafficPolicy: ptr.To("Local"),
+			Spec: iopv1alpha1.IstioOperatorSpec{
+				MeshConfig: meshConfigRaw,
 			},
-		}}
+		}
+
+		numProxies := 5
+
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
 
 		// when
 		out, err := istioCR.MergeInto(iop)
@@ -507,21 +842,189 @@ var _ = Describe("Merge", func() {
 		// then
 		Expect(err).ShouldNot(HaveOccurred())
 
-		externalTrafficPolicy := out.Spec.Components.IngressGateways[0].Kubernetes.Overlays[0].Patches[0].Value.(*structpb.Value).GetStringValue()
-		Expect(externalTrafficPolicy).To(Equal("Local"))
+		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
+		Expect(exists).To(BeTrue())
+		Expect(numTrustedProxies).To(Equal(float64(numProxies)))
 	})
 
-	It("should create IngressGateway overlay with externalTrafficPolicy set to Cluster", func() {
+	It("should set numTrustedProxies on IstioOperator to 5 when IstioOperator has nil spec", func() {
 		// given
-
 		iop := iopv1alpha1.IstioOperator{
 			Spec: iopv1alpha1.IstioOperatorSpec{},
 		}
-		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{
-			Config: istiov1alpha2.Config{
-				GatewayExternalTrafficPolicy: ptr.To("Cluster"),
-			},
-		}}
+
+		numProxies := 5
+
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+
+		// when
+		out, err := istioCR.MergeInto(iop)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
+		Expect(exists).To(BeTrue())
+		Expect(numTrustedProxies).To(Equal(float64(numProxies)))
+	})
+
+	It("should set numTrustedProxies on IstioOperator to 5 when IstioOperator has nil mesh config", func() {
+		// given
+		iop := iopv1alpha1.IstioOperator{
+			Spec: iopv1alpha1.IstioOperatorSpec{
+				MeshConfig: nil,
+			},
+		}
+
+		numProxies := 5
+
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+
+		// when
+		out, err := istioCR.MergeInto(iop)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
+		Expect(exists).To(BeTrue())
+		Expect(numTrustedProxies).To(Equal(float64(numProxies)))
+	})
+
+	It("should change nothing if config is empty", func() {
+		// given
+		m := mesh.DefaultMeshConfig()
+		m.DefaultConfig.GatewayTopology = &meshv1alpha1.Topology{NumTrustedProxies: 1}
+		meshConfigRaw := convert(m)
+
+		iop := iopv1alpha1.IstioOperator{
+			Spec: iopv1alpha1.IstioOperatorSpec{
+				MeshConfig: meshConfigRaw,
+			},
+		}
+
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{}}
+
+		// when
+		out, err := istioCR.MergeInto(iop)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
+		Expect(exists).To(BeTrue())
+		Expect(numTrustedProxies).To(Equal(float64(1)))
+	})
+	It("should set numTrustedProxies on IstioOperator to 5 when there is no defaultConfig in meshConfig", func() {
+		// given
+		m := &meshv1alpha1.MeshConfig{
+			EnableTracing: true,
+		}
+		meshConfigRaw := convert(m)
+
+		iop := iopv1alpha1.IstioOperator{
+			Spec: iopv1alpha1.IstioOperatorSpec{
+				MeshConfig: meshConfigRaw,
+			},
+		}
+		numProxies := 5
+
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{NumTrustedProxies: &numProxies}}}
+
+		// when
+		out, err := istioCR.MergeInto(iop)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		numTrustedProxies, exists := meshConfig.GetPath("defaultConfig.gatewayTopology.numTrustedProxies")
+		Expect(exists).To(BeTrue())
+		Expect(numTrustedProxies).To(Equal(float64(5)))
+	})
+
+	It("should set prometheusMerge on IstioOperator Telemetry Metrics to true when meshConfig has a enablePrometheusMerge with true", func() {
+		// given
+		m := &meshv1alpha1.MeshConfig{
+			EnablePrometheusMerge: wrapperspb.Bool(false),
+		}
+		meshConfigRaw := convert(m)
+
+		iop := iopv1alpha1.IstioOperator{
+			Spec: iopv1alpha1.IstioOperatorSpec{
+				MeshConfig: meshConfigRaw,
+			},
+		}
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+			Telemetry: istiov1alpha2.Telemetry{
+				Metrics: istiov1alpha2.Metrics{
+					PrometheusMerge: true,
+				},
+			},
+		}}}
+
+		// when
+		out, err := istioCR.MergeInto(iop)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		enabledPrometheusMerge, exists := meshConfig.GetPath("enablePrometheusMerge")
+		Expect(exists).To(BeTrue())
+		Expect(enabledPrometheusMerge).To(BeTrue())
+
+	})
+
+	It("should create IngressGateway overlay with externalTrafficPolicy set to Local", func() {
+		// given
+
+		iop := iopv1alpha1.IstioOperator{
+			Spec: iopv1alpha1.IstioOperatorSpec{},
+		}
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{
+			Config: istiov1alpha2.Config{
+				GatewayExternalTrafficPolicy: ptr.To("Local"),
+			},
+		}}
+
+		// when
+		out, err := istioCR.MergeInto(iop)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		externalTrafficPolicy := out.Spec.Components.IngressGateways[0].Kubernetes.Overlays[0].Patches[0].Value.(*structpb.Value).GetStringValue()
+		Expect(externalTrafficPolicy).To(Equal("Local"))
+	})
+
+	It("should create IngressGateway overlay with externalTrafficPolicy set to Cluster", func() {
+		// given
+
+		iop := iopv1alpha1.IstioOperator{
+			Spec: iopv1alpha1.IstioOperatorSpec{},
+		}
+		istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{
+			Config: istiov1alpha2.Config{
+				GatewayExternalTrafficPolicy: ptr.To("Cluster"),
+			},
+		}}
 
 		// when
 		out, err := istioCR.MergeInto(iop)
@@ -534,6 +1037,700 @@ var _ = Describe("Merge", func() {
 
 	})
 
+	Context("OutboundTrafficPolicy", func() {
+		It("should set outbound traffic policy mode to REGISTRY_ONLY", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				OutboundTrafficPolicy: ptr.To(istiov1alpha2.OutboundTrafficPolicyRegistryOnly),
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+			mode, found := meshConfig.GetPath("outboundTrafficPolicy.mode")
+			Expect(found).To(BeTrue())
+			Expect(mode).To(Equal("REGISTRY_ONLY"))
+		})
+
+		It("should not change outbound traffic policy when it is not configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+			mode, found := meshConfig.GetPath("outboundTrafficPolicy.mode")
+			Expect(found).To(BeTrue())
+			Expect(mode).To(Equal("ALLOW_ANY"))
+		})
+	})
+
+	Context("DNS proxy", func() {
+		DescribeTable("should set DNS proxy metadata in meshConfig defaultConfig",
+			func(dnsProxy istiov1alpha2.DNSProxy, expectedCapture, expectedAutoAllocate string) {
+				// given
+				iop := iopv1alpha1.IstioOperator{
+					Spec: iopv1alpha1.IstioOperatorSpec{
+						MeshConfig: convert(mesh.DefaultMeshConfig()),
+					},
+				}
+				istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{DNSProxy: &dnsProxy}}}
+
+				// when
+				out, err := istioCR.MergeInto(iop)
+
+				// then
+				Expect(err).ShouldNot(HaveOccurred())
+
+				meshConfig := &meshv1alpha1.MeshConfig{}
+				Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+				Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).To(HaveKeyWithValue("ISTIO_META_DNS_CAPTURE", expectedCapture))
+				Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).To(HaveKeyWithValue("ISTIO_META_DNS_AUTO_ALLOCATE", expectedAutoAllocate))
+			},
+			Entry("DNS capture with auto allocation", istiov1alpha2.DNSProxy{Enabled: true, AutoAllocate: true}, "true", "true"),
+			Entry("DNS capture without auto allocation", istiov1alpha2.DNSProxy{Enabled: true}, "true", "false"),
+			Entry("disabled DNS capture", istiov1alpha2.DNSProxy{Enabled: false}, "false", "false"),
+		)
+
+		It("should not set DNS proxy metadata when DNS proxy is not configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).ToNot(HaveKey("ISTIO_META_DNS_CAPTURE"))
+			Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).ToNot(HaveKey("ISTIO_META_DNS_AUTO_ALLOCATE"))
+		})
+	})
+
+	Context("Locality load balancing", func() {
+		It("should set localityLbSetting in meshConfig", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				LocalityLoadBalancing: &istiov1alpha2.LocalityLoadBalancing{
+					Enabled: true,
+					Distribute: []istiov1alpha2.LocalityDistribute{
+						{From: "eu-central-1/eu-central-1a/*", To: map[string]uint32{"eu-central-1/eu-central-1a/*": 80, "eu-central-1/eu-central-1b/*": 20}},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetLocalityLbSetting().GetEnabled().GetValue()).To(BeTrue())
+			Expect(meshConfig.GetLocalityLbSetting().GetFailover()).To(BeEmpty())
+			Expect(meshConfig.GetLocalityLbSetting().GetDistribute()).To(HaveLen(1))
+			Expect(meshConfig.GetLocalityLbSetting().GetDistribute()[0].GetFrom()).To(Equal("eu-central-1/eu-central-1a/*"))
+			Expect(meshConfig.GetLocalityLbSetting().GetDistribute()[0].GetTo()).To(Equal(map[string]uint32{"eu-central-1/eu-central-1a/*": 80, "eu-central-1/eu-central-1b/*": 20}))
+		})
+
+		It("should set failover of localityLbSetting in meshConfig", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				LocalityLoadBalancing: &istiov1alpha2.LocalityLoadBalancing{
+					Enabled:  true,
+					Failover: []istiov1alpha2.LocalityFailover{{From: "eu-central-1", To: "eu-west-1"}},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetLocalityLbSetting().GetFailover()).To(HaveLen(1))
+			Expect(meshConfig.GetLocalityLbSetting().GetFailover()[0].GetFrom()).To(Equal("eu-central-1"))
+			Expect(meshConfig.GetLocalityLbSetting().GetFailover()[0].GetTo()).To(Equal("eu-west-1"))
+		})
+
+		It("should keep localityLbSetting of the IstioOperator when locality load balancing is not configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: json.RawMessage(`{"localityLbSetting":{"enabled":false}}`),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetLocalityLbSetting().GetEnabled().GetValue()).To(BeFalse())
+			Expect(meshConfig.GetLocalityLbSetting().GetDistribute()).To(BeEmpty())
+		})
+	})
+
+	Context("Proxy lifecycle", func() {
+		It("should set proxy lifecycle settings in meshConfig defaultConfig", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Proxy: &istiov1alpha2.ProxyComponent{
+					HoldApplicationUntilProxyStarts: ptr.To(false),
+					TerminationDrainDuration:        &metav1.Duration{Duration: 90 * time.Second},
+					ExitOnZeroActiveConnections:     ptr.To(true),
+					Concurrency:                     ptr.To(int32(4)),
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetDefaultConfig().GetHoldApplicationUntilProxyStarts().GetValue()).To(BeFalse())
+			Expect(meshConfig.GetDefaultConfig().GetTerminationDrainDuration().AsDuration()).To(Equal(90 * time.Second))
+			Expect(meshConfig.GetDefaultConfig().GetConcurrency().GetValue()).To(Equal(int32(4)))
+			Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).To(HaveKeyWithValue("EXIT_ON_ZERO_ACTIVE_CONNECTIONS", "true"))
+		})
+
+		It("should keep proxy lifecycle settings of the IstioOperator when they are not configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: json.RawMessage(`{"defaultConfig":{"holdApplicationUntilProxyStarts":true}}`),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Proxy: &istiov1alpha2.ProxyComponent{},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetDefaultConfig().GetHoldApplicationUntilProxyStarts().GetValue()).To(BeTrue())
+			Expect(meshConfig.GetDefaultConfig().GetTerminationDrainDuration()).To(BeNil())
+			Expect(meshConfig.GetDefaultConfig().GetConcurrency()).To(BeNil())
+			Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).ToNot(HaveKey("EXIT_ON_ZERO_ACTIVE_CONNECTIONS"))
+		})
+	})
+
+	Context("Native sidecar", func() {
+		It("should replace ENABLE_NATIVE_SIDECARS of istiod when nativeSidecar is configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					Components: &iopv1alpha1.IstioComponentSpec{
+						Pilot: &iopv1alpha1.ComponentSpec{
+							Kubernetes: &iopv1alpha1.KubernetesResources{
+								Env: []*corev1.EnvVar{
+									{Name: "PILOT_HTTP10", Value: "1"},
+									{Name: "ENABLE_NATIVE_SIDECARS", Value: "false"},
+								},
+							},
+						},
+					},
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Proxy: &istiov1alpha2.ProxyComponent{NativeSidecar: ptr.To(true)},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env).To(HaveLen(2))
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env[0].Value).To(Equal("1"))
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env[1].Name).To(Equal("ENABLE_NATIVE_SIDECARS"))
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env[1].Value).To(Equal("true"))
+		})
+
+		It("should add ENABLE_NATIVE_SIDECARS to istiod when it is not defined in the IstioOperator", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Proxy: &istiov1alpha2.ProxyComponent{NativeSidecar: ptr.To(false)},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env).To(ContainElement(&corev1.EnvVar{Name: "ENABLE_NATIVE_SIDECARS", Value: "false"}))
+		})
+
+		It("should not change ENABLE_NATIVE_SIDECARS of istiod when nativeSidecar is not configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					Components: &iopv1alpha1.IstioComponentSpec{
+						Pilot: &iopv1alpha1.ComponentSpec{
+							Kubernetes: &iopv1alpha1.KubernetesResources{
+								Env: []*corev1.EnvVar{{Name: "ENABLE_NATIVE_SIDECARS", Value: "false"}},
+							},
+						},
+					},
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Proxy: &istiov1alpha2.ProxyComponent{},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env).To(ConsistOf(&corev1.EnvVar{Name: "ENABLE_NATIVE_SIDECARS", Value: "false"}))
+		})
+	})
+
+	Context("Data plane mode", func() {
+		It("should enable ztunnel and ambient settings when the data plane mode is ambient", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+					Values:     json.RawMessage(`{"cni":{"cniBinDir":"/opt/cni/bin"}}`),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{DataPlaneMode: istiov1alpha2.DataPlaneModeAmbient}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.Ztunnel.Enabled.GetValueOrFalse()).To(BeTrue())
+			Expect(out.Spec.Components.Ztunnel.Namespace).To(Equal("istio-system"))
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env).To(ContainElement(&corev1.EnvVar{Name: "PILOT_ENABLE_AMBIENT", Value: "true"}))
+
+			valuesMap, err := values.MapFromObject(out.Spec.Values)
+			Expect(err).ShouldNot(HaveOccurred())
+			ambientEnabled, exists := valuesMap.GetPath("cni.ambient.enabled")
+			Expect(exists).To(BeTrue())
+			Expect(ambientEnabled).To(Equal(true))
+			cniBinDir, exists := valuesMap.GetPath("cni.cniBinDir")
+			Expect(exists).To(BeTrue())
+			Expect(cniBinDir).To(Equal("/opt/cni/bin"))
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).To(HaveKeyWithValue("ISTIO_META_ENABLE_HBONE", "true"))
+		})
+
+		It("should not enable ztunnel when the data plane mode is sidecar", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{DataPlaneMode: istiov1alpha2.DataPlaneModeSidecar}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			if out.Spec.Components != nil {
+				Expect(out.Spec.Components.Ztunnel).To(BeNil())
+			}
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetDefaultConfig().GetProxyMetadata()).ToNot(HaveKey("ISTIO_META_ENABLE_HBONE"))
+		})
+	})
+
+	Context("Certificate authority", func() {
+		It("should set the external CA address and disable the CA server of istiod when caAddress is configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				CertificateAuthority: &istiov1alpha2.CertificateAuthority{CAAddress: ptr.To("cert-manager-istio-csr.cert-manager.svc:443")},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env).To(ContainElement(&corev1.EnvVar{Name: "ENABLE_CA_SERVER", Value: "false"}))
+
+			valuesMap, err := values.MapFromObject(out.Spec.Values)
+			Expect(err).ShouldNot(HaveOccurred())
+			caAddress, exists := valuesMap.GetPath("global.caAddress")
+			Expect(exists).To(BeTrue())
+			Expect(caAddress).To(Equal("cert-manager-istio-csr.cert-manager.svc:443"))
+		})
+
+		It("should not change the CA configuration of the IstioOperator when a plug-in CA Secret is configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				CertificateAuthority: &istiov1alpha2.CertificateAuthority{
+					Secret: &istiov1alpha2.CASecretReference{Name: "my-ca", Namespace: "my-namespace"},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			if out.Spec.Components != nil && out.Spec.Components.Pilot != nil && out.Spec.Components.Pilot.Kubernetes != nil {
+				Expect(out.Spec.Components.Pilot.Kubernetes.Env).ToNot(ContainElement(HaveField("Name", "ENABLE_CA_SERVER")))
+			}
+			valuesMap, err := values.MapFromObject(out.Spec.Values)
+			Expect(err).ShouldNot(HaveOccurred())
+			_, exists := valuesMap.GetPath("global.caAddress")
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should set trustDomain and trustDomainAliases in meshConfig", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				TrustDomain:        ptr.To("kyma.example.com"),
+				TrustDomainAliases: []string{"cluster.local"},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetTrustDomain()).To(Equal("kyma.example.com"))
+			Expect(meshConfig.GetTrustDomainAliases()).To(ConsistOf("cluster.local"))
+		})
+
+		It("should keep trustDomain of the IstioOperator when it is not configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: json.RawMessage(`{"trustDomain":"cluster.local"}`),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			Expect(meshConfig.GetTrustDomain()).To(Equal("cluster.local"))
+			Expect(meshConfig.GetTrustDomainAliases()).To(BeEmpty())
+		})
+	})
+
+	Context("Workload certificates", func() {
+		It("should set the certificate TTLs of istiod and the ECDSA key settings of the proxies", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				WorkloadCertificates: &istiov1alpha2.WorkloadCertificates{
+					DefaultTTL:   &metav1.Duration{Duration: 12 * time.Hour},
+					MaxTTL:       &metav1.Duration{Duration: 48 * time.Hour},
+					KeyAlgorithm: ptr.To(istiov1alpha2.KeyAlgorithmECDSA),
+					KeySize:      ptr.To(int32(384)),
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.Pilot.Kubernetes.Env).To(ContainElements(
+				&corev1.EnvVar{Name: "DEFAULT_WORKLOAD_CERT_TTL", Value: "12h0m0s"},
+				&corev1.EnvVar{Name: "MAX_WORKLOAD_CERT_TTL", Value: "48h0m0s"},
+			))
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			proxyMetadata := meshConfig.GetDefaultConfig().GetProxyMetadata()
+			Expect(proxyMetadata).To(HaveKeyWithValue("SECRET_TTL", "12h0m0s"))
+			Expect(proxyMetadata).To(HaveKeyWithValue("ECC_SIGNATURE_ALGORITHM", "ECDSA"))
+			Expect(proxyMetadata).To(HaveKeyWithValue("ECC_CURVE", "P384"))
+			Expect(proxyMetadata).ToNot(HaveKey("WORKLOAD_RSA_KEY_SIZE"))
+		})
+
+		It("should set the RSA key size of the proxies", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				WorkloadCertificates: &istiov1alpha2.WorkloadCertificates{
+					KeyAlgorithm: ptr.To(istiov1alpha2.KeyAlgorithmRSA),
+					KeySize:      ptr.To(int32(4096)),
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			if out.Spec.Components != nil && out.Spec.Components.Pilot != nil && out.Spec.Components.Pilot.Kubernetes != nil {
+				Expect(out.Spec.Components.Pilot.Kubernetes.Env).ToNot(ContainElement(HaveField("Name", "DEFAULT_WORKLOAD_CERT_TTL")))
+			}
+
+			meshConfig := &meshv1alpha1.MeshConfig{}
+			Expect(protomarshal.Unmarshal(out.Spec.MeshConfig, meshConfig)).To(Succeed())
+			proxyMetadata := meshConfig.GetDefaultConfig().GetProxyMetadata()
+			Expect(proxyMetadata).To(HaveKeyWithValue("WORKLOAD_RSA_KEY_SIZE", "4096"))
+			Expect(proxyMetadata).ToNot(HaveKey("ECC_SIGNATURE_ALGORITHM"))
+			Expect(proxyMetadata).ToNot(HaveKey("SECRET_TTL"))
+		})
+	})
+
+	Context("Tracing", func() {
+		It("should register OpenTelemetry and Zipkin tracing providers", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				Telemetry: istiov1alpha2.Telemetry{
+					Tracing: &istiov1alpha2.Tracing{
+						Providers: []*istiov1alpha2.TracingProvider{
+							{Name: "otel", Type: istiov1alpha2.TracingProviderOpenTelemetry, Service: "otel.tracing.svc.cluster.local", Port: 4317, MaxTagLength: ptr.To(uint32(100))},
+							{Name: "zipkin", Type: istiov1alpha2.TracingProviderZipkin, Service: "zipkin.tracing.svc.cluster.local", Port: 9411},
+						},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			otelProvider := getExtensionProvider(out, "otel", "opentelemetry")
+			Expect(otelProvider["service"]).To(Equal("otel.tracing.svc.cluster.local"))
+			Expect(otelProvider["port"]).To(BeEquivalentTo(4317))
+			Expect(otelProvider["maxTagLength"]).To(BeEquivalentTo(100))
+
+			zipkinProvider := getExtensionProvider(out, "zipkin", "zipkin")
+			Expect(zipkinProvider["service"]).To(Equal("zipkin.tracing.svc.cluster.local"))
+			Expect(zipkinProvider["port"]).To(BeEquivalentTo(9411))
+			Expect(zipkinProvider).ToNot(HaveKey("maxTagLength"))
+		})
+
+		It("should override an existing extension provider with the same name", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				Telemetry: istiov1alpha2.Telemetry{
+					Tracing: &istiov1alpha2.Tracing{
+						Providers: []*istiov1alpha2.TracingProvider{
+							{Name: "otel", Type: istiov1alpha2.TracingProviderOpenTelemetry, Service: "otel.tracing.svc.cluster.local", Port: 4317},
+						},
+					},
+				},
+			}}}
+			iop, err := istioCR.MergeInto(iop)
+			Expect(err).ShouldNot(HaveOccurred())
+			istioCR.Spec.Config.Telemetry.Tracing.Providers[0].Port = 4318
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+			count := 0
+			for _, provider := range values.TryGetPathAs[[]interface{}](meshConfig, "extensionProviders") {
+				if provider.(map[string]interface{})["name"] == "otel" {
+					count++
+				}
+			}
+			Expect(count).To(Equal(1))
+			Expect(getExtensionProvider(out, "otel", "opentelemetry")["port"]).To(BeEquivalentTo(4318))
+		})
+
+		It("should set the tracing providers as default providers when mesh-wide tracing is enabled", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				Telemetry: istiov1alpha2.Telemetry{
+					Tracing: &istiov1alpha2.Tracing{
+						MeshWide: true,
+						Providers: []*istiov1alpha2.TracingProvider{
+							{Name: "otel", Type: istiov1alpha2.TracingProviderOpenTelemetry, Service: "otel.tracing.svc.cluster.local", Port: 4317},
+							{Name: "zipkin", Type: istiov1alpha2.TracingProviderZipkin, Service: "zipkin.tracing.svc.cluster.local", Port: 9411},
+						},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(values.TryGetPathAs[[]interface{}](meshConfig, "defaultProviders.tracing")).To(ConsistOf("otel", "zipkin"))
+		})
+
+		It("should not set default tracing providers when mesh-wide tracing is disabled", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				Telemetry: istiov1alpha2.Telemetry{
+					Tracing: &istiov1alpha2.Tracing{
+						Providers: []*istiov1alpha2.TracingProvider{
+							{Name: "otel", Type: istiov1alpha2.TracingProviderOpenTelemetry, Service: "otel.tracing.svc.cluster.local", Port: 4317},
+						},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(values.TryGetPathAs[[]interface{}](meshConfig, "defaultProviders.tracing")).To(BeEmpty())
+		})
+
+		It("should set the default sampling percentage", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					MeshConfig: convert(mesh.DefaultMeshConfig()),
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Config: istiov1alpha2.Config{
+				Telemetry: istiov1alpha2.Telemetry{
+					Tracing: &istiov1alpha2.Tracing{
+						SamplingPercentage: ptr.To(25),
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			meshConfig, err := values.MapFromObject(out.Spec.MeshConfig)
+			Expect(err).ShouldNot(HaveOccurred())
+			sampling, found := meshConfig.GetPath("defaultConfig.tracing.sampling")
+			Expect(found).To(BeTrue())
+			Expect(sampling).To(BeEquivalentTo(25))
+		})
+	})
+
 	Context("Pilot", func() {
 		Context("When Istio CR has 500m configured for CPU limits", func() {
 			It("should set CPU limits to 500m in IOP", func() {
@@ -605,7 +1802,7 @@ var _ = Describe("Merge", func() {
 				memoryLimit := "500Mi"
 
 				istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
-					IngressGateway: &istiov1alpha2.IstioComponent{
+					IngressGateway: &istiov1alpha2.IngressGateway{
 						K8s: &istiov1alpha2.KubernetesResourcesConfig{
 							Resources: &istiov1alpha2.Resources{
 								Limits: &istiov1alpha2.ResourceClaims{
@@ -640,7 +1837,7 @@ var _ = Describe("Merge", func() {
 				memoryRequests := "500Mi"
 
 				istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
-					IngressGateway: &istiov1alpha2.IstioComponent{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+					IngressGateway: &istiov1alpha2.IngressGateway{K8s: &istiov1alpha2.KubernetesResourcesConfig{
 						Resources: &istiov1alpha2.Resources{
 							Requests: &istiov1alpha2.ResourceClaims{
 								CPU:    &cpuRequests,
@@ -665,6 +1862,295 @@ var _ = Describe("Merge", func() {
 		})
 	})
 
+	Context("IngressGateway Service", func() {
+		It("should set Service type, load balancer settings and ports together with the default ports", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				IngressGateway: &istiov1alpha2.IngressGateway{
+					Service: &istiov1alpha2.IngressGatewayService{
+						Type: istiov1alpha2.IngressGatewayServiceTypeNodePort,
+						Ports: []istiov1alpha2.IngressGatewayServicePort{
+							{Name: "tcp-mqtt", Port: 8883, TargetPort: ptr.To[int32](8883), NodePort: ptr.To[int32](30883)},
+							{Name: "udp-dns", Port: 53, Protocol: ptr.To("UDP")},
+						},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			service := out.Spec.Components.IngressGateways[0].Kubernetes.Service
+			Expect(service.Type).To(Equal(corev1.ServiceTypeNodePort))
+			Expect(service.Ports).To(Equal([]corev1.ServicePort{
+				{Name: "status-port", Port: 15021, TargetPort: intstr.FromInt32(15021), Protocol: corev1.ProtocolTCP},
+				{Name: "http2", Port: 80, TargetPort: intstr.FromInt32(8080), Protocol: corev1.ProtocolTCP},
+				{Name: "https", Port: 443, TargetPort: intstr.FromInt32(8443), Protocol: corev1.ProtocolTCP},
+				{Name: "tcp-mqtt", Port: 8883, TargetPort: intstr.FromInt32(8883), NodePort: 30883, Protocol: corev1.ProtocolTCP},
+				{Name: "udp-dns", Port: 53, Protocol: corev1.ProtocolUDP},
+			}))
+		})
+
+		It("should set loadBalancerSourceRanges and loadBalancerClass", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				IngressGateway: &istiov1alpha2.IngressGateway{
+					Service: &istiov1alpha2.IngressGatewayService{
+						LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+						LoadBalancerClass:        ptr.To("service.k8s.aws/nlb"),
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			service := out.Spec.Components.IngressGateways[0].Kubernetes.Service
+			Expect(service.Type).To(BeEmpty())
+			Expect(service.Ports).To(BeEmpty())
+			Expect(service.LoadBalancerSourceRanges).To(Equal([]string{"10.0.0.0/8"}))
+			Expect(*service.LoadBalancerClass).To(Equal("service.k8s.aws/nlb"))
+		})
+
+		It("should set Service annotations as component service annotations without changing the Service spec", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					Components: &iopv1alpha1.IstioComponentSpec{
+						IngressGateways: []iopv1alpha1.GatewayComponentSpec{
+							{
+								Name: "istio-ingressgateway",
+								ComponentSpec: iopv1alpha1.ComponentSpec{
+									Kubernetes: &iopv1alpha1.KubernetesResources{
+										ServiceAnnotations: map[string]string{"existing": "value"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				IngressGateway: &istiov1alpha2.IngressGateway{
+					Service: &istiov1alpha2.IngressGatewayService{
+						Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal"},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			k8s := out.Spec.Components.IngressGateways[0].Kubernetes
+			Expect(k8s.Service).To(BeNil())
+			Expect(k8s.ServiceAnnotations).To(Equal(map[string]string{
+				"existing": "value",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internal",
+			}))
+		})
+	})
+
+	Context("AdditionalIngressGateways", func() {
+		defaultIngressGatewayIop := func() iopv1alpha1.IstioOperator {
+			return iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{
+					Components: &iopv1alpha1.IstioComponentSpec{
+						IngressGateways: []iopv1alpha1.GatewayComponentSpec{
+							{
+								Name: "istio-ingressgateway",
+								ComponentSpec: iopv1alpha1.ComponentSpec{
+									Kubernetes: &iopv1alpha1.KubernetesResources{
+										HpaSpec: &autoscalingv2.HorizontalPodAutoscalerSpec{
+											ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+												Kind: "Deployment",
+												Name: "istio-ingressgateway",
+											},
+											MaxReplicas: 5,
+										},
+										ServiceAnnotations: map[string]string{
+											"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		It("should add an additional ingress gateway based on the default ingress gateway", func() {
+			// given
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				AdditionalIngressGateways: []istiov1alpha2.AdditionalIngressGateway{
+					{
+						Name:   "internal-ingressgateway",
+						Labels: map[string]string{"istio": "internal"},
+						ServiceAnnotations: map[string]string{
+							"networking.gke.io/load-balancer-type": "Internal",
+						},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(defaultIngressGatewayIop())
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.IngressGateways).To(HaveLen(2))
+
+			gateway := out.Spec.Components.IngressGateways[1]
+			Expect(gateway.Name).To(Equal("internal-ingressgateway"))
+			Expect(gateway.Namespace).To(Equal("istio-system"))
+			Expect(gateway.Enabled.GetValueOrFalse()).To(BeTrue())
+			Expect(gateway.Label).To(Equal(map[string]string{"app": "internal-ingressgateway", "istio": "internal"}))
+			Expect(gateway.Kubernetes.HpaSpec.ScaleTargetRef.Name).To(Equal("internal-ingressgateway"))
+			Expect(gateway.Kubernetes.HpaSpec.MaxReplicas).To(Equal(int32(5)))
+			Expect(gateway.Kubernetes.ServiceAnnotations).To(Equal(map[string]string{"networking.gke.io/load-balancer-type": "Internal"}))
+
+			defaultGateway := out.Spec.Components.IngressGateways[0]
+			Expect(defaultGateway.Kubernetes.HpaSpec.ScaleTargetRef.Name).To(Equal("istio-ingressgateway"))
+			Expect(defaultGateway.Kubernetes.ServiceAnnotations).To(HaveKey("service.beta.kubernetes.io/aws-load-balancer-type"))
+		})
+
+		It("should apply the Kubernetes configuration of the additional ingress gateway only to this gateway", func() {
+			// given
+			maxReplicas := int32(10)
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				AdditionalIngressGateways: []istiov1alpha2.AdditionalIngressGateway{
+					{
+						Name: "internal-ingressgateway",
+						K8s: &istiov1alpha2.KubernetesResourcesConfig{
+							HPASpec: &istiov1alpha2.HPASpec{
+								MaxReplicas: &maxReplicas,
+							},
+						},
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(defaultIngressGatewayIop())
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.IngressGateways[0].Kubernetes.HpaSpec.MaxReplicas).To(Equal(int32(5)))
+			Expect(out.Spec.Components.IngressGateways[1].Kubernetes.HpaSpec.MaxReplicas).To(Equal(maxReplicas))
+		})
+
+		It("should not inherit the Istio CR configuration of the default ingress gateway", func() {
+			// given
+			maxReplicas := int32(10)
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				IngressGateway: &istiov1alpha2.IngressGateway{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+					HPASpec: &istiov1alpha2.HPASpec{
+						MaxReplicas: &maxReplicas,
+					},
+				}},
+				AdditionalIngressGateways: []istiov1alpha2.AdditionalIngressGateway{
+					{Name: "internal-ingressgateway"},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(defaultIngressGatewayIop())
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.IngressGateways[0].Kubernetes.HpaSpec.MaxReplicas).To(Equal(maxReplicas))
+			Expect(out.Spec.Components.IngressGateways[1].Kubernetes.HpaSpec.MaxReplicas).To(Equal(int32(5)))
+		})
+
+		It("should apply the Istio CR configuration of the default ingress gateway by name if it is not the first gateway", func() {
+			// given
+			iop := defaultIngressGatewayIop()
+			iop.Spec.Components.IngressGateways = append([]iopv1alpha1.GatewayComponentSpec{
+				{
+					Name: "internal-ingressgateway",
+					ComponentSpec: iopv1alpha1.ComponentSpec{
+						Kubernetes: &iopv1alpha1.KubernetesResources{},
+					},
+				},
+			}, iop.Spec.Components.IngressGateways...)
+			maxReplicas := int32(10)
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				IngressGateway: &istiov1alpha2.IngressGateway{
+					K8s: &istiov1alpha2.KubernetesResourcesConfig{
+						HPASpec: &istiov1alpha2.HPASpec{
+							MaxReplicas: &maxReplicas,
+						},
+					},
+					Service: &istiov1alpha2.IngressGatewayService{
+						Type: istiov1alpha2.IngressGatewayServiceTypeNodePort,
+					},
+				},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.IngressGateways).To(HaveLen(2))
+
+			additionalGateway := out.Spec.Components.IngressGateways[0]
+			Expect(additionalGateway.Name).To(Equal("internal-ingressgateway"))
+			Expect(additionalGateway.Kubernetes.HpaSpec).To(BeNil())
+			Expect(additionalGateway.Kubernetes.Service).To(BeNil())
+
+			defaultGateway := out.Spec.Components.IngressGateways[1]
+			Expect(defaultGateway.Name).To(Equal("istio-ingressgateway"))
+			Expect(defaultGateway.Kubernetes.HpaSpec.MaxReplicas).To(Equal(maxReplicas))
+			Expect(defaultGateway.Kubernetes.Service.Type).To(Equal(corev1.ServiceTypeNodePort))
+		})
+
+		It("should set externalTrafficPolicy for the Service of every ingress gateway", func() {
+			// given
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{
+				Config: istiov1alpha2.Config{
+					GatewayExternalTrafficPolicy: ptr.To("Local"),
+				},
+				Components: &istiov1alpha2.Components{
+					AdditionalIngressGateways: []istiov1alpha2.AdditionalIngressGateway{
+						{Name: "internal-ingressgateway"},
+					},
+				},
+			}}
+
+			// when
+			out, err := istioCR.MergeInto(defaultIngressGatewayIop())
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.IngressGateways).To(HaveLen(2))
+
+			for _, gateway := range out.Spec.Components.IngressGateways {
+				Expect(gateway.Kubernetes.Overlays).To(HaveLen(1))
+				overlay := gateway.Kubernetes.Overlays[0]
+				Expect(overlay.Kind).To(Equal("Service"))
+				Expect(overlay.Name).To(Equal(gateway.Name))
+				Expect(overlay.Patches[0].Path).To(Equal("spec.externalTrafficPolicy"))
+				Expect(overlay.Patches[0].Value.(*structpb.Value).GetStringValue()).To(Equal("Local"))
+			}
+		})
+	})
+
 	Context("EgressGateway", func() {
 		Context("When Istio CR has 500m configured for CPU and 500Mi for memory limits", func() {
 			It("should set CPU limits to 500m and 500Mi for memory in IOP", func() {
@@ -765,7 +2251,7 @@ var _ = Describe("Merge", func() {
 			}
 
 			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
-				IngressGateway: &istiov1alpha2.IstioComponent{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+				IngressGateway: &istiov1alpha2.IngressGateway{K8s: &istiov1alpha2.KubernetesResourcesConfig{
 					Strategy: &istiov1alpha2.Strategy{
 						RollingUpdate: &istiov1alpha2.RollingUpdate{
 							MaxUnavailable: &maxUnavailable,
@@ -789,6 +2275,169 @@ var _ = Describe("Merge", func() {
 		})
 	})
 
+	Context("Scheduling", func() {
+		It("should set nodeSelector, tolerations and priorityClassName for Pilot", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{},
+			}
+			toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "istio", Effect: corev1.TaintEffectNoSchedule}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Pilot: &istiov1alpha2.IstioComponent{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+					NodeSelector:      map[string]string{"node-role": "istio"},
+					Tolerations:       []corev1.Toleration{toleration},
+					PriorityClassName: ptr.To("system-cluster-critical"),
+				}},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			k8s := out.Spec.Components.Pilot.Kubernetes
+			Expect(k8s.NodeSelector).To(Equal(map[string]string{"node-role": "istio"}))
+			Expect(k8s.Tolerations).To(Equal([]*corev1.Toleration{&toleration}))
+			Expect(k8s.PriorityClassName).To(Equal("system-cluster-critical"))
+		})
+
+		It("should add topologySpreadConstraints overlay for the Deployment of the component", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				IngressGateway: &istiov1alpha2.IngressGateway{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       "topology.kubernetes.io/zone",
+							WhenUnsatisfiable: corev1.ScheduleAnyway,
+							LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "istio-ingressgateway"}},
+						},
+					},
+				}},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			overlays := out.Spec.Components.IngressGateways[0].Kubernetes.Overlays
+			Expect(overlays).To(HaveLen(1))
+			Expect(overlays[0].Kind).To(Equal("Deployment"))
+			Expect(overlays[0].Name).To(Equal("istio-ingressgateway"))
+			Expect(overlays[0].Patches[0].Path).To(Equal("spec.template.spec.topologySpreadConstraints"))
+
+			value, ok := overlays[0].Patches[0].Value.(*structpb.Value)
+			Expect(ok).To(BeTrue())
+			constraints := value.GetListValue().GetValues()
+			Expect(constraints).To(HaveLen(1))
+			constraint := constraints[0].GetStructValue().GetFields()
+			Expect(constraint["topologyKey"].GetStringValue()).To(Equal("topology.kubernetes.io/zone"))
+			Expect(constraint["maxSkew"].GetNumberValue()).To(Equal(float64(1)))
+			Expect(constraint["whenUnsatisfiable"].GetStringValue()).To(Equal("ScheduleAnyway"))
+		})
+
+		It("should use the revisioned istiod Deployment name for the topologySpreadConstraints overlay of Pilot", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{Revision: "1-27-1"},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Pilot: &istiov1alpha2.IstioComponent{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       "kubernetes.io/hostname",
+							WhenUnsatisfiable: corev1.ScheduleAnyway,
+						},
+					},
+				}},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			overlays := out.Spec.Components.Pilot.Kubernetes.Overlays
+			Expect(overlays).To(HaveLen(1))
+			Expect(overlays[0].Kind).To(Equal("Deployment"))
+			Expect(overlays[0].Name).To(Equal("istiod-1-27-1"))
+		})
+
+		It("should set PodDisruptionBudget without enabling the default PodDisruptionBudgets of all components", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{},
+			}
+			maxUnavailable := intstr.FromInt32(1)
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				EgressGateway: &istiov1alpha2.EgressGateway{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+					PodDisruptionBudget: &istiov1alpha2.PodDisruptionBudget{MaxUnavailable: &maxUnavailable},
+				}},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			pdb := out.Spec.Components.EgressGateways[0].Kubernetes.PodDisruptionBudget
+			Expect(pdb.MinAvailable).To(BeNil())
+			Expect(*pdb.MaxUnavailable).To(Equal(maxUnavailable))
+
+			Expect(out.Spec.Values).To(BeNil())
+		})
+
+		It("should not change the default PodDisruptionBudget when no PodDisruptionBudget is configured", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{},
+			}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Pilot: &istiov1alpha2.IstioComponent{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+					NodeSelector: map[string]string{"node-role": "istio"},
+				}},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Values).To(BeNil())
+		})
+
+		It("should set tolerations and priorityClassName for CNI", func() {
+			// given
+			iop := iopv1alpha1.IstioOperator{
+				Spec: iopv1alpha1.IstioOperatorSpec{},
+			}
+			toleration := corev1.Toleration{Operator: corev1.TolerationOpExists}
+			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
+				Cni: &istiov1alpha2.CniComponent{K8S: &istiov1alpha2.CniK8sConfig{
+					Tolerations:       []corev1.Toleration{toleration},
+					PriorityClassName: ptr.To("system-node-critical"),
+				}},
+			}}}
+
+			// when
+			out, err := istioCR.MergeInto(iop)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(out.Spec.Components.Cni.Kubernetes.Tolerations).To(Equal([]*corev1.Toleration{&toleration}))
+			Expect(out.Spec.Components.Cni.Kubernetes.PriorityClassName).To(Equal("system-node-critical"))
+		})
+	})
+
 	Context("HPASpec", func() {
 		It("should update HPASpec when it is present in Istio CR", func() {
 			// given
@@ -799,7 +2448,7 @@ var _ = Describe("Merge", func() {
 			minReplicas := int32(4)
 
 			istioCR := istiov1alpha2.Istio{Spec: istiov1alpha2.IstioSpec{Components: &istiov1alpha2.Components{
-				IngressGateway: &istiov1alpha2.IstioComponent{K8s: &istiov1alpha2.KubernetesResourcesConfig{
+				IngressGateway: &istiov1alpha2.IngressGateway{K8s: &istiov1alpha2.KubernetesResourcesConfig{
 					HPASpec: &istiov1alpha2.HPASpec{
 						MaxReplicas: &maxReplicas,
 						MinReplicas: &minReplicas,
@@ -1070,3 +2719,26 @@ func convert(a *meshv1alpha1.MeshConfig) json.RawMessage {
 
 	return jsonConfig
 }
+
+func getExtensionProvider(op iopv1alpha1.IstioOperator, name, providerType string) map[string]interface{} {
+	meshConfig, err := values.MapFromObject(op.Spec.MeshConfig)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	extensionProviders := values.TryGetPathAs[[]interface{}](meshConfig, "extensionProviders")
+	for _, extensionProviderInt := range extensionProviders {
+		extensionProvider, ok := extensionProviderInt.(map[string]interface{})
+		Expect(ok).To(BeTrue())
+
+		if extensionProvider["name"] == name {
+			extensionProviderMap, mapErr := values.MapFromObject(extensionProvider)
+			Expect(mapErr).ShouldNot(HaveOccurred())
+
+			provider, found := extensionProviderMap.GetPathMap(providerType)
+			Expect(found).To(BeTrue(), "Could not find the %s provider of %s", providerType, name)
+			return provider
+		}
+	}
+
+	Fail("Could not find the extension provider by the name " + name)
+	return nil
+}
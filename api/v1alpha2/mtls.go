@@ -0,0 +1,40 @@
+package v1alpha2
+
+type MTLSMode string
+
+const (
+	MTLSModeStrict     MTLSMode = "STRICT"
+	MTLSModePermissive MTLSMode = "PERMISSIVE"
+)
+
+// MTLS defines the mutual TLS mode of the mesh and the namespaces that use a different mode.
+type MTLS struct {
+	// Defines the mesh-wide mutual TLS mode. If not specified, "STRICT" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE
+	Mode MTLSMode `json:"mode,omitempty"`
+
+	// Defines the namespaces that use a mutual TLS mode different from the mesh-wide mode.
+	// +kubebuilder:validation:Optional
+	NamespaceExceptions []MTLSNamespaceException `json:"namespaceExceptions,omitempty"`
+}
+
+type MTLSNamespaceException struct {
+	// Name of the namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Defines the mutual TLS mode of the workloads in the namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE
+	Mode MTLSMode `json:"mode"`
+}
+
+// MeshMode returns the mesh-wide mutual TLS mode, which is "STRICT" if it is not configured.
+func (m *MTLS) MeshMode() MTLSMode {
+	if m == nil || m.Mode == "" {
+		return MTLSModeStrict
+	}
+	return m.Mode
+}
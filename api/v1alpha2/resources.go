@@ -1,6 +1,8 @@
 package v1alpha2
 
 import (
+	"strconv"
+
 	"github.com/pkg/errors"
 	iopv1alpha1 "istio.io/istio/operator/pkg/apis"
 	"istio.io/istio/operator/pkg/values"
@@ -78,3 +80,24 @@ func hasResources(op iopv1alpha1.IstioOperator) bool {
 func hasNoCPUAndMemory(m values.Map) bool {
 	return m.GetPathString("cpu") == "" || m.GetPathString("memory") == ""
 }
+
+// IsNativeSidecarEnabled returns whether the proxy is injected as a native sidecar by merging the given IstioOperator with the
+// configuration in Istio CR. If the Istio CR does not configure it, the default of the IstioOperator is used.
+func (i *Istio) IsNativeSidecarEnabled(op iopv1alpha1.IstioOperator) (bool, error) {
+	mergedOp, err := i.MergeInto(op)
+	if err != nil {
+		return false, err
+	}
+
+	if mergedOp.Spec.Components == nil || mergedOp.Spec.Components.Pilot == nil || mergedOp.Spec.Components.Pilot.Kubernetes == nil {
+		return false, errors.New("istiod environment missing in merged IstioOperator")
+	}
+
+	for _, env := range mergedOp.Spec.Components.Pilot.Kubernetes.Env {
+		if env != nil && env.Name == enableNativeSidecarsEnvName {
+			return strconv.ParseBool(env.Value)
+		}
+	}
+
+	return false, errors.Errorf("%s missing in merged IstioOperator", enableNativeSidecarsEnvName)
+}
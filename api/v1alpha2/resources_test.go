@@ -98,3 +98,56 @@ var _ = Describe("GetProxyResources", func() {
 		Expect(result.Limits.Memory().String()).ToNot(BeEmpty())
 	})
 })
+
+var _ = Describe("IsNativeSidecarEnabled", func() {
+	readIstioOperator := func(path string) iopv1alpha1.IstioOperator {
+		istioOperator, err := os.ReadFile(path)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		iop := iopv1alpha1.IstioOperator{}
+		err = yaml.Unmarshal(istioOperator, &iop)
+		Expect(err).ShouldNot(HaveOccurred())
+		return iop
+	}
+
+	DescribeTable("should use the default of the real istio operator template when IstioCR has no overrides",
+		func(path string) {
+			// given
+			istioCR := v1alpha2.Istio{}
+
+			// when
+			result, err := istioCR.IsNativeSidecarEnabled(readIstioOperator(path))
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).To(BeFalse())
+		},
+		Entry("production", "../../internal/istiooperator/istio-operator.yaml"),
+		Entry("evaluation", "../../internal/istiooperator/istio-operator-light.yaml"),
+	)
+
+	It("should use the value of the Istio CR", func() {
+		// given
+		istioCR := v1alpha2.Istio{Spec: v1alpha2.IstioSpec{Components: &v1alpha2.Components{
+			Proxy: &v1alpha2.ProxyComponent{NativeSidecar: ptr.To(true)},
+		}}}
+
+		// when
+		result, err := istioCR.IsNativeSidecarEnabled(readIstioOperator("../../internal/istiooperator/istio-operator.yaml"))
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).To(BeTrue())
+	})
+
+	It("should return an error when the istio operator has no native sidecar setting", func() {
+		// given
+		istioCR := v1alpha2.Istio{}
+
+		// when
+		_, err := istioCR.IsNativeSidecarEnabled(iopv1alpha1.IstioOperator{})
+
+		// then
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,56 @@
+package v1alpha2
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DefaultRootCAExpiryWarningThreshold = 30 * 24 * time.Hour
+	DefaultRootCAExpiryErrorThreshold   = 7 * 24 * time.Hour
+)
+
+// RootCA defines when an expiring root or intermediate CA certificate of the mesh is reported and whether the root CA is rotated
+// by the Istio module.
+type RootCA struct {
+	// Defines the remaining validity of a CA certificate below which the Istio CR is set to the Warning state, for example "720h".
+	// If not specified, 720h (30 days) is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	ExpiryWarningThreshold *metav1.Duration `json:"expiryWarningThreshold,omitempty"`
+
+	// Defines the remaining validity of a CA certificate below which the Istio CR is set to the Error state, for example "168h".
+	// Must be lower than expiryWarningThreshold. If not specified, 168h (7 days) is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	ExpiryErrorThreshold *metav1.Duration `json:"expiryErrorThreshold,omitempty"`
+
+	// Enables the rotation of the root CA when the plug-in CA Secret is updated with certificates issued by a new root CA.
+	// The new root CA is added to the trust bundle next to the old one, istiod switches to the new CA, and the old root CA is removed.
+	// All Istio proxies are restarted after each of these steps. Requires certificateAuthority.secret.
+	// +kubebuilder:validation:Optional
+	AutomaticRotation bool `json:"automaticRotation,omitempty"`
+}
+
+// RootCAExpiryThresholds returns the remaining validity of a CA certificate below which a warning or an error is reported.
+func (c Config) RootCAExpiryThresholds() (warning, err time.Duration) {
+	warning, err = DefaultRootCAExpiryWarningThreshold, DefaultRootCAExpiryErrorThreshold
+	if c.RootCA == nil {
+		return warning, err
+	}
+	if c.RootCA.ExpiryWarningThreshold != nil {
+		warning = c.RootCA.ExpiryWarningThreshold.Duration
+	}
+	if c.RootCA.ExpiryErrorThreshold != nil {
+		err = c.RootCA.ExpiryErrorThreshold.Duration
+	}
+	return warning, err
+}
+
+// IsRootCARotationEnabled returns true if the Istio module rotates the root CA of the plug-in CA.
+func (c Config) IsRootCARotationEnabled() bool {
+	return c.RootCA != nil && c.RootCA.AutomaticRotation && c.PluginCASecret() != nil
+}
@@ -1,9 +1,20 @@
 package v1alpha2
 
+type TracingProviderType string
+
+const (
+	TracingProviderOpenTelemetry TracingProviderType = "OpenTelemetry"
+	TracingProviderZipkin        TracingProviderType = "Zipkin"
+)
+
 type Telemetry struct {
 	// Istio telemetry configuration related to metrics
 	// +kubebuilder:validation:Optional
 	Metrics Metrics `json:"metrics,omitempty"`
+
+	// Istio telemetry configuration related to distributed tracing
+	// +kubebuilder:validation:Optional
+	Tracing *Tracing `json:"tracing,omitempty"`
 }
 
 type Metrics struct {
@@ -13,3 +24,44 @@ type Metrics struct {
 	// +kubebuilder:validation:Optional
 	PrometheusMerge bool `json:"prometheusMerge,omitempty"`
 }
+
+type Tracing struct {
+	// Defines the tracing providers registered as extension providers in the mesh config.
+	// +kubebuilder:validation:Optional
+	Providers []*TracingProvider `json:"providers,omitempty"`
+
+	// Defines the percentage of requests that are sampled by default. The value must be between 0 and 100.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SamplingPercentage *int `json:"samplingPercentage,omitempty"`
+
+	// Defines whether the tracing providers are set as the default tracing providers of the mesh to enable them for all workloads.
+	// If disabled, the providers must be enabled by a Telemetry resource managed by the user.
+	// +kubebuilder:validation:Optional
+	MeshWide bool `json:"meshWide,omitempty"`
+}
+
+type TracingProvider struct {
+	// A unique name identifying the tracing provider.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Defines the type of the tracing provider. Either "OpenTelemetry" or "Zipkin".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=OpenTelemetry;Zipkin
+	Type TracingProviderType `json:"type"`
+
+	// Specifies the service that receives the traces.
+	// +kubebuilder:validation:Required
+	Service string `json:"service"`
+
+	// Specifies the port of the service.
+	// +kubebuilder:validation:Required
+	Port uint32 `json:"port"`
+
+	// Defines the maximum length of the request path included in the span tags. If not specified, Istio's default of 256 is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxTagLength *uint32 `json:"maxTagLength,omitempty"`
+}
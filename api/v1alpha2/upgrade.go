@@ -0,0 +1,28 @@
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+type UpgradeStrategy string
+
+const (
+	UpgradeStrategyInPlace UpgradeStrategy = "InPlace"
+	UpgradeStrategyCanary  UpgradeStrategy = "Canary"
+)
+
+// DefaultRevision is the revision of an Istio installation without an explicit revision name.
+const DefaultRevision = "default"
+
+// IsCanaryUpgrade returns true if a new Istio version is installed as a separate revision next to the running one.
+func (s IstioSpec) IsCanaryUpgrade() bool {
+	return s.UpgradeStrategy == UpgradeStrategyCanary
+}
+
+// IsCanaryUpgradeInProgress returns true if a canary upgrade was started and the previous Istio revision is not removed yet.
+func (i *Istio) IsCanaryUpgradeInProgress() bool {
+	if i.Status.Conditions == nil {
+		return false
+	}
+	return meta.IsStatusConditionFalse(*i.Status.Conditions, string(ConditionTypeCanaryUpgrade))
+}
@@ -0,0 +1,147 @@
+package v1alpha2
+
+import (
+	"strconv"
+	"time"
+
+	iopv1alpha1 "istio.io/istio/operator/pkg/apis"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type KeyAlgorithm string
+
+const (
+	KeyAlgorithmRSA   KeyAlgorithm = "RSA"
+	KeyAlgorithmECDSA KeyAlgorithm = "ECDSA"
+
+	// DefaultWorkloadCertificateTTL and DefaultWorkloadCertificateMaxTTL are the defaults of Istio if no TTL is configured.
+	DefaultWorkloadCertificateTTL    = 24 * time.Hour
+	DefaultWorkloadCertificateMaxTTL = 90 * 24 * time.Hour
+
+	ProxyMetadataSecretTTL             = "SECRET_TTL"
+	ProxyMetadataECCSignatureAlgorithm = "ECC_SIGNATURE_ALGORITHM"
+	ProxyMetadataECCCurve              = "ECC_CURVE"
+	ProxyMetadataRSAKeySize            = "WORKLOAD_RSA_KEY_SIZE"
+
+	defaultWorkloadCertTTLEnvName = "DEFAULT_WORKLOAD_CERT_TTL"
+	maxWorkloadCertTTLEnvName     = "MAX_WORKLOAD_CERT_TTL"
+)
+
+// eccCurves maps the supported ECDSA key sizes to the curves used by the Istio proxies.
+var eccCurves = map[int32]string{
+	256: "P256",
+	384: "P384",
+}
+
+// WorkloadCertificates defines the lifetime and the private key of the workload certificates that istiod issues to the Istio proxies.
+type WorkloadCertificates struct {
+	// Defines the lifetime of the workload certificates requested by the Istio proxies, for example "12h".
+	// Must not exceed maxTTL. If not specified, 24h is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	DefaultTTL *metav1.Duration `json:"defaultTTL,omitempty"`
+
+	// Defines the maximum lifetime of the workload certificates that istiod issues, for example "48h".
+	// If not specified, 2160h (90 days) is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	MaxTTL *metav1.Duration `json:"maxTTL,omitempty"`
+
+	// Defines the algorithm of the private key of the workload certificates. If not specified, RSA is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=RSA;ECDSA
+	KeyAlgorithm *KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+
+	// Defines the size of the private key of the workload certificates: 2048, 3072, or 4096 for RSA, and 256 or 384 for ECDSA.
+	// If not specified, 3072 is used for RSA and 256 for ECDSA.
+	// +kubebuilder:validation:Optional
+	KeySize *int32 `json:"keySize,omitempty"`
+}
+
+// TTLs returns the default and the maximum lifetime of the workload certificates.
+func (w *WorkloadCertificates) TTLs() (defaultTTL, maxTTL time.Duration) {
+	defaultTTL, maxTTL = DefaultWorkloadCertificateTTL, DefaultWorkloadCertificateMaxTTL
+	if w == nil {
+		return defaultTTL, maxTTL
+	}
+	if w.DefaultTTL != nil {
+		defaultTTL = w.DefaultTTL.Duration
+	}
+	if w.MaxTTL != nil {
+		maxTTL = w.MaxTTL.Duration
+	}
+	return defaultTTL, maxTTL
+}
+
+// GetKeyAlgorithm returns the configured key algorithm, or RSA if none is configured.
+func (w *WorkloadCertificates) GetKeyAlgorithm() KeyAlgorithm {
+	if w == nil || w.KeyAlgorithm == nil {
+		return KeyAlgorithmRSA
+	}
+	return *w.KeyAlgorithm
+}
+
+// ECCCurve returns the curve used for the given ECDSA key size and whether the key size is supported.
+func ECCCurve(keySize int32) (string, bool) {
+	curve, ok := eccCurves[keySize]
+	return curve, ok
+}
+
+// proxyMetadata returns the proxy metadata that configures the lifetime and the private key of the certificates requested
+// by the Istio proxies.
+func (w *WorkloadCertificates) proxyMetadata() map[string]string {
+	metadata := map[string]string{}
+	if w.DefaultTTL != nil {
+		metadata[ProxyMetadataSecretTTL] = w.DefaultTTL.Duration.String()
+	}
+
+	switch w.GetKeyAlgorithm() {
+	case KeyAlgorithmECDSA:
+		metadata[ProxyMetadataECCSignatureAlgorithm] = string(KeyAlgorithmECDSA)
+		if w.KeySize != nil {
+			if curve, ok := ECCCurve(*w.KeySize); ok {
+				metadata[ProxyMetadataECCCurve] = curve
+			}
+		}
+	case KeyAlgorithmRSA:
+		if w.KeySize != nil {
+			metadata[ProxyMetadataRSAKeySize] = strconv.Itoa(int(*w.KeySize))
+		}
+	}
+
+	return metadata
+}
+
+func (m *meshConfigBuilder) BuildWorkloadCertificates(workloadCertificates *WorkloadCertificates) *meshConfigBuilder {
+	if workloadCertificates == nil {
+		return m
+	}
+
+	for key, value := range workloadCertificates.proxyMetadata() {
+		_, err := m.AddProxyMetadata(key, value)
+		if err != nil {
+			return nil
+		}
+	}
+
+	return m
+}
+
+// mergeWorkloadCertificates configures the default and the maximum lifetime of the workload certificates issued by istiod.
+func (i *Istio) mergeWorkloadCertificates(op iopv1alpha1.IstioOperator) iopv1alpha1.IstioOperator {
+	w := i.Spec.Config.WorkloadCertificates
+	if w == nil {
+		return op
+	}
+
+	if w.DefaultTTL != nil {
+		setPilotEnv(&op, defaultWorkloadCertTTLEnvName, w.DefaultTTL.Duration.String())
+	}
+	if w.MaxTTL != nil {
+		setPilotEnv(&op, maxWorkloadCertTTLEnvName, w.MaxTTL.Duration.String())
+	}
+
+	return op
+}
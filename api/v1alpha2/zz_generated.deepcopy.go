@@ -21,12 +21,68 @@ limitations under the License.
 package v1alpha2
 
 import (
-	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLog) DeepCopyInto(out *AccessLog) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLog.
+func (in *AccessLog) DeepCopy() *AccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalIngressGateway) DeepCopyInto(out *AdditionalIngressGateway) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.K8s != nil {
+		in, out := &in.K8s, &out.K8s
+		*out = new(KubernetesResourcesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalIngressGateway.
+func (in *AdditionalIngressGateway) DeepCopy() *AdditionalIngressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalIngressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Authorizer) DeepCopyInto(out *Authorizer) {
 	*out = *in
@@ -35,6 +91,31 @@ func (in *Authorizer) DeepCopyInto(out *Authorizer) {
 		*out = new(Headers)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailOpen != nil {
+		in, out := &in.FailOpen, &out.FailOpen
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StatusOnError != nil {
+		in, out := &in.StatusOnError, &out.StatusOnError
+		*out = new(string)
+		**out = **in
+	}
+	if in.IncludeRequestBodyInCheck != nil {
+		in, out := &in.IncludeRequestBodyInCheck, &out.IncludeRequestBodyInCheck
+		*out = new(RequestBody)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Authorizer.
@@ -47,6 +128,46 @@ func (in *Authorizer) DeepCopy() *Authorizer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CASecretReference) DeepCopyInto(out *CASecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CASecretReference.
+func (in *CASecretReference) DeepCopy() *CASecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CASecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthority) DeepCopyInto(out *CertificateAuthority) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(CASecretReference)
+		**out = **in
+	}
+	if in.CAAddress != nil {
+		in, out := &in.CAAddress, &out.CAAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthority.
+func (in *CertificateAuthority) DeepCopy() *CertificateAuthority {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthority)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CniComponent) DeepCopyInto(out *CniComponent) {
 	*out = *in
@@ -72,7 +193,7 @@ func (in *CniK8sConfig) DeepCopyInto(out *CniK8sConfig) {
 	*out = *in
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
+		*out = new(corev1.Affinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
@@ -80,6 +201,25 @@ func (in *CniK8sConfig) DeepCopyInto(out *CniK8sConfig) {
 		*out = new(Resources)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CniK8sConfig.
@@ -92,6 +232,21 @@ func (in *CniK8sConfig) DeepCopy() *CniK8sConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentStatus) DeepCopyInto(out *ComponentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentStatus.
+func (in *ComponentStatus) DeepCopy() *ComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
@@ -102,7 +257,7 @@ func (in *Components) DeepCopyInto(out *Components) {
 	}
 	if in.IngressGateway != nil {
 		in, out := &in.IngressGateway, &out.IngressGateway
-		*out = new(IstioComponent)
+		*out = new(IngressGateway)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Cni != nil {
@@ -120,6 +275,13 @@ func (in *Components) DeepCopyInto(out *Components) {
 		*out = new(EgressGateway)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalIngressGateways != nil {
+		in, out := &in.AdditionalIngressGateways, &out.AdditionalIngressGateways
+		*out = make([]AdditionalIngressGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Components.
@@ -156,7 +318,57 @@ func (in *Config) DeepCopyInto(out *Config) {
 		*out = new(string)
 		**out = **in
 	}
-	out.Telemetry = in.Telemetry
+	if in.OutboundTrafficPolicy != nil {
+		in, out := &in.OutboundTrafficPolicy, &out.OutboundTrafficPolicy
+		*out = new(string)
+		**out = **in
+	}
+	in.Telemetry.DeepCopyInto(&out.Telemetry)
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MTLS != nil {
+		in, out := &in.MTLS, &out.MTLS
+		*out = new(MTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSProxy != nil {
+		in, out := &in.DNSProxy, &out.DNSProxy
+		*out = new(DNSProxy)
+		**out = **in
+	}
+	if in.LocalityLoadBalancing != nil {
+		in, out := &in.LocalityLoadBalancing, &out.LocalityLoadBalancing
+		*out = new(LocalityLoadBalancing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(CertificateAuthority)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustDomain != nil {
+		in, out := &in.TrustDomain, &out.TrustDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrustDomainAliases != nil {
+		in, out := &in.TrustDomainAliases, &out.TrustDomainAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootCA != nil {
+		in, out := &in.RootCA, &out.RootCA
+		*out = new(RootCA)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadCertificates != nil {
+		in, out := &in.WorkloadCertificates, &out.WorkloadCertificates
+		*out = new(WorkloadCertificates)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Config.
@@ -169,6 +381,36 @@ func (in *Config) DeepCopy() *Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProxy) DeepCopyInto(out *DNSProxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSProxy.
+func (in *DNSProxy) DeepCopy() *DNSProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneStatus) DeepCopyInto(out *DataPlaneStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneStatus.
+func (in *DataPlaneStatus) DeepCopy() *DataPlaneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressGateway) DeepCopyInto(out *EgressGateway) {
 	*out = *in
@@ -292,6 +534,100 @@ func (in *InCheck) DeepCopy() *InCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressGateway) DeepCopyInto(out *IngressGateway) {
+	*out = *in
+	if in.K8s != nil {
+		in, out := &in.K8s, &out.K8s
+		*out = new(KubernetesResourcesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(IngressGatewayService)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGateway.
+func (in *IngressGateway) DeepCopy() *IngressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressGatewayService) DeepCopyInto(out *IngressGatewayService) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]IngressGatewayServicePort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGatewayService.
+func (in *IngressGatewayService) DeepCopy() *IngressGatewayService {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressGatewayService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressGatewayServicePort) DeepCopyInto(out *IngressGatewayServicePort) {
+	*out = *in
+	if in.TargetPort != nil {
+		in, out := &in.TargetPort, &out.TargetPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGatewayServicePort.
+func (in *IngressGatewayServicePort) DeepCopy() *IngressGatewayServicePort {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressGatewayServicePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Istio) DeepCopyInto(out *Istio) {
 	*out = *in
@@ -402,15 +738,30 @@ func (in *IstioStatus) DeepCopyInto(out *IstioStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = new([]metav1.Condition)
+		*out = new([]v1.Condition)
 		if **in != nil {
 			in, out := *in, *out
-			*out = make([]metav1.Condition, len(*in))
+			*out = make([]v1.Condition, len(*in))
 			for i := range *in {
 				(*in)[i].DeepCopyInto(&(*out)[i])
 			}
 		}
 	}
+	if in.DataPlane != nil {
+		in, out := &in.DataPlane, &out.DataPlane
+		*out = new(DataPlaneStatus)
+		**out = **in
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManualRestartWorkloads != nil {
+		in, out := &in.ManualRestartWorkloads, &out.ManualRestartWorkloads
+		*out = make([]ManualRestartWorkload, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioStatus.
@@ -441,6 +792,37 @@ func (in *KubernetesResourcesConfig) DeepCopyInto(out *KubernetesResourcesConfig
 		*out = new(Resources)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesResourcesConfig.
@@ -453,6 +835,120 @@ func (in *KubernetesResourcesConfig) DeepCopy() *KubernetesResourcesConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityDistribute) DeepCopyInto(out *LocalityDistribute) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make(map[string]uint32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityDistribute.
+func (in *LocalityDistribute) DeepCopy() *LocalityDistribute {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityDistribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityFailover) DeepCopyInto(out *LocalityFailover) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityFailover.
+func (in *LocalityFailover) DeepCopy() *LocalityFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityLoadBalancing) DeepCopyInto(out *LocalityLoadBalancing) {
+	*out = *in
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = make([]LocalityFailover, len(*in))
+		copy(*out, *in)
+	}
+	if in.Distribute != nil {
+		in, out := &in.Distribute, &out.Distribute
+		*out = make([]LocalityDistribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityLoadBalancing.
+func (in *LocalityLoadBalancing) DeepCopy() *LocalityLoadBalancing {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityLoadBalancing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MTLS) DeepCopyInto(out *MTLS) {
+	*out = *in
+	if in.NamespaceExceptions != nil {
+		in, out := &in.NamespaceExceptions, &out.NamespaceExceptions
+		*out = make([]MTLSNamespaceException, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLS.
+func (in *MTLS) DeepCopy() *MTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(MTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MTLSNamespaceException) DeepCopyInto(out *MTLSNamespaceException) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSNamespaceException.
+func (in *MTLSNamespaceException) DeepCopy() *MTLSNamespaceException {
+	if in == nil {
+		return nil
+	}
+	out := new(MTLSNamespaceException)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManualRestartWorkload) DeepCopyInto(out *ManualRestartWorkload) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManualRestartWorkload.
+func (in *ManualRestartWorkload) DeepCopy() *ManualRestartWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(ManualRestartWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metrics) DeepCopyInto(out *Metrics) {
 	*out = *in
@@ -483,6 +979,31 @@ func (in *PilotFeatures) DeepCopy() *PilotFeatures {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudget) DeepCopyInto(out *PodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudget.
+func (in *PodDisruptionBudget) DeepCopy() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyComponent) DeepCopyInto(out *ProxyComponent) {
 	*out = *in
@@ -491,6 +1012,31 @@ func (in *ProxyComponent) DeepCopyInto(out *ProxyComponent) {
 		*out = new(ProxyK8sConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HoldApplicationUntilProxyStarts != nil {
+		in, out := &in.HoldApplicationUntilProxyStarts, &out.HoldApplicationUntilProxyStarts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TerminationDrainDuration != nil {
+		in, out := &in.TerminationDrainDuration, &out.TerminationDrainDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExitOnZeroActiveConnections != nil {
+		in, out := &in.ExitOnZeroActiveConnections, &out.ExitOnZeroActiveConnections
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NativeSidecar != nil {
+		in, out := &in.NativeSidecar, &out.NativeSidecar
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyComponent.
@@ -538,6 +1084,21 @@ func (in *ReasonWithMessage) DeepCopy() *ReasonWithMessage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestBody) DeepCopyInto(out *RequestBody) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestBody.
+func (in *RequestBody) DeepCopy() *RequestBody {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceClaims) DeepCopyInto(out *ResourceClaims) {
 	*out = *in
@@ -613,6 +1174,31 @@ func (in *RollingUpdate) DeepCopy() *RollingUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RootCA) DeepCopyInto(out *RootCA) {
+	*out = *in
+	if in.ExpiryWarningThreshold != nil {
+		in, out := &in.ExpiryWarningThreshold, &out.ExpiryWarningThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExpiryErrorThreshold != nil {
+		in, out := &in.ExpiryErrorThreshold, &out.ExpiryErrorThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RootCA.
+func (in *RootCA) DeepCopy() *RootCA {
+	if in == nil {
+		return nil
+	}
+	out := new(RootCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Strategy) DeepCopyInto(out *Strategy) {
 	*out = *in
@@ -637,6 +1223,11 @@ func (in *Strategy) DeepCopy() *Strategy {
 func (in *Telemetry) DeepCopyInto(out *Telemetry) {
 	*out = *in
 	out.Metrics = in.Metrics
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Telemetry.
@@ -693,3 +1284,89 @@ func (in *ToUpstream) DeepCopy() *ToUpstream {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tracing) DeepCopyInto(out *Tracing) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]*TracingProvider, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TracingProvider)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SamplingPercentage != nil {
+		in, out := &in.SamplingPercentage, &out.SamplingPercentage
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tracing.
+func (in *Tracing) DeepCopy() *Tracing {
+	if in == nil {
+		return nil
+	}
+	out := new(Tracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingProvider) DeepCopyInto(out *TracingProvider) {
+	*out = *in
+	if in.MaxTagLength != nil {
+		in, out := &in.MaxTagLength, &out.MaxTagLength
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingProvider.
+func (in *TracingProvider) DeepCopy() *TracingProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadCertificates) DeepCopyInto(out *WorkloadCertificates) {
+	*out = *in
+	if in.DefaultTTL != nil {
+		in, out := &in.DefaultTTL, &out.DefaultTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.KeyAlgorithm != nil {
+		in, out := &in.KeyAlgorithm, &out.KeyAlgorithm
+		*out = new(KeyAlgorithm)
+		**out = **in
+	}
+	if in.KeySize != nil {
+		in, out := &in.KeySize, &out.KeySize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadCertificates.
+func (in *WorkloadCertificates) DeepCopy() *WorkloadCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,66 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+// +kubebuilder:validation:Optional
+
+type Components struct {
+	// Pilot defines component configuration for Istiod
+	Pilot *v1alpha2.IstioComponent `json:"pilot,omitempty"`
+	// IngressGateway defines component configurations for Istio Ingress Gateway
+	IngressGateway *v1alpha2.IngressGateway `json:"ingressGateway,omitempty"`
+	// Cni defines component configuration for Istio CNI DaemonSet
+	Cni *CniComponent `json:"cni,omitempty"`
+	// Proxy defines component configuration for Istio proxy sidecar
+	Proxy *ProxyComponent `json:"proxy,omitempty"`
+	// +kubebuilder:validation:Optional
+	EgressGateway *v1alpha2.EgressGateway `json:"egressGateway,omitempty"`
+	// AdditionalIngressGateways defines Istio Ingress Gateways that are installed next to the default istio-ingressgateway, for example an internal-only gateway
+	// +kubebuilder:validation:Optional
+	AdditionalIngressGateways []v1alpha2.AdditionalIngressGateway `json:"additionalIngressGateways,omitempty"`
+}
+
+// ProxyComponent defines configuration for Istio proxies.
+type ProxyComponent struct {
+	// +kubebuilder:validation:Optional
+	K8s *v1alpha2.ProxyK8sConfig `json:"k8s,omitempty"`
+
+	// Defines whether the application container starts only after the Istio proxy has started.
+	// If not specified, "true" is used.
+	// +kubebuilder:validation:Optional
+	HoldApplicationUntilProxyStarts *bool `json:"holdApplicationUntilProxyStarts,omitempty"`
+
+	// Defines how long the Istio proxy drains existing connections on shutdown, for example "5s" or "1m".
+	// If not specified, Istio's default of 5s is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	TerminationDrainDuration *metav1.Duration `json:"terminationDrainDuration,omitempty"`
+
+	// Defines whether the Istio proxy exits as soon as there are no active connections left during shutdown,
+	// instead of waiting for the whole termination drain duration.
+	// +kubebuilder:validation:Optional
+	ExitOnZeroActiveConnections *bool `json:"exitOnZeroActiveConnections,omitempty"`
+
+	// Defines the number of worker threads of the Istio proxy. If set to 0, a worker thread is started for each CPU core.
+	// If not specified, the number of worker threads is derived from the CPU limit of the Istio proxy.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Concurrency *int32 `json:"concurrency,omitempty"`
+
+	// Defines whether the Istio proxy is injected as a native sidecar container, which is an init container with restartPolicy set to Always.
+	// If not specified, the default sidecar type of the Istio module version is used.
+	// The sidecar.istio.io/nativeSidecar annotation of a Pod takes precedence over this setting.
+	// +kubebuilder:validation:Optional
+	NativeSidecar *bool `json:"nativeSidecar,omitempty"`
+}
+
+// CniComponent defines configuration for CNI Istio component.
+type CniComponent struct {
+	// +kubebuilder:validation:Required
+	K8s *v1alpha2.CniK8sConfig `json:"k8s"`
+}
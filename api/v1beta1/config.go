@@ -0,0 +1,436 @@
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:validation:Optional
+
+// Config is the configuration for the Istio installation.
+type Config struct {
+	// Defines the number of trusted proxies deployed in front of the Istio gateway proxy.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	NumTrustedProxies *int `json:"numTrustedProxies,omitempty"`
+
+	// Defines a list of external authorization providers.
+	Authorizers []*Authorizer `json:"authorizers,omitempty"`
+
+	// Defines the external traffic policy for the Istio Ingress Gateway Service. Valid configurations are "Local" or "Cluster". The external traffic policy set to "Local" preserves the client IP in the request, but also introduces the risk of unbalanced traffic distribution.
+	// WARNING: Switching `externalTrafficPolicy` may result in a temporal increase in request delay. Make sure that this is acceptable.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Local;Cluster
+	GatewayExternalTrafficPolicy *string `json:"gatewayExternalTrafficPolicy,omitempty"`
+
+	// Defines the outbound traffic policy of the mesh. With "REGISTRY_ONLY", the sidecar proxies only allow traffic to hosts registered in the service registry,
+	// for example, through ServiceEntries. With "ALLOW_ANY", traffic to unknown hosts is passed through. If not specified, "ALLOW_ANY" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ALLOW_ANY;REGISTRY_ONLY
+	OutboundTrafficPolicy *string `json:"outboundTrafficPolicy,omitempty"`
+
+	// Defines the telemetry configuration of Istio.
+	// +kubebuilder:validation:Optional
+	Telemetry Telemetry `json:"telemetry,omitempty"`
+
+	// Defines the log format of the kyma-default-logger and kyma-default-otel-logger access log providers.
+	// +kubebuilder:validation:Optional
+	AccessLog *AccessLog `json:"accessLog,omitempty"`
+
+	// Defines the mesh-wide mutual TLS mode and the namespaces that use a different mode.
+	// +kubebuilder:validation:Optional
+	MTLS *MTLS `json:"mtls,omitempty"`
+
+	// Defines DNS proxying of the Istio sidecar proxies. Changing the configuration restarts the sidecar proxies.
+	// +kubebuilder:validation:Optional
+	DNSProxy *DNSProxy `json:"dnsProxy,omitempty"`
+
+	// Defines locality-aware load balancing of the mesh, which keeps traffic in the locality of the client and defines the failover
+	// to other localities.
+	// +kubebuilder:validation:Optional
+	LocalityLoadBalancing *LocalityLoadBalancing `json:"localityLoadBalancing,omitempty"`
+
+	// Defines the certificate authority that signs the workload certificates. If not specified, istiod uses its self-signed root certificate.
+	// +kubebuilder:validation:Optional
+	CertificateAuthority *CertificateAuthority `json:"certificateAuthority,omitempty"`
+
+	// Defines the trust domain of the mesh, which is part of the SPIFFE identity of the workloads. If not specified, "cluster.local" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	TrustDomain *string `json:"trustDomain,omitempty"`
+
+	// Defines the trust domains that are treated as equal to the trust domain of the mesh, for example, the previous trust domain
+	// during a migration.
+	// +kubebuilder:validation:Optional
+	TrustDomainAliases []string `json:"trustDomainAliases,omitempty"`
+
+	// Defines the expiry monitoring of the CA certificates of the mesh and the rotation of the root CA.
+	// +kubebuilder:validation:Optional
+	RootCA *RootCA `json:"rootCA,omitempty"`
+
+	// Defines the lifetime and the private key of the workload certificates that istiod issues to the Istio proxies.
+	// +kubebuilder:validation:Optional
+	WorkloadCertificates *WorkloadCertificates `json:"workloadCertificates,omitempty"`
+}
+
+type AuthorizerProtocol string
+
+type Authorizer struct {
+	// A unique name identifying the extension authorization provider.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Specifies the protocol used to communicate with the authorization service. Valid values are "HTTP" and "GRPC".
+	// If not specified, "HTTP" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=HTTP;GRPC
+	Protocol AuthorizerProtocol `json:"protocol,omitempty"`
+
+	// Specifies the service that implements the Envoy ext_authz HTTP or gRPC authorization service.
+	// The format is "[<Namespace>/]<Hostname>".
+	// The specification of "<Namespace>"
+	// is required only when it is insufficient to unambiguously resolve a service in the service registry.
+	// The "<Hostname>" is a fully qualified host name of a service defined by the Kubernetes service or ServiceEntry.
+	// The recommended format is "[<Namespace>/]<Hostname>"
+	// Example: "my-ext-authz.foo.svc.cluster.local" or "bar/my-ext-authz".
+	// +kubebuilder:validation:Required
+	Service string `json:"service"`
+
+	// Specifies the port of the service.
+	// +kubebuilder:validation:Required
+	Port uint32 `json:"port"`
+
+	// Specifies headers to be included, added or forwarded during authorization.
+	// Applicable only to HTTP authorizers.
+	Headers *Headers `json:"headers,omitempty"`
+
+	// Specifies the prefix added to the value of the *Path* header in the authorization request.
+	// For example, setting this to "/auth" for an original request at path "/users" causes the
+	// authorization request to be sent to the authorization service at the path "/auth/users".
+	// If not specified, Istio's default is used.
+	// Applicable only to HTTP authorizers.
+	// +kubebuilder:validation:Optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+
+	// Specifies the maximum duration that the proxy waits for a response from the authorization service.
+	// The value must be a valid duration, for example "500ms", "10s" or "1m30s".
+	// If not specified, Istio's default timeout of 600s is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// If true, the request is allowed even if the communication with the authorization service has failed,
+	// or if the authorization service has returned an HTTP 5xx error.
+	// If not specified, the request is rejected.
+	// +kubebuilder:validation:Optional
+	FailOpen *bool `json:"failOpen,omitempty"`
+
+	// Sets the HTTP status that is returned to the client when there is a network error between the proxy and the authorization service.
+	// Has no effect if failOpen is enabled.
+	// If not specified, "403" (Forbidden) is returned.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[1-5][0-9]{2}$`
+	StatusOnError *string `json:"statusOnError,omitempty"`
+
+	// Specifies whether the body of the request is included in the authorization request.
+	// If not specified, the body is not sent to the authorization service.
+	// +kubebuilder:validation:Optional
+	IncludeRequestBodyInCheck *RequestBody `json:"includeRequestBodyInCheck,omitempty"`
+}
+
+type RequestBody struct {
+	// Sets the maximum size of the request body, in bytes, that is buffered and sent to the authorization service.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestBytes uint32 `json:"maxRequestBytes"`
+
+	// If true, only the first MaxRequestBytes of the body are sent to the authorization service when the body exceeds the limit.
+	// If false, requests with a body larger than MaxRequestBytes are rejected with HTTP 413.
+	// +kubebuilder:validation:Optional
+	AllowPartialMessage bool `json:"allowPartialMessage,omitempty"`
+}
+
+type Headers struct {
+	// Defines headers to be included or added in check authorization request.
+	InCheck *InCheck `json:"inCheck,omitempty"`
+
+	// Defines headers to be forwarded to the upstream.
+	ToUpstream *ToUpstream `json:"toUpstream,omitempty"`
+
+	// Defines headers to be forwarded to the downstream.
+	ToDownstream *ToDownstream `json:"toDownstream,omitempty"`
+}
+
+type InCheck struct {
+	// List of client request headers that should be included in the authorization request sent to the authorization service.
+	// Note that in addition to the headers specified here, the following headers are included by default:
+	// 1. *Host*, *Method*, *Path* and *Content-Length* are automatically sent.
+	// 2. *Content-Length* will be set to 0, and the request will not have a message body. However, the authorization request can include the buffered client request body (controlled by include_request_body_in_check setting), consequently the value of Content-Length of the authorization request reflects the size of its payload size.
+	Include []string `json:"include,omitempty"`
+
+	// Set of additional fixed headers that should be included in the authorization request sent to the authorization service.
+	// The Key is the header name and value is the header value.
+	// Note that client request of the same key or headers specified in `Include` will be overridden.
+	Add map[string]string `json:"add,omitempty"`
+}
+
+type ToUpstream struct {
+	// List of headers from the authorization service that should be added or overridden in the original request and forwarded to the upstream when the authorization check result is allowed (HTTP code 200).
+	// If not specified, the original request will not be modified and forwarded to backend as-is.
+	// Note, any existing headers will be overridden.
+	OnAllow []string `json:"onAllow,omitempty"`
+}
+
+type ToDownstream struct {
+	// List of headers from the authorization service that should be forwarded to downstream when the authorization check result is allowed (HTTP code 200).
+	// If not specified, the original response will not be modified and forwarded to downstream as-is.
+	// Note, any existing headers will be overridden.
+	OnAllow []string `json:"onAllow,omitempty"`
+
+	// List of headers from the authorization service that should be forwarded to downstream when the authorization check result is not allowed (HTTP code other than 200).
+	// If not specified, all the authorization response headers, except *Authority (Host)* will be in the response to the downstream.
+	// When a header is included in this list, *Path*, *Status*, *Content-Length*, *WWWAuthenticate* and *Location* are automatically added.
+	// Note, the body from the authorization service is always included in the response to downstream.
+	OnDeny []string `json:"onDeny,omitempty"`
+}
+
+type TracingProviderType string
+
+type Telemetry struct {
+	// Istio telemetry configuration related to metrics
+	// +kubebuilder:validation:Optional
+	Metrics Metrics `json:"metrics,omitempty"`
+
+	// Istio telemetry configuration related to distributed tracing
+	// +kubebuilder:validation:Optional
+	Tracing *Tracing `json:"tracing,omitempty"`
+}
+
+type Metrics struct {
+	// Defines whether the prometheusMerge feature is enabled. If yes, appropriate prometheus.io annotations will be added to all data plane pods to set up scraping.
+	// If these annotations already exist, they will be overwritten. With this option, the Envoy sidecar will merge Istio’s metrics with the application metrics.
+	// The merged metrics will be scraped from :15020/stats/prometheus.
+	// +kubebuilder:validation:Optional
+	PrometheusMerge bool `json:"prometheusMerge,omitempty"`
+}
+
+type Tracing struct {
+	// Defines the tracing providers registered as extension providers in the mesh config.
+	// +kubebuilder:validation:Optional
+	Providers []*TracingProvider `json:"providers,omitempty"`
+
+	// Defines the percentage of requests that are sampled by default. The value must be between 0 and 100.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SamplingPercentage *int `json:"samplingPercentage,omitempty"`
+
+	// Defines whether the tracing providers are set as the default tracing providers of the mesh to enable them for all workloads.
+	// If disabled, the providers must be enabled by a Telemetry resource managed by the user.
+	// +kubebuilder:validation:Optional
+	MeshWide bool `json:"meshWide,omitempty"`
+}
+
+type TracingProvider struct {
+	// A unique name identifying the tracing provider.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Defines the type of the tracing provider. Either "OpenTelemetry" or "Zipkin".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=OpenTelemetry;Zipkin
+	Type TracingProviderType `json:"type"`
+
+	// Specifies the service that receives the traces.
+	// +kubebuilder:validation:Required
+	Service string `json:"service"`
+
+	// Specifies the port of the service.
+	// +kubebuilder:validation:Required
+	Port uint32 `json:"port"`
+
+	// Defines the maximum length of the request path included in the span tags. If not specified, Istio's default of 256 is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxTagLength *uint32 `json:"maxTagLength,omitempty"`
+}
+
+type AccessLogStrategy string
+
+// AccessLog defines the log format of the kyma-default-logger and kyma-default-otel-logger extension providers.
+type AccessLog struct {
+	// Defines how the labels are applied to the default log format. With "merge", the labels are added to the default labels
+	// and override the values of existing keys. With "replace", the labels replace all the default labels.
+	// If not specified, "merge" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=merge;replace
+	Strategy AccessLogStrategy `json:"strategy,omitempty"`
+
+	// Defines structured keys and their values included in the access log. Envoy command operators, such as "%REQ(X-TENANT)%", can be used as values.
+	// Keys with empty values are ignored. If no labels are specified, the default log format is used.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type MTLSMode string
+
+// MTLS defines the mutual TLS mode of the mesh and the namespaces that use a different mode.
+type MTLS struct {
+	// Defines the mesh-wide mutual TLS mode. If not specified, "STRICT" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE
+	Mode MTLSMode `json:"mode,omitempty"`
+
+	// Defines the namespaces that use a mutual TLS mode different from the mesh-wide mode.
+	// +kubebuilder:validation:Optional
+	NamespaceExceptions []MTLSNamespaceException `json:"namespaceExceptions,omitempty"`
+}
+
+type MTLSNamespaceException struct {
+	// Name of the namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Defines the mutual TLS mode of the workloads in the namespace.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE
+	Mode MTLSMode `json:"mode"`
+}
+
+// DNSProxy defines DNS proxying of the Istio sidecar proxies.
+// +kubebuilder:validation:XValidation:rule="self.enabled || !has(self.autoAllocate) || !self.autoAllocate",message="autoAllocate requires enabled to be true"
+type DNSProxy struct {
+	// Enables DNS proxying. The sidecar proxies capture the DNS requests of the workloads and answer them for hosts known to the mesh,
+	// for example, hosts of ServiceEntries.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// Enables automatic allocation of virtual IP addresses for ServiceEntries without addresses. This allows routing of TCP traffic
+	// to external services without stable IPs. Requires DNS proxying to be enabled.
+	// +kubebuilder:validation:Optional
+	AutoAllocate bool `json:"autoAllocate,omitempty"`
+}
+
+// LocalityLoadBalancing defines locality-aware load balancing of the mesh. The locality of a workload is derived from the
+// topology.kubernetes.io/region and topology.kubernetes.io/zone labels and the topology.istio.io/subzone label of its node,
+// and has the format "region/zone/subzone".
+type LocalityLoadBalancing struct {
+	// Enables locality-aware load balancing. Traffic is kept in the locality of the client as long as healthy endpoints are available there.
+	// Failover requires outlier detection to be configured in the DestinationRule of the service.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// Defines the region that traffic is sent to when the endpoints in the region of the client are unhealthy.
+	// Cannot be combined with distribute.
+	// +kubebuilder:validation:Optional
+	Failover []LocalityFailover `json:"failover,omitempty"`
+
+	// Defines how the traffic of clients in a locality is distributed across localities.
+	// Cannot be combined with failover.
+	// +kubebuilder:validation:Optional
+	Distribute []LocalityDistribute `json:"distribute,omitempty"`
+}
+
+type LocalityFailover struct {
+	// The region of the clients.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+
+	// The region that the traffic fails over to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	To string `json:"to"`
+}
+
+type LocalityDistribute struct {
+	// The locality of the clients, for example "us-west/zone1/*". The wildcard "*" matches all localities on its level.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+
+	// Defines the localities that receive the traffic and the percentage of the traffic each of them receives.
+	// The percentages must add up to 100.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	To map[string]uint32 `json:"to"`
+}
+
+// CertificateAuthority defines the certificate authority that signs the workload certificates of the mesh. Either a plug-in CA
+// certificate stored in a Secret or an external CA can be used.
+// +kubebuilder:validation:XValidation:rule="has(self.secret) != has(self.caAddress)",message="exactly one of secret and caAddress must be set"
+type CertificateAuthority struct {
+	// References the Secret with the plug-in CA certificates. The Secret must contain the keys "ca-cert.pem", "ca-key.pem", "root-cert.pem"
+	// and "cert-chain.pem". The Istio module validates the certificates and copies them to the cacerts Secret in the istio-system namespace.
+	// +kubebuilder:validation:Optional
+	Secret *CASecretReference `json:"secret,omitempty"`
+
+	// Defines the address of an external CA, for example, cert-manager istio-csr. The CA server of istiod is disabled.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	CAAddress *string `json:"caAddress,omitempty"`
+}
+
+type CASecretReference struct {
+	// Name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// RootCA defines when an expiring root or intermediate CA certificate of the mesh is reported and whether the root CA is rotated
+// by the Istio module.
+type RootCA struct {
+	// Defines the remaining validity of a CA certificate below which the Istio CR is set to the Warning state, for example "720h".
+	// If not specified, 720h (30 days) is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	ExpiryWarningThreshold *metav1.Duration `json:"expiryWarningThreshold,omitempty"`
+
+	// Defines the remaining validity of a CA certificate below which the Istio CR is set to the Error state, for example "168h".
+	// Must be lower than expiryWarningThreshold. If not specified, 168h (7 days) is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	ExpiryErrorThreshold *metav1.Duration `json:"expiryErrorThreshold,omitempty"`
+
+	// Enables the rotation of the root CA when the plug-in CA Secret is updated with certificates issued by a new root CA.
+	// The new root CA is added to the trust bundle next to the old one, istiod switches to the new CA, and the old root CA is removed.
+	// All Istio proxies are restarted after each of these steps. Requires certificateAuthority.secret.
+	// +kubebuilder:validation:Optional
+	AutomaticRotation bool `json:"automaticRotation,omitempty"`
+}
+
+type KeyAlgorithm string
+
+// WorkloadCertificates defines the lifetime and the private key of the workload certificates that istiod issues to the Istio proxies.
+type WorkloadCertificates struct {
+	// Defines the lifetime of the workload certificates requested by the Istio proxies, for example "12h".
+	// Must not exceed maxTTL. If not specified, 24h is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	DefaultTTL *metav1.Duration `json:"defaultTTL,omitempty"`
+
+	// Defines the maximum lifetime of the workload certificates that istiod issues, for example "48h".
+	// If not specified, 2160h (90 days) is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`
+	MaxTTL *metav1.Duration `json:"maxTTL,omitempty"`
+
+	// Defines the algorithm of the private key of the workload certificates. If not specified, RSA is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=RSA;ECDSA
+	KeyAlgorithm *KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+
+	// Defines the size of the private key of the workload certificates: 2048, 3072, or 4096 for RSA, and 256 or 384 for ECDSA.
+	// If not specified, 3072 is used for RSA and 256 for ECDSA.
+	// +kubebuilder:validation:Optional
+	KeySize *int32 `json:"keySize,omitempty"`
+}
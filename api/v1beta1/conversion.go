@@ -0,0 +1,111 @@
+package v1beta1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+// ConvertTo converts this Istio CR to the hub version v1alpha2.
+func (src *Istio) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha2.Istio)
+	if !ok {
+		return fmt.Errorf("unexpected conversion hub type %T", dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1alpha2.IstioSpec{
+		Config:            convertConfigTo(src.Spec.Config),
+		Components:        convertComponentsTo(src.Spec.Components),
+		CompatibilityMode: src.Spec.CompatibilityMode != nil && src.Spec.CompatibilityMode.Enabled,
+		DataPlaneMode:     src.Spec.DataPlaneMode,
+		UpgradeStrategy:   src.Spec.UpgradeStrategy,
+	}
+	if src.Spec.Experimental != nil {
+		dst.Spec.Experimental = &v1alpha2.Experimental{PilotFeatures: src.Spec.Experimental.Pilot}
+	}
+	dst.Status = convertStatusTo(src.Status)
+
+	return nil
+}
+
+// ConvertFrom converts the hub version v1alpha2 to this Istio CR.
+func (dst *Istio) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha2.Istio)
+	if !ok {
+		return fmt.Errorf("unexpected conversion hub type %T", srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = IstioSpec{
+		Config:          convertConfigFrom(src.Spec.Config),
+		Components:      convertComponentsFrom(src.Spec.Components),
+		DataPlaneMode:   src.Spec.DataPlaneMode,
+		UpgradeStrategy: src.Spec.UpgradeStrategy,
+	}
+	if src.Spec.CompatibilityMode {
+		dst.Spec.CompatibilityMode = &CompatibilityMode{Enabled: true}
+	}
+	if src.Spec.Experimental != nil {
+		dst.Spec.Experimental = &Experimental{Pilot: src.Spec.Experimental.PilotFeatures}
+	}
+	dst.Status = convertStatusFrom(src.Status)
+
+	return nil
+}
+
+func convertComponentsTo(src *Components) *v1alpha2.Components {
+	if src == nil {
+		return nil
+	}
+
+	dst := &v1alpha2.Components{
+		Pilot:                     src.Pilot,
+		IngressGateway:            src.IngressGateway,
+		EgressGateway:             src.EgressGateway,
+		AdditionalIngressGateways: src.AdditionalIngressGateways,
+	}
+	if src.Cni != nil {
+		dst.Cni = &v1alpha2.CniComponent{K8S: src.Cni.K8s}
+	}
+	if src.Proxy != nil {
+		dst.Proxy = &v1alpha2.ProxyComponent{
+			K8S:                             src.Proxy.K8s,
+			HoldApplicationUntilProxyStarts: src.Proxy.HoldApplicationUntilProxyStarts,
+			TerminationDrainDuration:        src.Proxy.TerminationDrainDuration,
+			ExitOnZeroActiveConnections:     src.Proxy.ExitOnZeroActiveConnections,
+			Concurrency:                     src.Proxy.Concurrency,
+			NativeSidecar:                   src.Proxy.NativeSidecar,
+		}
+	}
+	return dst
+}
+
+func convertComponentsFrom(src *v1alpha2.Components) *Components {
+	if src == nil {
+		return nil
+	}
+
+	dst := &Components{
+		Pilot:                     src.Pilot,
+		IngressGateway:            src.IngressGateway,
+		EgressGateway:             src.EgressGateway,
+		AdditionalIngressGateways: src.AdditionalIngressGateways,
+	}
+	if src.Cni != nil {
+		dst.Cni = &CniComponent{K8s: src.Cni.K8S}
+	}
+	if src.Proxy != nil {
+		dst.Proxy = &ProxyComponent{
+			K8s:                             src.Proxy.K8S,
+			HoldApplicationUntilProxyStarts: src.Proxy.HoldApplicationUntilProxyStarts,
+			TerminationDrainDuration:        src.Proxy.TerminationDrainDuration,
+			ExitOnZeroActiveConnections:     src.Proxy.ExitOnZeroActiveConnections,
+			Concurrency:                     src.Proxy.Concurrency,
+			NativeSidecar:                   src.Proxy.NativeSidecar,
+		}
+	}
+	return dst
+}
@@ -0,0 +1,321 @@
+package v1beta1
+
+import (
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+func convertConfigTo(src Config) v1alpha2.Config {
+	return v1alpha2.Config{
+		NumTrustedProxies:            src.NumTrustedProxies,
+		Authorizers:                  convertSlice(src.Authorizers, convertAuthorizerTo),
+		GatewayExternalTrafficPolicy: src.GatewayExternalTrafficPolicy,
+		OutboundTrafficPolicy:        src.OutboundTrafficPolicy,
+		Telemetry:                    convertTelemetryTo(src.Telemetry),
+		AccessLog:                    convertAccessLogTo(src.AccessLog),
+		MTLS:                         convertMTLSTo(src.MTLS),
+		DNSProxy:                     convertDNSProxyTo(src.DNSProxy),
+		LocalityLoadBalancing:        convertLocalityLoadBalancingTo(src.LocalityLoadBalancing),
+		CertificateAuthority:         convertCertificateAuthorityTo(src.CertificateAuthority),
+		TrustDomain:                  src.TrustDomain,
+		TrustDomainAliases:           src.TrustDomainAliases,
+		RootCA:                       convertRootCATo(src.RootCA),
+		WorkloadCertificates:         convertWorkloadCertificatesTo(src.WorkloadCertificates),
+	}
+}
+
+func convertConfigFrom(src v1alpha2.Config) Config {
+	return Config{
+		NumTrustedProxies:            src.NumTrustedProxies,
+		Authorizers:                  convertSlice(src.Authorizers, convertAuthorizerFrom),
+		GatewayExternalTrafficPolicy: src.GatewayExternalTrafficPolicy,
+		OutboundTrafficPolicy:        src.OutboundTrafficPolicy,
+		Telemetry:                    convertTelemetryFrom(src.Telemetry),
+		AccessLog:                    convertAccessLogFrom(src.AccessLog),
+		MTLS:                         convertMTLSFrom(src.MTLS),
+		DNSProxy:                     convertDNSProxyFrom(src.DNSProxy),
+		LocalityLoadBalancing:        convertLocalityLoadBalancingFrom(src.LocalityLoadBalancing),
+		CertificateAuthority:         convertCertificateAuthorityFrom(src.CertificateAuthority),
+		TrustDomain:                  src.TrustDomain,
+		TrustDomainAliases:           src.TrustDomainAliases,
+		RootCA:                       convertRootCAFrom(src.RootCA),
+		WorkloadCertificates:         convertWorkloadCertificatesFrom(src.WorkloadCertificates),
+	}
+}
+
+func convertAuthorizerTo(src *Authorizer) *v1alpha2.Authorizer {
+	if src == nil {
+		return nil
+	}
+	dst := &v1alpha2.Authorizer{
+		Name:          src.Name,
+		Protocol:      v1alpha2.AuthorizerProtocol(src.Protocol),
+		Service:       src.Service,
+		Port:          src.Port,
+		PathPrefix:    src.PathPrefix,
+		Timeout:       src.Timeout,
+		FailOpen:      src.FailOpen,
+		StatusOnError: src.StatusOnError,
+	}
+	if src.Headers != nil {
+		dst.Headers = &v1alpha2.Headers{}
+		if src.Headers.InCheck != nil {
+			dst.Headers.InCheck = &v1alpha2.InCheck{Include: src.Headers.InCheck.Include, Add: src.Headers.InCheck.Add}
+		}
+		if src.Headers.ToUpstream != nil {
+			dst.Headers.ToUpstream = &v1alpha2.ToUpstream{OnAllow: src.Headers.ToUpstream.OnAllow}
+		}
+		if src.Headers.ToDownstream != nil {
+			dst.Headers.ToDownstream = &v1alpha2.ToDownstream{OnAllow: src.Headers.ToDownstream.OnAllow, OnDeny: src.Headers.ToDownstream.OnDeny}
+		}
+	}
+	if src.IncludeRequestBodyInCheck != nil {
+		dst.IncludeRequestBodyInCheck = &v1alpha2.RequestBody{
+			MaxRequestBytes:     src.IncludeRequestBodyInCheck.MaxRequestBytes,
+			AllowPartialMessage: src.IncludeRequestBodyInCheck.AllowPartialMessage,
+		}
+	}
+	return dst
+}
+
+func convertAuthorizerFrom(src *v1alpha2.Authorizer) *Authorizer {
+	if src == nil {
+		return nil
+	}
+	dst := &Authorizer{
+		Name:          src.Name,
+		Protocol:      AuthorizerProtocol(src.Protocol),
+		Service:       src.Service,
+		Port:          src.Port,
+		PathPrefix:    src.PathPrefix,
+		Timeout:       src.Timeout,
+		FailOpen:      src.FailOpen,
+		StatusOnError: src.StatusOnError,
+	}
+	if src.Headers != nil {
+		dst.Headers = &Headers{}
+		if src.Headers.InCheck != nil {
+			dst.Headers.InCheck = &InCheck{Include: src.Headers.InCheck.Include, Add: src.Headers.InCheck.Add}
+		}
+		if src.Headers.ToUpstream != nil {
+			dst.Headers.ToUpstream = &ToUpstream{OnAllow: src.Headers.ToUpstream.OnAllow}
+		}
+		if src.Headers.ToDownstream != nil {
+			dst.Headers.ToDownstream = &ToDownstream{OnAllow: src.Headers.ToDownstream.OnAllow, OnDeny: src.Headers.ToDownstream.OnDeny}
+		}
+	}
+	if src.IncludeRequestBodyInCheck != nil {
+		dst.IncludeRequestBodyInCheck = &RequestBody{
+			MaxRequestBytes:     src.IncludeRequestBodyInCheck.MaxRequestBytes,
+			AllowPartialMessage: src.IncludeRequestBodyInCheck.AllowPartialMessage,
+		}
+	}
+	return dst
+}
+
+func convertTelemetryTo(src Telemetry) v1alpha2.Telemetry {
+	dst := v1alpha2.Telemetry{Metrics: v1alpha2.Metrics{PrometheusMerge: src.Metrics.PrometheusMerge}}
+	if src.Tracing != nil {
+		dst.Tracing = &v1alpha2.Tracing{
+			Providers: convertSlice(src.Tracing.Providers, func(p *TracingProvider) *v1alpha2.TracingProvider {
+				if p == nil {
+					return nil
+				}
+				return &v1alpha2.TracingProvider{
+					Name:         p.Name,
+					Type:         v1alpha2.TracingProviderType(p.Type),
+					Service:      p.Service,
+					Port:         p.Port,
+					MaxTagLength: p.MaxTagLength,
+				}
+			}),
+			SamplingPercentage: src.Tracing.SamplingPercentage,
+			MeshWide:           src.Tracing.MeshWide,
+		}
+	}
+	return dst
+}
+
+func convertTelemetryFrom(src v1alpha2.Telemetry) Telemetry {
+	dst := Telemetry{Metrics: Metrics{PrometheusMerge: src.Metrics.PrometheusMerge}}
+	if src.Tracing != nil {
+		dst.Tracing = &Tracing{
+			Providers: convertSlice(src.Tracing.Providers, func(p *v1alpha2.TracingProvider) *TracingProvider {
+				if p == nil {
+					return nil
+				}
+				return &TracingProvider{
+					Name:         p.Name,
+					Type:         TracingProviderType(p.Type),
+					Service:      p.Service,
+					Port:         p.Port,
+					MaxTagLength: p.MaxTagLength,
+				}
+			}),
+			SamplingPercentage: src.Tracing.SamplingPercentage,
+			MeshWide:           src.Tracing.MeshWide,
+		}
+	}
+	return dst
+}
+
+func convertAccessLogTo(src *AccessLog) *v1alpha2.AccessLog {
+	if src == nil {
+		return nil
+	}
+	return &v1alpha2.AccessLog{Strategy: v1alpha2.AccessLogStrategy(src.Strategy), Labels: src.Labels}
+}
+
+func convertAccessLogFrom(src *v1alpha2.AccessLog) *AccessLog {
+	if src == nil {
+		return nil
+	}
+	return &AccessLog{Strategy: AccessLogStrategy(src.Strategy), Labels: src.Labels}
+}
+
+func convertMTLSTo(src *MTLS) *v1alpha2.MTLS {
+	if src == nil {
+		return nil
+	}
+	return &v1alpha2.MTLS{
+		Mode: v1alpha2.MTLSMode(src.Mode),
+		NamespaceExceptions: convertSlice(src.NamespaceExceptions, func(e MTLSNamespaceException) v1alpha2.MTLSNamespaceException {
+			return v1alpha2.MTLSNamespaceException{Namespace: e.Namespace, Mode: v1alpha2.MTLSMode(e.Mode)}
+		}),
+	}
+}
+
+func convertMTLSFrom(src *v1alpha2.MTLS) *MTLS {
+	if src == nil {
+		return nil
+	}
+	return &MTLS{
+		Mode: MTLSMode(src.Mode),
+		NamespaceExceptions: convertSlice(src.NamespaceExceptions, func(e v1alpha2.MTLSNamespaceException) MTLSNamespaceException {
+			return MTLSNamespaceException{Namespace: e.Namespace, Mode: MTLSMode(e.Mode)}
+		}),
+	}
+}
+
+func convertDNSProxyTo(src *DNSProxy) *v1alpha2.DNSProxy {
+	if src == nil {
+		return nil
+	}
+	return &v1alpha2.DNSProxy{Enabled: src.Enabled, AutoAllocate: src.AutoAllocate}
+}
+
+func convertDNSProxyFrom(src *v1alpha2.DNSProxy) *DNSProxy {
+	if src == nil {
+		return nil
+	}
+	return &DNSProxy{Enabled: src.Enabled, AutoAllocate: src.AutoAllocate}
+}
+
+func convertLocalityLoadBalancingTo(src *LocalityLoadBalancing) *v1alpha2.LocalityLoadBalancing {
+	if src == nil {
+		return nil
+	}
+	return &v1alpha2.LocalityLoadBalancing{
+		Enabled: src.Enabled,
+		Failover: convertSlice(src.Failover, func(f LocalityFailover) v1alpha2.LocalityFailover {
+			return v1alpha2.LocalityFailover{From: f.From, To: f.To}
+		}),
+		Distribute: convertSlice(src.Distribute, func(d LocalityDistribute) v1alpha2.LocalityDistribute {
+			return v1alpha2.LocalityDistribute{From: d.From, To: d.To}
+		}),
+	}
+}
+
+func convertLocalityLoadBalancingFrom(src *v1alpha2.LocalityLoadBalancing) *LocalityLoadBalancing {
+	if src == nil {
+		return nil
+	}
+	return &LocalityLoadBalancing{
+		Enabled: src.Enabled,
+		Failover: convertSlice(src.Failover, func(f v1alpha2.LocalityFailover) LocalityFailover {
+			return LocalityFailover{From: f.From, To: f.To}
+		}),
+		Distribute: convertSlice(src.Distribute, func(d v1alpha2.LocalityDistribute) LocalityDistribute {
+			return LocalityDistribute{From: d.From, To: d.To}
+		}),
+	}
+}
+
+func convertCertificateAuthorityTo(src *CertificateAuthority) *v1alpha2.CertificateAuthority {
+	if src == nil {
+		return nil
+	}
+	dst := &v1alpha2.CertificateAuthority{CAAddress: src.CAAddress}
+	if src.Secret != nil {
+		dst.Secret = &v1alpha2.CASecretReference{Name: src.Secret.Name, Namespace: src.Secret.Namespace}
+	}
+	return dst
+}
+
+func convertCertificateAuthorityFrom(src *v1alpha2.CertificateAuthority) *CertificateAuthority {
+	if src == nil {
+		return nil
+	}
+	dst := &CertificateAuthority{CAAddress: src.CAAddress}
+	if src.Secret != nil {
+		dst.Secret = &CASecretReference{Name: src.Secret.Name, Namespace: src.Secret.Namespace}
+	}
+	return dst
+}
+
+func convertRootCATo(src *RootCA) *v1alpha2.RootCA {
+	if src == nil {
+		return nil
+	}
+	return &v1alpha2.RootCA{
+		ExpiryWarningThreshold: src.ExpiryWarningThreshold,
+		ExpiryErrorThreshold:   src.ExpiryErrorThreshold,
+		AutomaticRotation:      src.AutomaticRotation,
+	}
+}
+
+func convertRootCAFrom(src *v1alpha2.RootCA) *RootCA {
+	if src == nil {
+		return nil
+	}
+	return &RootCA{
+		ExpiryWarningThreshold: src.ExpiryWarningThreshold,
+		ExpiryErrorThreshold:   src.ExpiryErrorThreshold,
+		AutomaticRotation:      src.AutomaticRotation,
+	}
+}
+
+func convertWorkloadCertificatesTo(src *WorkloadCertificates) *v1alpha2.WorkloadCertificates {
+	if src == nil {
+		return nil
+	}
+	dst := &v1alpha2.WorkloadCertificates{DefaultTTL: src.DefaultTTL, MaxTTL: src.MaxTTL, KeySize: src.KeySize}
+	if src.KeyAlgorithm != nil {
+		keyAlgorithm := v1alpha2.KeyAlgorithm(*src.KeyAlgorithm)
+		dst.KeyAlgorithm = &keyAlgorithm
+	}
+	return dst
+}
+
+func convertWorkloadCertificatesFrom(src *v1alpha2.WorkloadCertificates) *WorkloadCertificates {
+	if src == nil {
+		return nil
+	}
+	dst := &WorkloadCertificates{DefaultTTL: src.DefaultTTL, MaxTTL: src.MaxTTL, KeySize: src.KeySize}
+	if src.KeyAlgorithm != nil {
+		keyAlgorithm := KeyAlgorithm(*src.KeyAlgorithm)
+		dst.KeyAlgorithm = &keyAlgorithm
+	}
+	return dst
+}
+
+// convertSlice converts each element of src and keeps a nil slice nil.
+func convertSlice[S, D any](src []S, convert func(S) D) []D {
+	if src == nil {
+		return nil
+	}
+	dst := make([]D, 0, len(src))
+	for _, s := range src {
+		dst = append(dst, convert(s))
+	}
+	return dst
+}
@@ -0,0 +1,55 @@
+package v1beta1
+
+import (
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+func convertStatusTo(src IstioStatus) v1alpha2.IstioStatus {
+	dst := v1alpha2.IstioStatus{
+		State:       v1alpha2.State(src.State),
+		Conditions:  src.Conditions,
+		Description: src.Description,
+		Components: convertSlice(src.Components, func(c ComponentStatus) v1alpha2.ComponentStatus {
+			return v1alpha2.ComponentStatus{
+				Name:            c.Name,
+				Kind:            c.Kind,
+				DesiredReplicas: c.DesiredReplicas,
+				ReadyReplicas:   c.ReadyReplicas,
+				Version:         c.Version,
+				Health:          v1alpha2.ComponentHealth(c.Health),
+			}
+		}),
+		ManualRestartWorkloads: convertSlice(src.ManualRestartWorkloads, func(w ManualRestartWorkload) v1alpha2.ManualRestartWorkload {
+			return v1alpha2.ManualRestartWorkload{Name: w.Name, Namespace: w.Namespace, Kind: w.Kind, Reason: w.Reason, Message: w.Message}
+		}),
+	}
+	if src.DataPlane != nil {
+		dst.DataPlane = &v1alpha2.DataPlaneStatus{SidecarWorkloads: src.DataPlane.SidecarWorkloads, AmbientWorkloads: src.DataPlane.AmbientWorkloads}
+	}
+	return dst
+}
+
+func convertStatusFrom(src v1alpha2.IstioStatus) IstioStatus {
+	dst := IstioStatus{
+		State:       State(src.State),
+		Conditions:  src.Conditions,
+		Description: src.Description,
+		Components: convertSlice(src.Components, func(c v1alpha2.ComponentStatus) ComponentStatus {
+			return ComponentStatus{
+				Name:            c.Name,
+				Kind:            c.Kind,
+				DesiredReplicas: c.DesiredReplicas,
+				ReadyReplicas:   c.ReadyReplicas,
+				Version:         c.Version,
+				Health:          ComponentHealth(c.Health),
+			}
+		}),
+		ManualRestartWorkloads: convertSlice(src.ManualRestartWorkloads, func(w v1alpha2.ManualRestartWorkload) ManualRestartWorkload {
+			return ManualRestartWorkload{Name: w.Name, Namespace: w.Namespace, Kind: w.Kind, Reason: w.Reason, Message: w.Message}
+		}),
+	}
+	if src.DataPlane != nil {
+		dst.DataPlane = &DataPlaneStatus{SidecarWorkloads: src.DataPlane.SidecarWorkloads, AmbientWorkloads: src.DataPlane.AmbientWorkloads}
+	}
+	return dst
+}
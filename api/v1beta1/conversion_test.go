@@ -0,0 +1,141 @@
+package v1beta1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // Ginkgo tests are generally written without a direct package reference
+	. "github.com/onsi/gomega"    //nolint:revive // Gomega asserts are generally written without a direct package reference
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/randfill"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/api/v1beta1"
+)
+
+const fuzzIterations = 1000
+
+func newFiller(seed int64) *randfill.Filler {
+	return randfill.NewWithSeed(seed).NilChance(0.3).NumElements(0, 3).MaxDepth(10)
+}
+
+var _ = Describe("Conversion", func() {
+	It("should convert the reshaped fields of v1beta1 to v1alpha2", func() {
+		// given
+		src := v1beta1.Istio{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"},
+			Spec: v1beta1.IstioSpec{
+				CompatibilityMode: &v1beta1.CompatibilityMode{Enabled: true},
+				Config: v1beta1.Config{
+					WorkloadCertificates: &v1beta1.WorkloadCertificates{KeyAlgorithm: ptr.To(v1beta1.KeyAlgorithm("ECDSA"))},
+					MTLS:                 &v1beta1.MTLS{NamespaceExceptions: []v1beta1.MTLSNamespaceException{{Namespace: "legacy", Mode: "PERMISSIVE"}}},
+				},
+				Experimental: &v1beta1.Experimental{Pilot: v1alpha2.PilotFeatures{EnableAlphaGatewayAPI: true}},
+				Components: &v1beta1.Components{
+					Proxy: &v1beta1.ProxyComponent{
+						K8s:           &v1alpha2.ProxyK8sConfig{Resources: &v1alpha2.Resources{Limits: &v1alpha2.ResourceClaims{CPU: ptr.To("500m")}}},
+						NativeSidecar: ptr.To(true),
+					},
+					Cni: &v1beta1.CniComponent{K8s: &v1alpha2.CniK8sConfig{PriorityClassName: ptr.To("cni-priority")}},
+				},
+			},
+		}
+		dst := v1alpha2.Istio{}
+
+		// when
+		err := src.ConvertTo(&dst)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dst.Name).To(Equal("default"))
+		Expect(dst.Spec.CompatibilityMode).To(BeTrue())
+		Expect(dst.Spec.Experimental.EnableAlphaGatewayAPI).To(BeTrue())
+		Expect(*dst.Spec.Components.Proxy.K8S.Resources.Limits.CPU).To(Equal("500m"))
+		Expect(*dst.Spec.Components.Proxy.NativeSidecar).To(BeTrue())
+		Expect(*dst.Spec.Components.Cni.K8S.PriorityClassName).To(Equal("cni-priority"))
+		Expect(*dst.Spec.Config.WorkloadCertificates.KeyAlgorithm).To(Equal(v1alpha2.KeyAlgorithmECDSA))
+		Expect(dst.Spec.Config.MTLS.NamespaceExceptions).To(ConsistOf(v1alpha2.MTLSNamespaceException{Namespace: "legacy", Mode: v1alpha2.MTLSModePermissive}))
+	})
+
+	It("should convert a missing compatibility mode to a disabled compatibility mode", func() {
+		// given
+		src := v1beta1.Istio{}
+		dst := v1alpha2.Istio{}
+
+		// when
+		err := src.ConvertTo(&dst)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dst.Spec.CompatibilityMode).To(BeFalse())
+	})
+
+	It("should omit the compatibility mode when it is disabled in v1alpha2", func() {
+		// given
+		src := v1alpha2.Istio{Spec: v1alpha2.IstioSpec{CompatibilityMode: false}}
+		dst := v1beta1.Istio{}
+
+		// when
+		err := dst.ConvertFrom(&src)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dst.Spec.CompatibilityMode).To(BeNil())
+	})
+
+	It("should not lose data when converting v1alpha2 to v1beta1 and back", func() {
+		for i := range fuzzIterations {
+			// given
+			filler := newFiller(int64(i))
+			original := v1alpha2.Istio{}
+			filler.Fill(&original)
+			original.TypeMeta = metav1.TypeMeta{}
+
+			// when
+			converted := v1beta1.Istio{}
+			Expect(converted.ConvertFrom(original.DeepCopy())).To(Succeed())
+			roundTripped := v1alpha2.Istio{}
+			Expect(converted.ConvertTo(&roundTripped)).To(Succeed())
+
+			// then
+			Expect(apiequality.Semantic.DeepEqual(original, roundTripped)).To(BeTrue(), "round trip of seed %d lost data", i)
+		}
+	})
+
+	It("should not lose data when converting v1beta1 to v1alpha2 and back", func() {
+		for i := range fuzzIterations {
+			// given
+			filler := newFiller(int64(i))
+			original := v1beta1.Istio{}
+			filler.Fill(&original)
+			original.TypeMeta = metav1.TypeMeta{}
+			// v1alpha2 can't distinguish a disabled compatibility mode from a missing one, so both are converted back to a missing one.
+			if original.Spec.CompatibilityMode != nil && !original.Spec.CompatibilityMode.Enabled {
+				original.Spec.CompatibilityMode = nil
+			}
+
+			// when
+			hub := v1alpha2.Istio{}
+			Expect(original.DeepCopy().ConvertTo(&hub)).To(Succeed())
+			roundTripped := v1beta1.Istio{}
+			Expect(roundTripped.ConvertFrom(&hub)).To(Succeed())
+
+			// then
+			Expect(apiequality.Semantic.DeepEqual(original, roundTripped)).To(BeTrue(), "round trip of seed %d lost data", i)
+		}
+	})
+
+	It("should return an error when converting to a hub of an unexpected type", func() {
+		// given
+		src := v1beta1.Istio{}
+
+		// when
+		err := src.ConvertTo(&unexpectedHub{})
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+type unexpectedHub struct {
+	v1alpha2.Istio
+}
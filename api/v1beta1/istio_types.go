@@ -0,0 +1,91 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+// IstioSpec describes the desired specification for installing or updating Istio.
+// Compared to v1alpha2, the experimental pilot features are a named field, all Kubernetes resource settings are named K8s,
+// and the compatibility mode is an object.
+type IstioSpec struct {
+	// +kubebuilder:validation:Optional
+	Config Config `json:"config,omitempty"`
+	// +kubebuilder:validation:Optional
+	Components *Components `json:"components,omitempty"`
+	// +kubebuilder:validation:Optional
+	Experimental *Experimental `json:"experimental,omitempty"`
+	// Defines the compatibility mode, which applies the Istio settings of the previous Istio module version.
+	// +kubebuilder:validation:Optional
+	CompatibilityMode *CompatibilityMode `json:"compatibilityMode,omitempty"`
+	// Defines the data plane mode of the service mesh. In the "ambient" mode, the ztunnel component is installed
+	// and workloads in namespaces labeled with istio.io/dataplane-mode=ambient are part of the mesh without sidecar proxies.
+	// If not specified, "sidecar" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=sidecar;ambient
+	DataPlaneMode v1alpha2.DataPlaneMode `json:"dataPlaneMode,omitempty"`
+	// Defines how Istio is upgraded to a new version. With "InPlace", the running Istio control plane is upgraded directly.
+	// With "Canary", the new version is installed as a separate revision and workloads are moved to it namespace by namespace.
+	// The previous revision is removed after all proxies run the new version. If not specified, "InPlace" is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=InPlace;Canary
+	UpgradeStrategy v1alpha2.UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+}
+
+// CompatibilityMode defines whether the Istio settings of the previous Istio module version are applied.
+type CompatibilityMode struct {
+	// Enables the compatibility mode.
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// Experimental defines the experimental features of Istio. They are only applied by experimental builds of the Istio module.
+type Experimental struct {
+	// +kubebuilder:validation:Optional
+	Pilot v1alpha2.PilotFeatures `json:"pilot"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:categories={kyma-modules,kyma-istio}
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:JSONPath=".status.state",name="State",type="string"
+
+// Istio contains Istio CR specification and current status.
+type Istio struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IstioSpec   `json:"spec,omitempty"`
+	Status IstioStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// IstioList contains a list of Istio's.
+type IstioList struct {
+	metav1.TypeMeta `        json:",inline"`
+	metav1.ListMeta `        json:"metadata,omitempty"`
+	Items           []Istio `json:"items"`
+}
+
+//nolint:gochecknoinits // this is a scaffolded file. TODO: remove init function
+func init() {
+	SchemeBuilder.Register(&Istio{}, &IstioList{})
+}
@@ -0,0 +1,67 @@
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// State signifies the current state of the Istio CR.
+type State string
+
+// IstioStatus defines the observed state of IstioCR.
+type IstioStatus struct {
+	// State signifies the current state of CustomObject. Value
+	// can be one of ("Ready", "Processing", "Error", "Deleting", "Warning").
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Processing;Deleting;Ready;Error;Warning
+	State State `json:"state"`
+	//  Conditions associated with IstioStatus.
+	Conditions *[]metav1.Condition `json:"conditions,omitempty"`
+	// Description of Istio status
+	Description string `json:"description,omitempty"`
+	// Number of workloads running in each data plane mode.
+	DataPlane *DataPlaneStatus `json:"dataPlane,omitempty"`
+	// Health of the Istio workloads managed by the module.
+	Components []ComponentStatus `json:"components,omitempty"`
+	// Workloads whose Istio sidecar proxies could not be restarted by the module in the last reconciliation and must be restarted manually.
+	ManualRestartWorkloads []ManualRestartWorkload `json:"manualRestartWorkloads,omitempty"`
+}
+
+// DataPlaneStatus defines the number of Pods running in each data plane mode.
+type DataPlaneStatus struct {
+	// Number of Pods running with an Istio sidecar proxy.
+	SidecarWorkloads int `json:"sidecarWorkloads"`
+	// Number of Pods that are part of the ambient mesh.
+	AmbientWorkloads int `json:"ambientWorkloads"`
+}
+
+type ComponentHealth string
+
+// ComponentStatus defines the health of an Istio workload managed by the module, for example istiod or the ingress gateway.
+type ComponentStatus struct {
+	// Name of the Deployment or DaemonSet of the component.
+	Name string `json:"name"`
+	// Kind of the workload of the component, either Deployment or DaemonSet.
+	Kind string `json:"kind"`
+	// Number of replicas that the component should run. For a DaemonSet, the number of nodes that should run the component.
+	DesiredReplicas int32 `json:"desiredReplicas"`
+	// Number of ready replicas of the component.
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// Istio version of the component image.
+	Version string `json:"version,omitempty"`
+	// Health of the component. Value can be one of ("Healthy", "Degraded", "Unavailable").
+	// +kubebuilder:validation:Enum=Healthy;Degraded;Unavailable
+	Health ComponentHealth `json:"health"`
+}
+
+// ManualRestartWorkload defines a workload whose Istio sidecar proxies could not be restarted by the module.
+type ManualRestartWorkload struct {
+	// Name of the workload.
+	Name string `json:"name"`
+	// Namespace of the workload.
+	Namespace string `json:"namespace"`
+	// Kind of the workload, for example Deployment, Job, or Pod.
+	Kind string `json:"kind"`
+	// Reason why the sidecar proxies could not be restarted.
+	// Value can be one of ("OwnerNotFound", "OwnedByJob", "OwnedByCronJob", "NotReadyReplicaSetExists", "RestartFailed").
+	Reason string `json:"reason"`
+	// Details why the sidecar proxies could not be restarted.
+	Message string `json:"message,omitempty"`
+}
@@ -19,10 +19,12 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/kyma-project/istio/operator/internal/cacerts"
 	"github.com/kyma-project/istio/operator/internal/images"
 	"github.com/kyma-project/istio/operator/internal/resources"
 
@@ -30,6 +32,7 @@ import (
 	"github.com/kyma-project/istio/operator/internal/restarter/predicates"
 	"github.com/kyma-project/istio/operator/internal/validation"
 
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
 	"github.com/kyma-project/istio/operator/pkg/lib/sidecars"
 	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/pods"
 	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/restart"
@@ -62,18 +65,23 @@ const (
 	namespace                        = "kyma-system"
 	reconciliationRequeueTimeError   = 1 * time.Minute
 	reconciliationRequeueTimeWarning = 1 * time.Hour
+	// reconciliationRequeueTimeCanaryUpgrade is the time between two steps of a canary upgrade.
+	reconciliationRequeueTimeCanaryUpgrade = 1 * time.Minute
 )
 
 func NewController(mgr manager.Manager, reconciliationInterval time.Duration) *IstioReconciler {
 	merger := istiooperator.NewDefaultIstioMerger()
 
-	statusHandler := status.NewStatusHandler(mgr.GetClient())
+	statusHandler := status.NewEventRecordingStatusHandler(mgr.GetClient(), mgr.GetEventRecorderFor("istio-controller"))
 	logger := mgr.GetLogger()
 	podsLister := pods.NewPods(mgr.GetClient(), &logger)
 	actionRestarter := restart.NewActionRestarter(mgr.GetClient(), &logger)
+	proxyRestarter := sidecars.NewProxyRestarter(mgr.GetClient(), podsLister, actionRestarter, &logger)
 	restarters := []restarter.Restarter{
 		restarter.NewIngressGatewayRestarter(mgr.GetClient(), []predicates.IngressGatewayPredicate{}, statusHandler),
-		restarter.NewSidecarsRestarter(mgr.GetLogger(), mgr.GetClient(), &merger, sidecars.NewProxyRestarter(mgr.GetClient(), podsLister, actionRestarter, &logger), statusHandler),
+		restarter.NewSidecarsRestarter(mgr.GetLogger(), mgr.GetClient(), &merger, proxyRestarter, statusHandler),
+		restarter.NewRootCARotationRestarter(mgr.GetClient(), podsLister, proxyRestarter, statusHandler),
+		restarter.NewCASwitchRestarter(mgr.GetClient(), podsLister, proxyRestarter, statusHandler),
 	}
 	userResources := resources.NewUserResources(mgr.GetClient())
 
@@ -106,6 +114,10 @@ func (r *IstioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 	r.statusHandler.SetCondition(&istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonReconcileUnknown))
 
+	if err := cacerts.RecordExpiry(ctx, r.Client); err != nil {
+		r.log.Error(err, "Could not record the expiry of the CA certificates")
+	}
+
 	istioImages, imgErr := images.GetImages()
 	if imgErr != nil {
 		return r.terminateReconciliation(ctx, &istioCR, describederrors.NewDescribedError(imgErr, "Unable to get Istio images environments"),
@@ -117,15 +129,15 @@ func (r *IstioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 			operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonReconcileFailed))
 	}
 
-	err := validation.ValidateAuthorizers(istioCR)
-	if err != nil {
-		return r.terminateReconciliation(ctx, &istioCR, err, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonValidationFailed))
+	for _, validate := range validation.SpecValidations {
+		if err := validate(istioCR); err != nil {
+			return r.terminateReconciliation(ctx, &istioCR, err, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonValidationFailed))
+		}
 	}
 
-	if istioCR.GetNamespace() != namespace {
-		errWrongNS := fmt.Errorf("istio CR is not in %s namespace", namespace)
-		return r.terminateReconciliation(ctx, &istioCR, describederrors.NewDescribedError(errWrongNS, "Stopped Istio CR reconciliation"),
-			operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonReconcileFailed))
+	err := validation.ValidateNamespace(istioCR)
+	if err != nil {
+		return r.terminateReconciliation(ctx, &istioCR, err, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonReconcileFailed))
 	}
 
 	existingIstioCRs := &operatorv1alpha2.IstioList{}
@@ -148,8 +160,7 @@ func (r *IstioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		}
 
 		if istioCR.GetUID() != oldestCr.GetUID() {
-			errNotOldestCR := fmt.Errorf("only Istio CR %s in %s reconciles the module", oldestCr.GetName(), oldestCr.GetNamespace())
-			return r.terminateReconciliation(ctx, &istioCR, describederrors.NewDescribedError(errNotOldestCR, "Stopped Istio CR reconciliation").SetWarning(),
+			return r.terminateReconciliation(ctx, &istioCR, validation.NewOlderCRExistsError(*oldestCr),
 				operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonOlderCRExists))
 		}
 	}
@@ -212,7 +223,7 @@ func (r *IstioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return r.requeueReconciliationRestartNotFinished(ctx, &istioCR, reconciliationRequeueTime)
 	}
 
-	userResErr := r.userResources.DetectUserCreatedEfOnIngress(ctx)
+	userResErr := r.userResources.DetectUserCreatedEfOnIngress(ctx, istioCR)
 	if userResErr != nil {
 		if userResErr.Level() != describederrors.Warning {
 			return r.requeueReconciliation(
@@ -259,12 +270,25 @@ func (r *IstioReconciler) requeueReconciliationRestartNotFinished(ctx context.Co
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// requeueReconciliationCanaryUpgradeNotFinished keeps the Istio CR in the Processing state and requeues the request to run the next
+// step of the canary upgrade.
+func (r *IstioReconciler) requeueReconciliationCanaryUpgradeNotFinished(ctx context.Context, istioCR *operatorv1alpha2.Istio) (ctrl.Result, error) {
+	statusUpdateErr := r.statusHandler.UpdateToProcessing(ctx, istioCR)
+	if statusUpdateErr != nil {
+		r.log.Error(statusUpdateErr, "Error during updating status to processing")
+	}
+	r.log.Info("Reconcile requeued to continue the canary upgrade")
+	return ctrl.Result{RequeueAfter: reconciliationRequeueTimeCanaryUpgrade}, nil
+}
+
 // terminateReconciliation stops the reconciliation and does not requeue the request.
 func (r *IstioReconciler) terminateReconciliation(ctx context.Context, istioCR *operatorv1alpha2.Istio,
 	err describederrors.DescribedError, reason operatorv1alpha2.ReasonWithMessage) (ctrl.Result, error) {
 	if err.ShouldSetCondition() {
 		r.setConditionForError(istioCR, reason)
 	}
+	// The reconciliation is not retried, so the Event is the only trace of the failure besides the status of the Istio CR.
+	r.statusHandler.RecordEvent(istioCR, corev1.EventTypeWarning, reason.Reason, err.Description())
 	statusUpdateErr := r.statusHandler.UpdateToError(ctx, istioCR, err)
 	if statusUpdateErr != nil {
 		r.log.Error(statusUpdateErr, "Error during updating status to error")
@@ -284,10 +308,58 @@ func (r *IstioReconciler) finishReconcile(ctx context.Context, istioCR *operator
 			reconciliationRequeueTimeError)
 	}
 
+	r.setDataPlaneStatus(ctx, istioCR)
+	unhealthyComponents := r.setComponentStatus(ctx, istioCR)
+
 	r.statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonReconcileSucceeded))
 	r.statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIngressTargetingUserResourceNotFound))
-	if err := r.validate(istioCR); err != nil {
-		return ctrl.Result{}, r.statusHandler.UpdateToError(ctx, istioCR, err)
+
+	// All checks are run, so that the status reports the most severe finding and each check sets its condition.
+	var errs []describederrors.DescribedError
+	requeueAfter := r.reconciliationInterval
+	if istioCR.Spec.Config.IsOutboundTrafficRegistryOnly() {
+		if err := r.userResources.DetectMissingServiceEntries(ctx); err != nil {
+			reason := operatorv1alpha2.ConditionReasonServiceEntriesNotFound
+			if err.Level() != describederrors.Warning {
+				reason = operatorv1alpha2.ConditionReasonServiceEntriesDetectionFailed
+				requeueAfter = reconciliationRequeueTimeError
+			}
+			r.statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(reason))
+			r.log.Info("Outbound traffic to external hosts requires attention", "reason", err.Error())
+			errs = append(errs, err)
+		} else {
+			r.statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonServiceEntriesFound))
+		}
+	} else {
+		r.statusHandler.RemoveCondition(istioCR, operatorv1alpha2.ConditionTypeOutboundTrafficBlocked)
+	}
+
+	if len(unhealthyComponents) > 0 {
+		err := describederrors.NewDescribedError(fmt.Errorf("not healthy: %s", strings.Join(unhealthyComponents, ", ")), "Istio components are not healthy").SetWarning()
+		r.statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonComponentsNotHealthy))
+		r.log.Info("Istio components are not healthy", "components", unhealthyComponents)
+		errs = append(errs, err)
+		requeueAfter = reconciliationRequeueTimeError
+	}
+
+	if err := cacerts.CheckExpiry(ctx, r.Client, istioCR, r.statusHandler, time.Now()); err != nil {
+		r.log.Info("CA certificates of the mesh require attention", "reason", err.Error())
+		errs = append(errs, err)
+	}
+
+	if err := validation.ValidateAccessLog(*istioCR); err != nil {
+		r.log.Info("Access log configuration is not fully applied", "reason", err.Error())
+		errs = append(errs, err)
+	}
+
+	// The canary upgrade is progressed one step per reconciliation, so we requeue until the previous revision is removed. The findings
+	// of the checks are still reported in the conditions.
+	if istioCR.IsCanaryUpgradeInProgress() {
+		return r.requeueReconciliationCanaryUpgradeNotFinished(ctx, istioCR)
+	}
+
+	if err := describederrors.GetMostSevereErr(errs); err != nil {
+		return ctrl.Result{RequeueAfter: requeueAfter}, r.statusHandler.UpdateToError(ctx, istioCR, err, requeueAfter)
 	}
 
 	if err := r.statusHandler.UpdateToReady(ctx, istioCR); err != nil {
@@ -298,6 +370,37 @@ func (r *IstioReconciler) finishReconcile(ctx context.Context, istioCR *operator
 	return ctrl.Result{RequeueAfter: r.reconciliationInterval}, nil
 }
 
+// setDataPlaneStatus updates the number of workloads running in each data plane mode. The status is informational, so a failure
+// to count the workloads does not fail the reconciliation and the previous numbers are kept.
+func (r *IstioReconciler) setDataPlaneStatus(ctx context.Context, istioCR *operatorv1alpha2.Istio) {
+	dataPlaneStatus, err := gatherer.CountDataPlaneWorkloads(ctx, r.Client)
+	if err != nil {
+		r.log.Error(err, "Could not count data plane workloads")
+		return
+	}
+	istioCR.Status.DataPlane = dataPlaneStatus
+}
+
+// setComponentStatus updates the health of the Istio components and returns the components that are not healthy. If the health
+// cannot be gathered, the previous statuses are kept and no component is reported as not healthy.
+func (r *IstioReconciler) setComponentStatus(ctx context.Context, istioCR *operatorv1alpha2.Istio) []string {
+	componentStatuses, err := gatherer.GetComponentStatuses(ctx, r.Client)
+	if err != nil {
+		r.log.Error(err, "Could not gather the health of Istio components")
+		return nil
+	}
+	istioCR.Status.Components = componentStatuses
+
+	var unhealthyComponents []string
+	for _, component := range componentStatuses {
+		if !component.IsHealthy() {
+			unhealthyComponents = append(unhealthyComponents, fmt.Sprintf("%s %s (%d/%d ready)", component.Kind, component.Name,
+				component.ReadyReplicas, component.DesiredReplicas))
+		}
+	}
+	return unhealthyComponents
+}
+
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=create;get;patch;update
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=operator.kyma-project.io,resources=istios,verbs=create;delete;get;list;patch;update;watch
@@ -314,6 +417,7 @@ func (r *IstioReconciler) finishReconcile(ctx context.Context, istioCR *operator
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions.apiextensions.k8s.io;customresourcedefinitions,verbs=create;deletecollection;delete;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=apps;extensions,resources=daemonsets;deployments;deployments/finalizers;replicasets;statefulsets,verbs=create;deletecollection;delete;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=k8s.cni.cncf.io,resources=networkattachmentdefinitions,verbs=create;deletecollection;delete;get;list;patch;update;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=create;deletecollection;delete;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;create;update
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=create;deletecollection;delete;get;list;patch;update;watch
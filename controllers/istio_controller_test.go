@@ -2,7 +2,14 @@ package controllers
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"os"
 	"time"
 
@@ -10,6 +17,7 @@ import (
 
 	"k8s.io/utils/ptr"
 
+	"github.com/kyma-project/istio/operator/internal/cacerts"
 	"github.com/kyma-project/istio/operator/internal/istiooperator"
 	"github.com/kyma-project/istio/operator/internal/restarter"
 
@@ -19,8 +27,12 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	_ "istio.io/api/networking/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -356,6 +368,191 @@ var _ = Describe("Istio Controller", func() {
 			Expect((*updatedIstioCR.Status.Conditions)[1].Status).To(Equal(metav1.ConditionFalse))
 		})
 
+		It("should set the number of workloads in each data plane mode when successfully reconciled", func() {
+			// given
+			istioCR := &operatorv1alpha2.Istio{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      istioCrName,
+					Namespace: testNamespace,
+					Finalizers: []string{
+						"istios.operator.kyma-project.io/istio-installation",
+					},
+				},
+				Spec: operatorv1alpha2.IstioSpec{
+					DataPlaneMode: operatorv1alpha2.DataPlaneModeAmbient,
+				},
+			}
+			ambientNamespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "ambient",
+					Labels: map[string]string{"istio.io/dataplane-mode": "ambient"},
+				},
+			}
+			sidecarPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "sidecar-pod",
+					Namespace:   "default",
+					Annotations: map[string]string{"sidecar.istio.io/status": "{}"},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}
+			ambientPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ambient-pod",
+					Namespace: "ambient",
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			}
+
+			fakeClient := createFakeClient(istioCR, ambientNamespace, sidecarPod, ambientPod)
+
+			sut := &IstioReconciler{
+				Client:                 fakeClient,
+				Scheme:                 getTestScheme(),
+				istioInstallation:      &istioInstallationReconciliationMock{},
+				restarters:             []restarter.Restarter{&restarterMock{}},
+				istioResources:         &istioResourcesReconciliationMock{},
+				userResources:          &UserResourcesMock{},
+				log:                    logr.Discard(),
+				statusHandler:          status.NewStatusHandler(fakeClient),
+				reconciliationInterval: testReconciliationInterval,
+			}
+
+			// when
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: istioCrName}})
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+
+			updatedIstioCR := operatorv1alpha2.Istio{}
+			err = fakeClient.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &updatedIstioCR)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(updatedIstioCR.Status.State).Should(Equal(operatorv1alpha2.Ready))
+			Expect(updatedIstioCR.Status.DataPlane).ToNot(BeNil())
+			Expect(updatedIstioCR.Status.DataPlane.SidecarWorkloads).To(Equal(1))
+			Expect(updatedIstioCR.Status.DataPlane.AmbientWorkloads).To(Equal(1))
+		})
+
+		It("should set the health of the Istio components and set Warning state when a component is not healthy", func() {
+			// given
+			istioCR := &operatorv1alpha2.Istio{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      istioCrName,
+					Namespace: testNamespace,
+					Finalizers: []string{
+						"istios.operator.kyma-project.io/istio-installation",
+					},
+				},
+			}
+			istiod := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "istiod",
+					Namespace: "istio-system",
+					Labels:    map[string]string{"operator.istio.io/component": "Pilot"},
+				},
+				Spec:   appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status: appsv1.DeploymentStatus{ReadyReplicas: 1},
+			}
+
+			fakeClient := createFakeClient(istioCR, istiod)
+
+			sut := &IstioReconciler{
+				Client:                 fakeClient,
+				Scheme:                 getTestScheme(),
+				istioInstallation:      &istioInstallationReconciliationMock{},
+				restarters:             []restarter.Restarter{&restarterMock{}},
+				istioResources:         &istioResourcesReconciliationMock{},
+				userResources:          &UserResourcesMock{},
+				log:                    logr.Discard(),
+				statusHandler:          status.NewStatusHandler(fakeClient),
+				reconciliationInterval: testReconciliationInterval,
+			}
+
+			// when
+			result, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: istioCrName}})
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(reconciliationRequeueTimeError))
+
+			updatedIstioCR := operatorv1alpha2.Istio{}
+			err = fakeClient.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &updatedIstioCR)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(updatedIstioCR.Status.State).Should(Equal(operatorv1alpha2.Warning))
+			Expect(updatedIstioCR.Status.Description).To(ContainSubstring("Istio components are not healthy: not healthy: Deployment istiod (1/2 ready)"))
+			Expect(updatedIstioCR.Status.Components).To(ConsistOf(operatorv1alpha2.ComponentStatus{
+				Name:            "istiod",
+				Kind:            "Deployment",
+				DesiredReplicas: 2,
+				ReadyReplicas:   1,
+				Health:          operatorv1alpha2.ComponentDegraded,
+			}))
+			readyCondition := meta.FindStatusCondition(*updatedIstioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeReady))
+			Expect(readyCondition).ToNot(BeNil())
+			Expect(readyCondition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonComponentsNotHealthy)))
+			Expect(readyCondition.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("should report the most severe finding when components are not healthy and a CA certificate is about to expire", func() {
+			// given
+			istioCR := &operatorv1alpha2.Istio{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      istioCrName,
+					Namespace: testNamespace,
+					Finalizers: []string{
+						"istios.operator.kyma-project.io/istio-installation",
+					},
+				},
+			}
+			istiod := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "istiod",
+					Namespace: "istio-system",
+					Labels:    map[string]string{"operator.istio.io/component": "Pilot"},
+				},
+				Spec:   appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+				Status: appsv1.DeploymentStatus{ReadyReplicas: 1},
+			}
+			caSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: cacerts.SelfSignedSecretName, Namespace: "istio-system"},
+				Data:       map[string][]byte{cacerts.CACertKey: createRootCertificate(time.Now().Add(time.Hour))},
+			}
+
+			fakeClient := createFakeClient(istioCR, istiod, caSecret)
+
+			sut := &IstioReconciler{
+				Client:                 fakeClient,
+				Scheme:                 getTestScheme(),
+				istioInstallation:      &istioInstallationReconciliationMock{},
+				restarters:             []restarter.Restarter{&restarterMock{}},
+				istioResources:         &istioResourcesReconciliationMock{},
+				userResources:          &UserResourcesMock{},
+				log:                    logr.Discard(),
+				statusHandler:          status.NewStatusHandler(fakeClient),
+				reconciliationInterval: testReconciliationInterval,
+			}
+
+			// when
+			result, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: istioCrName}})
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(reconciliationRequeueTimeError))
+
+			updatedIstioCR := operatorv1alpha2.Istio{}
+			err = fakeClient.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &updatedIstioCR)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(updatedIstioCR.Status.State).Should(Equal(operatorv1alpha2.Error))
+			Expect(updatedIstioCR.Status.Description).To(ContainSubstring("CA certificate of the mesh is about to expire"))
+			readyCondition := meta.FindStatusCondition(*updatedIstioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeReady))
+			Expect(readyCondition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonComponentsNotHealthy)))
+			expiryCondition := meta.FindStatusCondition(*updatedIstioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeCACertificatesExpiring))
+			Expect(expiryCondition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCACertificatesExpiryCritical)))
+		})
+
 		It("should return an error when update status to ready failed", func() {
 			// given
 			istioCR := &operatorv1alpha2.Istio{
@@ -655,7 +852,7 @@ var _ = Describe("Istio Controller", func() {
 			Expect((*updatedIstioCR.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
 		})
 
-		It("should set a warning if authorizer name is not unique", func() {
+		It("should set a warning and emit an Event if authorizer name is not unique", func() {
 			// given
 			istioCR := &operatorv1alpha2.Istio{
 				ObjectMeta: metav1.ObjectMeta{
@@ -684,6 +881,7 @@ var _ = Describe("Istio Controller", func() {
 			}
 
 			fakeClient := createFakeClient(istioCR)
+			recorder := record.NewFakeRecorder(1)
 			sut := &IstioReconciler{
 				Client:                 fakeClient,
 				Scheme:                 getTestScheme(),
@@ -692,7 +890,7 @@ var _ = Describe("Istio Controller", func() {
 				istioResources:         &istioResourcesReconciliationMock{},
 				userResources:          &UserResourcesMock{},
 				log:                    logr.Discard(),
-				statusHandler:          status.NewStatusHandler(fakeClient),
+				statusHandler:          status.NewEventRecordingStatusHandler(fakeClient, recorder),
 				reconciliationInterval: testReconciliationInterval,
 			}
 
@@ -715,6 +913,56 @@ var _ = Describe("Istio Controller", func() {
 			Expect((*updatedIstioCR.Status.Conditions)[0].Type).To(Equal(string(operatorv1alpha2.ConditionTypeReady)))
 			Expect((*updatedIstioCR.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonValidationFailed)))
 			Expect((*updatedIstioCR.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
+			Expect(recorder.Events).To(Receive(Equal("Warning ValidationFailed Authorizer name needs to be unique: test-authorizer is duplicated")))
+		})
+
+		It("should set a warning and requeue if access log labels are empty", func() {
+			// given
+			istioCR := &operatorv1alpha2.Istio{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      istioCrName,
+					Namespace: testNamespace,
+					Finalizers: []string{
+						"istios.operator.kyma-project.io/istio-installation",
+					},
+				},
+				Spec: operatorv1alpha2.IstioSpec{Config: operatorv1alpha2.Config{
+					AccessLog: &operatorv1alpha2.AccessLog{
+						Strategy: operatorv1alpha2.AccessLogStrategyMerge,
+					},
+				}},
+			}
+
+			fakeClient := createFakeClient(istioCR)
+			sut := &IstioReconciler{
+				Client:                 fakeClient,
+				Scheme:                 getTestScheme(),
+				istioInstallation:      &istioInstallationReconciliationMock{},
+				restarters:             []restarter.Restarter{&restarterMock{}},
+				istioResources:         &istioResourcesReconciliationMock{},
+				userResources:          &UserResourcesMock{},
+				log:                    logr.Discard(),
+				statusHandler:          status.NewStatusHandler(fakeClient),
+				reconciliationInterval: testReconciliationInterval,
+			}
+
+			// when
+			result, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: istioCrName}})
+
+			// then
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(result).Should(Equal(reconcile.Result{RequeueAfter: testReconciliationInterval}))
+
+			updatedIstioCR := operatorv1alpha2.Istio{}
+			err = fakeClient.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &updatedIstioCR)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(updatedIstioCR.Status.State).Should(Equal(operatorv1alpha2.Warning))
+			Expect(updatedIstioCR.Status.Description).To(ContainSubstring("Default access log format is applied: accessLog does not define any labels"))
+
+			Expect(updatedIstioCR.Status.Conditions).ToNot(BeNil())
+			Expect((*updatedIstioCR.Status.Conditions)[0].Type).To(Equal(string(operatorv1alpha2.ConditionTypeReady)))
+			Expect((*updatedIstioCR.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonReconcileSucceeded)))
 		})
 
 		It("should set a warning on IstioCR if in the cluster there is an EnvoyFilter that is not created by the Kyma module, and targets Istio Ingress Gateway", func() {
@@ -774,6 +1022,115 @@ var _ = Describe("Istio Controller", func() {
 
 		})
 
+		It("should set a warning on IstioCR if outbound traffic policy is REGISTRY_ONLY and no ServiceEntry exists", func() {
+			// given
+			istioCR := &operatorv1alpha2.Istio{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              istioCrName,
+					Namespace:         testNamespace,
+					UID:               "1",
+					CreationTimestamp: metav1.Unix(1494505756, 0),
+					Finalizers: []string{
+						"istios.operator.kyma-project.io/istio-installation",
+					},
+				},
+				Spec: operatorv1alpha2.IstioSpec{Config: operatorv1alpha2.Config{
+					OutboundTrafficPolicy: ptr.To(operatorv1alpha2.OutboundTrafficPolicyRegistryOnly),
+				}},
+			}
+
+			fakeClient := createFakeClient(istioCR)
+
+			sut := &IstioReconciler{
+				Client:            fakeClient,
+				Scheme:            getTestScheme(),
+				istioInstallation: &istioInstallationReconciliationMock{},
+				restarters:        []restarter.Restarter{&restarterMock{}},
+				istioResources:    &istioResourcesReconciliationMock{},
+				userResources: &UserResourcesMock{
+					serviceEntriesErr: describederrors.NewDescribedError(errors.New("no ServiceEntry found"), "traffic to all external hosts is blocked").SetWarning(),
+				},
+				log:                    logr.Discard(),
+				statusHandler:          status.NewStatusHandler(fakeClient),
+				reconciliationInterval: testReconciliationInterval,
+			}
+
+			// when
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: istioCrName}})
+
+			// then
+			Expect(err).To(Not(HaveOccurred()))
+
+			updatedIstioCR := operatorv1alpha2.Istio{}
+			err = fakeClient.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &updatedIstioCR)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(updatedIstioCR.Status.State).Should(Equal(operatorv1alpha2.Warning))
+			Expect(updatedIstioCR.Status.Description).To(ContainSubstring("traffic to all external hosts is blocked"))
+			Expect(updatedIstioCR.Annotations).To(HaveKey("operator.kyma-project.io/lastAppliedConfiguration"))
+			Expect(updatedIstioCR.Status.Conditions).ToNot(BeNil())
+			condition := meta.FindStatusCondition(*updatedIstioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeOutboundTrafficBlocked))
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonServiceEntriesNotFound)))
+		})
+
+		It("should remove the outbound traffic condition when outbound traffic policy is not REGISTRY_ONLY", func() {
+			// given
+			istioCR := &operatorv1alpha2.Istio{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              istioCrName,
+					Namespace:         testNamespace,
+					UID:               "1",
+					CreationTimestamp: metav1.Unix(1494505756, 0),
+					Finalizers: []string{
+						"istios.operator.kyma-project.io/istio-installation",
+					},
+				},
+				Spec: operatorv1alpha2.IstioSpec{Config: operatorv1alpha2.Config{
+					OutboundTrafficPolicy: ptr.To(operatorv1alpha2.OutboundTrafficPolicyAllowAny),
+				}},
+				Status: operatorv1alpha2.IstioStatus{
+					Conditions: &[]metav1.Condition{
+						{
+							Type:   string(operatorv1alpha2.ConditionTypeOutboundTrafficBlocked),
+							Status: metav1.ConditionTrue,
+							Reason: string(operatorv1alpha2.ConditionReasonServiceEntriesNotFound),
+						},
+					},
+				},
+			}
+
+			fakeClient := createFakeClient(istioCR)
+
+			sut := &IstioReconciler{
+				Client:                 fakeClient,
+				Scheme:                 getTestScheme(),
+				istioInstallation:      &istioInstallationReconciliationMock{},
+				restarters:             []restarter.Restarter{&restarterMock{}},
+				istioResources:         &istioResourcesReconciliationMock{},
+				userResources:          &UserResourcesMock{},
+				log:                    logr.Discard(),
+				statusHandler:          status.NewStatusHandler(fakeClient),
+				reconciliationInterval: testReconciliationInterval,
+			}
+
+			// when
+			_, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: istioCrName}})
+
+			// then
+			Expect(err).To(Not(HaveOccurred()))
+
+			updatedIstioCR := operatorv1alpha2.Istio{}
+			err = fakeClient.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &updatedIstioCR)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Expect(updatedIstioCR.Status.State).Should(Equal(operatorv1alpha2.Ready))
+			for _, condition := range *updatedIstioCR.Status.Conditions {
+				Expect(condition.Type).ToNot(Equal(string(operatorv1alpha2.ConditionTypeOutboundTrafficBlocked)))
+			}
+		})
+
 		It("should update lastTransitionTime of Ready condition when reason changed", func() {
 			// given
 			istioCR := &operatorv1alpha2.Istio{
@@ -1108,6 +1465,54 @@ var _ = Describe("Istio Controller", func() {
 				Expect((*updatedIstioCR.Status.Conditions)[0].Message).To(Equal(operatorv1alpha2.ConditionReasonReconcileRequeuedMessage))
 				Expect((*updatedIstioCR.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
 			})
+
+			It("should requeue reconcile request when a canary upgrade is in progress", func() {
+				//given
+				istioCR := &operatorv1alpha2.Istio{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              istioCrName,
+						Namespace:         testNamespace,
+						UID:               "1",
+						CreationTimestamp: metav1.Unix(1494505756, 0),
+						Finalizers: []string{
+							"istios.operator.kyma-project.io/istio-installation",
+						},
+					},
+					Spec: operatorv1alpha2.IstioSpec{UpgradeStrategy: operatorv1alpha2.UpgradeStrategyCanary},
+				}
+
+				fakeClient := createFakeClient(istioCR)
+				canaryCondition := operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCanaryWaitingForProxies)
+				sut := &IstioReconciler{
+					Client:                 fakeClient,
+					Scheme:                 getTestScheme(),
+					istioInstallation:      &istioInstallationReconciliationMock{condition: &canaryCondition},
+					istioResources:         &istioResourcesReconciliationMock{},
+					userResources:          &UserResourcesMock{},
+					restarters:             []restarter.Restarter{&restarterMock{}},
+					log:                    logr.Discard(),
+					statusHandler:          status.NewStatusHandler(fakeClient),
+					reconciliationInterval: testReconciliationInterval,
+				}
+
+				//when
+				reconcileResult, err := sut.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: istioCrName}})
+
+				//then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reconcileResult.RequeueAfter).To(Equal(time.Minute * 1))
+
+				updatedIstioCR := operatorv1alpha2.Istio{}
+				err = fakeClient.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &updatedIstioCR)
+				Expect(err).To(Not(HaveOccurred()))
+
+				Expect(updatedIstioCR.Status.State).To(Equal(operatorv1alpha2.Processing))
+				Expect(updatedIstioCR.Status.Conditions).ToNot(BeNil())
+				canaryUpgrade := meta.FindStatusCondition(*updatedIstioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeCanaryUpgrade))
+				Expect(canaryUpgrade).ToNot(BeNil())
+				Expect(canaryUpgrade.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryWaitingForProxies)))
+				Expect(updatedIstioCR.Annotations).To(HaveKey("operator.kyma-project.io/lastAppliedConfiguration"))
+			})
 		})
 	})
 })
@@ -1152,14 +1557,18 @@ func (p *shouldFailClient) List(ctx context.Context, list client.ObjectList, _ .
 }
 
 type istioInstallationReconciliationMock struct {
-	err describederrors.DescribedError
+	err       describederrors.DescribedError
+	condition *operatorv1alpha2.ReasonWithMessage
 }
 
-func (i *istioInstallationReconciliationMock) Reconcile(_ context.Context, _ *operatorv1alpha2.Istio, _ status.Status, _ string) (istiooperator.IstioImageVersion, describederrors.DescribedError) {
+func (i *istioInstallationReconciliationMock) Reconcile(_ context.Context, istioCR *operatorv1alpha2.Istio, statusHandler status.Status, _ string) (istiooperator.IstioImageVersion, describederrors.DescribedError) {
 	version, err := istiooperator.NewIstioImageVersionFromTag("1.16.0-distroless")
 	if err != nil {
 		i.err = describederrors.NewDescribedError(err, "error creating IstioImageVersion")
 	}
+	if i.condition != nil {
+		statusHandler.SetCondition(istioCR, *i.condition)
+	}
 	return version, i.err
 }
 
@@ -1207,14 +1616,43 @@ func (s *StatusMock) SetCondition(_ *operatorv1alpha2.Istio, reason operatorv1al
 	s.reasons = append(s.reasons, reason)
 }
 
+func (s *StatusMock) RemoveCondition(_ *operatorv1alpha2.Istio, _ operatorv1alpha2.ConditionType) {
+}
+
+func (s *StatusMock) RecordEvent(_ *operatorv1alpha2.Istio, _ string, _ operatorv1alpha2.ConditionReason, _ string) {
+}
+
 func (s *StatusMock) GetConditions() []operatorv1alpha2.ReasonWithMessage {
 	return s.reasons
 }
 
 type UserResourcesMock struct {
-	err describederrors.DescribedError
+	err               describederrors.DescribedError
+	serviceEntriesErr describederrors.DescribedError
 }
 
-func (urm UserResourcesMock) DetectUserCreatedEfOnIngress(ctx context.Context) describederrors.DescribedError {
+func (urm UserResourcesMock) DetectUserCreatedEfOnIngress(ctx context.Context, _ operatorv1alpha2.Istio) describederrors.DescribedError {
 	return urm.err
 }
+
+func (urm UserResourcesMock) DetectMissingServiceEntries(ctx context.Context) describederrors.DescribedError {
+	return urm.serviceEntriesErr
+}
+
+// createRootCertificate returns a PEM encoded self-signed root CA certificate that expires at notAfter.
+func createRootCertificate(notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	root := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, root, root, &key.PublicKey, key)
+	Expect(err).ShouldNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
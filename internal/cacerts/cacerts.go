@@ -0,0 +1,108 @@
+package cacerts
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// SecretName is the name of the Secret in the istio-system namespace that istiod uses as plug-in CA.
+	SecretName = "cacerts"
+
+	CACertKey    = "ca-cert.pem"
+	CAKeyKey     = "ca-key.pem"
+	RootCertKey  = "root-cert.pem"
+	CertChainKey = "cert-chain.pem"
+)
+
+//nolint:gochecknoglobals // list of the keys istiod requires in the cacerts Secret
+var requiredKeys = []string{CACertKey, CAKeyKey, RootCertKey, CertChainKey}
+
+// Validate checks that the plug-in CA certificates can be used by istiod. The CA certificate must be a CA, match the CA key, be the first
+// certificate of the certificate chain and chain up to the root certificate.
+func Validate(data map[string][]byte, now time.Time) error {
+	for _, key := range requiredKeys {
+		if len(data[key]) == 0 {
+			return fmt.Errorf("%s is missing", key)
+		}
+	}
+
+	caCerts, err := ParseCertificates(data[CACertKey])
+	if err != nil {
+		return fmt.Errorf("%s: %w", CACertKey, err)
+	}
+	caCert := caCerts[0]
+	if !caCert.IsCA {
+		return fmt.Errorf("%s is not a CA certificate", CACertKey)
+	}
+	if caCert.KeyUsage != 0 && caCert.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return fmt.Errorf("%s is not allowed to sign certificates", CACertKey)
+	}
+
+	if _, err := tls.X509KeyPair(data[CACertKey], data[CAKeyKey]); err != nil {
+		return fmt.Errorf("%s does not match %s: %w", CAKeyKey, CACertKey, err)
+	}
+
+	rootCerts, err := ParseCertificates(data[RootCertKey])
+	if err != nil {
+		return fmt.Errorf("%s: %w", RootCertKey, err)
+	}
+	roots := x509.NewCertPool()
+	for _, rootCert := range rootCerts {
+		roots.AddCert(rootCert)
+	}
+
+	chain, err := ParseCertificates(data[CertChainKey])
+	if err != nil {
+		return fmt.Errorf("%s: %w", CertChainKey, err)
+	}
+	if !bytes.Equal(chain[0].Raw, caCert.Raw) {
+		return fmt.Errorf("%s does not start with %s", CertChainKey, CACertKey)
+	}
+	intermediates := x509.NewCertPool()
+	for _, intermediate := range chain[1:] {
+		intermediates.AddCert(intermediate)
+	}
+
+	_, err = caCert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("%s does not chain up to %s: %w", CACertKey, RootCertKey, err)
+	}
+
+	return nil
+}
+
+// ParseCertificates parses all PEM encoded certificates. At least one certificate is required.
+func ParseCertificates(pemData []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
@@ -0,0 +1,21 @@
+package cacerts_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/istio/operator/internal/tests"
+	"github.com/onsi/ginkgo/v2/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCACerts(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "CA Certificates Suite")
+}
+
+var _ = ReportAfterSuite("custom reporter", func(report types.Report) {
+	tests.GenerateGinkgoJunitReport("ca-certificates-suite", report)
+})
@@ -0,0 +1,178 @@
+package cacerts_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+)
+
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCA(commonName string, isCA bool, notAfter time.Time, parent *testCA) testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+
+	signerCert, signerKey := template, key
+	if parent != nil {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signerCert, &key.PublicKey, signerKey)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return testCA{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func pluginCAData(intermediate, root testCA) map[string][]byte {
+	return map[string][]byte{
+		cacerts.CACertKey:    intermediate.certPEM,
+		cacerts.CAKeyKey:     intermediate.keyPEM,
+		cacerts.RootCertKey:  root.certPEM,
+		cacerts.CertChainKey: append(append([]byte{}, intermediate.certPEM...), root.certPEM...),
+	}
+}
+
+var _ = Describe("Validate", func() {
+	validUntil := time.Now().Add(24 * time.Hour)
+
+	It("should successfully validate an intermediate CA signed by the root CA", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		intermediate := newTestCA("intermediate", true, validUntil, &root)
+
+		// when
+		err := cacerts.Validate(pluginCAData(intermediate, root), time.Now())
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should fail when a key is missing", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		intermediate := newTestCA("intermediate", true, validUntil, &root)
+		data := pluginCAData(intermediate, root)
+		delete(data, cacerts.CertChainKey)
+
+		// when
+		err := cacerts.Validate(data, time.Now())
+
+		// then
+		Expect(err).To(MatchError("cert-chain.pem is missing"))
+	})
+
+	It("should fail when the CA key does not match the CA certificate", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		intermediate := newTestCA("intermediate", true, validUntil, &root)
+		data := pluginCAData(intermediate, root)
+		data[cacerts.CAKeyKey] = root.keyPEM
+
+		// when
+		err := cacerts.Validate(data, time.Now())
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ca-key.pem does not match ca-cert.pem"))
+	})
+
+	It("should fail when the CA certificate is not a CA", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		leaf := newTestCA("leaf", false, validUntil, &root)
+
+		// when
+		err := cacerts.Validate(pluginCAData(leaf, root), time.Now())
+
+		// then
+		Expect(err).To(MatchError("ca-cert.pem is not a CA certificate"))
+	})
+
+	It("should fail when the certificate chain does not start with the CA certificate", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		intermediate := newTestCA("intermediate", true, validUntil, &root)
+		data := pluginCAData(intermediate, root)
+		data[cacerts.CertChainKey] = root.certPEM
+
+		// when
+		err := cacerts.Validate(data, time.Now())
+
+		// then
+		Expect(err).To(MatchError("cert-chain.pem does not start with ca-cert.pem"))
+	})
+
+	It("should fail when the CA certificate is not signed by the root certificate", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		otherRoot := newTestCA("other-root", true, validUntil, nil)
+		intermediate := newTestCA("intermediate", true, validUntil, &otherRoot)
+
+		// when
+		err := cacerts.Validate(pluginCAData(intermediate, root), time.Now())
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ca-cert.pem does not chain up to root-cert.pem"))
+	})
+
+	It("should fail when the CA certificate is expired", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		intermediate := newTestCA("intermediate", true, time.Now().Add(-time.Minute), &root)
+
+		// when
+		err := cacerts.Validate(pluginCAData(intermediate, root), time.Now())
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ca-cert.pem does not chain up to root-cert.pem"))
+	})
+
+	It("should fail when the certificate is not PEM encoded", func() {
+		// given
+		root := newTestCA("root", true, validUntil, nil)
+		intermediate := newTestCA("intermediate", true, validUntil, &root)
+		data := pluginCAData(intermediate, root)
+		data[cacerts.RootCertKey] = []byte("not a certificate")
+
+		// when
+		err := cacerts.Validate(data, time.Now())
+
+		// then
+		Expect(err).To(MatchError("root-cert.pem: no PEM encoded certificate found"))
+	})
+})
@@ -0,0 +1,141 @@
+package cacerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/status"
+)
+
+const (
+	istioNamespace = "istio-system"
+	// SelfSignedSecretName is the name of the Secret in the istio-system namespace in which istiod stores its self-signed root CA.
+	SelfSignedSecretName = "istio-ca-secret"
+)
+
+type CertificateType string
+
+const (
+	CertificateTypeRoot         CertificateType = "root"
+	CertificateTypeIntermediate CertificateType = "intermediate"
+)
+
+// Certificate describes a root or intermediate CA certificate of the mesh.
+type Certificate struct {
+	Type     CertificateType
+	Subject  string
+	NotAfter time.Time
+}
+
+// GetMeshCertificates returns the root and intermediate CA certificates used by istiod. The plug-in CA in the cacerts Secret takes
+// precedence over the self-signed CA of istiod. If none of the Secrets exists, no certificates are returned.
+func GetMeshCertificates(ctx context.Context, k8sClient client.Client) ([]Certificate, error) {
+	secret := corev1.Secret{}
+	err := k8sClient.Get(ctx, types.NamespacedName{Namespace: istioNamespace, Name: SecretName}, &secret)
+	if client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	if err == nil {
+		return parseMeshCertificates(secret.Data[RootCertKey], secret.Data[CertChainKey])
+	}
+
+	err = k8sClient.Get(ctx, types.NamespacedName{Namespace: istioNamespace, Name: SelfSignedSecretName}, &secret)
+	if client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	if err == nil {
+		return parseMeshCertificates(secret.Data[CACertKey])
+	}
+
+	return nil, nil
+}
+
+func parseMeshCertificates(pemData ...[]byte) ([]Certificate, error) {
+	var parsed []*x509.Certificate
+	for _, data := range pemData {
+		certs, err := ParseCertificates(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			if !slices.ContainsFunc(parsed, func(c *x509.Certificate) bool { return bytes.Equal(c.Raw, cert.Raw) }) {
+				parsed = append(parsed, cert)
+			}
+		}
+	}
+
+	certificates := make([]Certificate, 0, len(parsed))
+	for _, cert := range parsed {
+		certType := CertificateTypeIntermediate
+		if isSelfSigned(cert) {
+			certType = CertificateTypeRoot
+		}
+		certificates = append(certificates, Certificate{Type: certType, Subject: cert.Subject.String(), NotAfter: cert.NotAfter})
+	}
+	return certificates, nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawSubject, cert.RawIssuer) && cert.CheckSignatureFrom(cert) == nil
+}
+
+// RecordExpiry records the expiry of the CA certificates of the mesh in the metrics. It is called at the start of each reconciliation,
+// so the metrics are also updated when the reconciliation ends before CheckExpiry.
+func RecordExpiry(ctx context.Context, k8sClient client.Client) error {
+	certificates, err := GetMeshCertificates(ctx, k8sClient)
+	if err != nil {
+		return err
+	}
+	recordExpiry(certificates)
+	return nil
+}
+
+// CheckExpiry records the expiry of the CA certificates of the mesh in the metrics and sets the CACertificatesExpiring condition.
+// A warning is returned if a certificate expires within the warning threshold of the Istio CR and an error if it expires within
+// the error threshold.
+func CheckExpiry(ctx context.Context, k8sClient client.Client, istioCR *operatorv1alpha2.Istio, statusHandler status.Status, now time.Time) describederrors.DescribedError {
+	certificates, err := GetMeshCertificates(ctx, k8sClient)
+	if err != nil {
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCACertificatesCheckFailed))
+		return describederrors.NewDescribedError(err, "Could not check the expiry of the CA certificates").SetWarning().SetCondition(false)
+	}
+
+	recordExpiry(certificates)
+	if len(certificates) == 0 {
+		statusHandler.RemoveCondition(istioCR, operatorv1alpha2.ConditionTypeCACertificatesExpiring)
+		return nil
+	}
+
+	earliest := slices.MinFunc(certificates, func(a, b Certificate) int { return a.NotAfter.Compare(b.NotAfter) })
+	warningThreshold, errorThreshold := istioCR.Spec.Config.RootCAExpiryThresholds()
+	remaining := earliest.NotAfter.Sub(now)
+
+	verb := "expires"
+	if remaining <= 0 {
+		verb = "expired"
+	}
+	message := fmt.Sprintf("The %s CA certificate %q %s on %s", earliest.Type, earliest.Subject, verb, earliest.NotAfter.UTC().Format(time.RFC3339))
+
+	switch {
+	case remaining < errorThreshold:
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCACertificatesExpiryCritical, message))
+		return describederrors.NewDescribedError(errors.New(message), "CA certificate of the mesh is about to expire").SetCondition(false)
+	case remaining < warningThreshold:
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCACertificatesExpiringSoon, message))
+		return describederrors.NewDescribedError(errors.New(message), "CA certificate of the mesh expires soon").SetWarning().SetCondition(false)
+	}
+
+	statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCACertificatesValid))
+	return nil
+}
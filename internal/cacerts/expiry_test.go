@@ -0,0 +1,183 @@
+package cacerts_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/status"
+)
+
+const expiryMetricName = "istio_operator_ca_certificate_expiration_timestamp_seconds"
+
+var _ = Describe("CheckExpiry", func() {
+	now := time.Now()
+
+	pluginCASecret := func(intermediate, root testCA) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: cacerts.SecretName, Namespace: "istio-system"},
+			Data:       pluginCAData(intermediate, root),
+		}
+	}
+
+	selfSignedSecret := func(root testCA) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: cacerts.SelfSignedSecretName, Namespace: "istio-system"},
+			Data:       map[string][]byte{cacerts.CACertKey: root.certPEM, cacerts.CAKeyKey: root.keyPEM},
+		}
+	}
+
+	checkExpiry := func(istioCR *operatorv1alpha2.Istio, objects ...client.Object) describederrors.DescribedError {
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+		return cacerts.CheckExpiry(context.Background(), k8sClient, istioCR, status.NewStatusHandler(k8sClient), now)
+	}
+
+	expiryCondition := func(istioCR *operatorv1alpha2.Istio) *metav1.Condition {
+		Expect(istioCR.Status.Conditions).ToNot(BeNil())
+		return meta.FindStatusCondition(*istioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeCACertificatesExpiring))
+	}
+
+	It("should set the valid condition and record the expiry of the plug-in CA certificates", func() {
+		// given
+		registry := prometheus.NewRegistry()
+		Expect(cacerts.RegisterMetrics(registry)).To(Succeed())
+		root := newTestCA("root", true, now.Add(365*24*time.Hour), nil)
+		intermediate := newTestCA("intermediate", true, now.Add(180*24*time.Hour), &root)
+		istioCR := &operatorv1alpha2.Istio{}
+
+		// when
+		err := checkExpiry(istioCR, pluginCASecret(intermediate, root))
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCACertificatesValid)))
+		Expect(expiryCondition(istioCR).Status).To(Equal(metav1.ConditionFalse))
+		Expect(testutil.GatherAndCount(registry, expiryMetricName)).To(Equal(2))
+	})
+
+	It("should return a warning when the intermediate CA expires within the warning threshold", func() {
+		// given
+		root := newTestCA("root", true, now.Add(365*24*time.Hour), nil)
+		intermediate := newTestCA("intermediate", true, now.Add(10*24*time.Hour), &root)
+		istioCR := &operatorv1alpha2.Istio{}
+
+		// when
+		err := checkExpiry(istioCR, pluginCASecret(intermediate, root))
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(err.Error()).To(ContainSubstring(`The intermediate CA certificate "CN=intermediate" expires on`))
+		Expect(expiryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCACertificatesExpiringSoon)))
+		Expect(expiryCondition(istioCR).Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should return an error when the root CA expires within the error threshold", func() {
+		// given
+		root := newTestCA("root", true, now.Add(3*24*time.Hour), nil)
+		intermediate := newTestCA("intermediate", true, now.Add(2*time.Hour), &root)
+		istioCR := &operatorv1alpha2.Istio{}
+
+		// when
+		err := checkExpiry(istioCR, pluginCASecret(intermediate, root))
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Error))
+		Expect(err.Error()).To(ContainSubstring(`The intermediate CA certificate "CN=intermediate" expires on`))
+		Expect(expiryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCACertificatesExpiryCritical)))
+	})
+
+	It("should use the thresholds of the Istio CR", func() {
+		// given
+		root := newTestCA("root", true, now.Add(10*24*time.Hour), nil)
+		istioCR := &operatorv1alpha2.Istio{Spec: operatorv1alpha2.IstioSpec{Config: operatorv1alpha2.Config{RootCA: &operatorv1alpha2.RootCA{
+			ExpiryWarningThreshold: &metav1.Duration{Duration: 5 * 24 * time.Hour},
+			ExpiryErrorThreshold:   &metav1.Duration{Duration: 24 * time.Hour},
+		}}}}
+
+		// when
+		err := checkExpiry(istioCR, selfSignedSecret(root))
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCACertificatesValid)))
+	})
+
+	It("should check the self-signed root CA of istiod when no plug-in CA is used", func() {
+		// given
+		root := newTestCA("self-signed", true, now.Add(-time.Hour), nil)
+		istioCR := &operatorv1alpha2.Istio{}
+
+		// when
+		err := checkExpiry(istioCR, selfSignedSecret(root))
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Error))
+		Expect(err.Error()).To(ContainSubstring(`The root CA certificate "CN=self-signed" expired on`))
+	})
+
+	It("should not set a condition when no CA certificates exist", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{}
+
+		// when
+		err := checkExpiry(istioCR)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(istioCR.Status.Conditions).To(BeNil())
+	})
+
+	It("should set the check failed condition when the certificates cannot be parsed", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: cacerts.SecretName, Namespace: "istio-system"},
+			Data:       map[string][]byte{cacerts.RootCertKey: []byte("invalid")},
+		}
+
+		// when
+		err := checkExpiry(istioCR, secret)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(expiryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCACertificatesCheckFailed)))
+		Expect(expiryCondition(istioCR).Status).To(Equal(metav1.ConditionUnknown))
+	})
+})
+
+var _ = Describe("RecordExpiry", func() {
+	It("should record the expiry of the CA certificates without an Istio CR", func() {
+		// given
+		registry := prometheus.NewRegistry()
+		Expect(cacerts.RegisterMetrics(registry)).To(Succeed())
+		root := newTestCA("root", true, time.Now().Add(365*24*time.Hour), nil)
+		k8sClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: cacerts.SelfSignedSecretName, Namespace: "istio-system"},
+			Data:       map[string][]byte{cacerts.CACertKey: root.certPEM, cacerts.CAKeyKey: root.keyPEM},
+		}).Build()
+
+		// when
+		err := cacerts.RecordExpiry(context.Background(), k8sClient)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testutil.GatherAndCount(registry, expiryMetricName)).To(Equal(1))
+	})
+})
@@ -0,0 +1,29 @@
+package cacerts
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//nolint:gochecknoglobals // the metric is registered once in the metrics registry of the manager
+var certificateExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "istio_operator_ca_certificate_expiration_timestamp_seconds",
+	Help: "Expiration time of the root and intermediate CA certificates of the mesh as Unix timestamp.",
+}, []string{"type", "subject"})
+
+// RegisterMetrics registers the CA certificate metrics in the given registry.
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	err := registerer.Register(certificateExpiry)
+	if are := (prometheus.AlreadyRegisteredError{}); errors.As(err, &are) {
+		return nil
+	}
+	return err
+}
+
+func recordExpiry(certificates []Certificate) {
+	certificateExpiry.Reset()
+	for _, cert := range certificates {
+		certificateExpiry.WithLabelValues(string(cert.Type), cert.Subject).Set(float64(cert.NotAfter.Unix()))
+	}
+}
@@ -0,0 +1,102 @@
+package cacerts
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"slices"
+)
+
+const (
+	// RotationPhaseAnnotation holds the current phase of the root CA rotation on the cacerts Secret.
+	RotationPhaseAnnotation = "operator.kyma-project.io/root-ca-rotation-phase"
+	// RotationPhaseStartedAnnotation holds the time at which the current phase of the root CA rotation started.
+	RotationPhaseStartedAnnotation = "operator.kyma-project.io/root-ca-rotation-phase-started"
+)
+
+type RotationPhase string
+
+const (
+	// RotationPhaseAddRoot adds the new root CA to the trust bundle, while istiod still signs with the old CA.
+	RotationPhaseAddRoot RotationPhase = "AddRoot"
+	// RotationPhaseSwitchCA makes istiod sign with the new CA, while the old root CA is still trusted.
+	RotationPhaseSwitchCA RotationPhase = "SwitchCA"
+	// RotationPhaseRemoveRoot removes the old root CA from the trust bundle.
+	RotationPhaseRemoveRoot RotationPhase = "RemoveRoot"
+)
+
+// Next returns the phase following the given one, or an empty phase if the rotation is finished.
+func (p RotationPhase) Next() RotationPhase {
+	switch p {
+	case RotationPhaseAddRoot:
+		return RotationPhaseSwitchCA
+	case RotationPhaseSwitchCA:
+		return RotationPhaseRemoveRoot
+	default:
+		return ""
+	}
+}
+
+// PluginCAData returns the keys of the plug-in CA Secret that are used by istiod.
+func PluginCAData(source map[string][]byte) map[string][]byte {
+	data := make(map[string][]byte, len(requiredKeys))
+	for _, key := range requiredKeys {
+		data[key] = source[key]
+	}
+	return data
+}
+
+// IsRootChanged returns true if the root certificates of the plug-in CA differ from the ones in the cacerts Secret.
+func IsRootChanged(current, source map[string][]byte) bool {
+	return !bytes.Equal(bytes.TrimSpace(current[RootCertKey]), bytes.TrimSpace(source[RootCertKey]))
+}
+
+// RotationData returns the content of the cacerts Secret in the given phase of the root CA rotation. current is the content of the
+// cacerts Secret before the phase and source the content of the plug-in CA Secret with the new root CA.
+func RotationData(phase RotationPhase, current, source map[string][]byte) (map[string][]byte, error) {
+	switch phase {
+	case RotationPhaseAddRoot:
+		data := PluginCAData(current)
+		data[RootCertKey] = joinPEM(current[RootCertKey], source[RootCertKey])
+		return data, nil
+	case RotationPhaseSwitchCA:
+		oldRoots, err := oldRootCertificates(current[RootCertKey], source[RootCertKey])
+		if err != nil {
+			return nil, err
+		}
+		data := PluginCAData(source)
+		data[RootCertKey] = joinPEM(source[RootCertKey], oldRoots)
+		return data, nil
+	case RotationPhaseRemoveRoot:
+		return PluginCAData(source), nil
+	default:
+		return nil, fmt.Errorf("unknown root CA rotation phase %q", phase)
+	}
+}
+
+// oldRootCertificates returns the PEM encoded certificates of the current trust bundle that are not part of the new one.
+func oldRootCertificates(current, source []byte) ([]byte, error) {
+	currentRoots, err := ParseCertificates(current)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", RootCertKey, err)
+	}
+	sourceRoots, err := ParseCertificates(source)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", RootCertKey, err)
+	}
+
+	var oldRoots []byte
+	for _, root := range currentRoots {
+		if slices.ContainsFunc(sourceRoots, func(r *x509.Certificate) bool { return bytes.Equal(r.Raw, root.Raw) }) {
+			continue
+		}
+		oldRoots = append(oldRoots, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw})...)
+	}
+	return oldRoots, nil
+}
+
+func joinPEM(first, second []byte) []byte {
+	joined := append(bytes.TrimSpace(bytes.Clone(first)), '\n')
+	return append(joined, second...)
+}
@@ -0,0 +1,102 @@
+package cacerts_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+)
+
+var _ = Describe("Root CA rotation", func() {
+	validUntil := time.Now().Add(24 * time.Hour)
+
+	rootsOf := func(data map[string][]byte) []string {
+		certs, err := cacerts.ParseCertificates(data[cacerts.RootCertKey])
+		Expect(err).NotTo(HaveOccurred())
+		var subjects []string
+		for _, cert := range certs {
+			subjects = append(subjects, cert.Subject.CommonName)
+		}
+		return subjects
+	}
+
+	It("should progress through all phases", func() {
+		Expect(cacerts.RotationPhaseAddRoot.Next()).To(Equal(cacerts.RotationPhaseSwitchCA))
+		Expect(cacerts.RotationPhaseSwitchCA.Next()).To(Equal(cacerts.RotationPhaseRemoveRoot))
+		Expect(cacerts.RotationPhaseRemoveRoot.Next()).To(BeEmpty())
+	})
+
+	It("should detect a new root CA", func() {
+		// given
+		oldRoot := newTestCA("old-root", true, validUntil, nil)
+		newRoot := newTestCA("new-root", true, validUntil, nil)
+		oldIntermediate := newTestCA("old-intermediate", true, validUntil, &oldRoot)
+		otherIntermediate := newTestCA("other-intermediate", true, validUntil, &oldRoot)
+		newIntermediate := newTestCA("new-intermediate", true, validUntil, &newRoot)
+
+		// when & then
+		Expect(cacerts.IsRootChanged(pluginCAData(oldIntermediate, oldRoot), pluginCAData(newIntermediate, newRoot))).To(BeTrue())
+		Expect(cacerts.IsRootChanged(pluginCAData(oldIntermediate, oldRoot), pluginCAData(otherIntermediate, oldRoot))).To(BeFalse())
+	})
+
+	It("should keep signing with the old CA and trust both roots when the new root is added", func() {
+		// given
+		oldRoot := newTestCA("old-root", true, validUntil, nil)
+		newRoot := newTestCA("new-root", true, validUntil, nil)
+		current := pluginCAData(newTestCA("old-intermediate", true, validUntil, &oldRoot), oldRoot)
+		source := pluginCAData(newTestCA("new-intermediate", true, validUntil, &newRoot), newRoot)
+
+		// when
+		data, err := cacerts.RotationData(cacerts.RotationPhaseAddRoot, current, source)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data[cacerts.CACertKey]).To(Equal(current[cacerts.CACertKey]))
+		Expect(data[cacerts.CAKeyKey]).To(Equal(current[cacerts.CAKeyKey]))
+		Expect(data[cacerts.CertChainKey]).To(Equal(current[cacerts.CertChainKey]))
+		Expect(rootsOf(data)).To(Equal([]string{"old-root", "new-root"}))
+		Expect(cacerts.Validate(data, time.Now())).To(Succeed())
+	})
+
+	It("should sign with the new CA and still trust the old root when the CA is switched", func() {
+		// given
+		oldRoot := newTestCA("old-root", true, validUntil, nil)
+		newRoot := newTestCA("new-root", true, validUntil, nil)
+		source := pluginCAData(newTestCA("new-intermediate", true, validUntil, &newRoot), newRoot)
+		current, err := cacerts.RotationData(cacerts.RotationPhaseAddRoot, pluginCAData(newTestCA("old-intermediate", true, validUntil, &oldRoot), oldRoot), source)
+		Expect(err).NotTo(HaveOccurred())
+
+		// when
+		data, err := cacerts.RotationData(cacerts.RotationPhaseSwitchCA, current, source)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data[cacerts.CACertKey]).To(Equal(source[cacerts.CACertKey]))
+		Expect(data[cacerts.CAKeyKey]).To(Equal(source[cacerts.CAKeyKey]))
+		Expect(rootsOf(data)).To(Equal([]string{"new-root", "old-root"}))
+		Expect(cacerts.Validate(data, time.Now())).To(Succeed())
+	})
+
+	It("should only trust the new root when the old root is removed", func() {
+		// given
+		newRoot := newTestCA("new-root", true, validUntil, nil)
+		source := pluginCAData(newTestCA("new-intermediate", true, validUntil, &newRoot), newRoot)
+
+		// when
+		data, err := cacerts.RotationData(cacerts.RotationPhaseRemoveRoot, nil, source)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal(source))
+	})
+
+	It("should fail for an unknown phase", func() {
+		// when
+		_, err := cacerts.RotationData("Unknown", nil, nil)
+
+		// then
+		Expect(err).To(MatchError(`unknown root CA rotation phase "Unknown"`))
+	})
+})
@@ -0,0 +1,10 @@
+package cacerts
+
+const (
+	// SwitchStartedAnnotation holds the time at which istiod was restarted to switch between the self-signed and the plug-in CA. It is set
+	// on the istio-system namespace, because the cacerts Secret does not exist after a switch to the self-signed CA.
+	SwitchStartedAnnotation = "operator.kyma-project.io/ca-switch-started"
+	// SwitchRestartBeforeAnnotation holds the time at which istiod was rolled out with the new CA. The Istio proxies created before this
+	// time are restarted, because their certificates are signed by the previous CA and they do not trust the new root CA.
+	SwitchRestartBeforeAnnotation = "operator.kyma-project.io/ca-switch-restart-before"
+)
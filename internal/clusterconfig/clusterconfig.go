@@ -3,6 +3,7 @@ package clusterconfig
 import (
 	"context"
 	"regexp"
+	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -349,6 +350,49 @@ func generateIstioIngressGatewayAnnotations(clusterProvider string) (ClusterConf
 	return ClusterConfiguration{}, nil
 }
 
+// IngressGatewayServiceAnnotationConflicts returns the sorted keys of the given istio-ingressgateway Service annotations that are also
+// set by the cluster configuration, but with a different value.
+func (c ClusterConfiguration) IngressGatewayServiceAnnotationConflicts(annotations map[string]string) []string {
+	var conflicts []string
+	for key, value := range c.ingressGatewayServiceAnnotations() {
+		if userValue, ok := annotations[key]; ok && userValue != value {
+			conflicts = append(conflicts, key)
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+func (c ClusterConfiguration) ingressGatewayServiceAnnotations() map[string]string {
+	var current interface{} = map[string]interface{}(c)
+	for _, key := range []string{"spec", "values", "gateways", "istio-ingressgateway"} {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = m[key]
+	}
+
+	m, ok := current.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	switch annotations := m["serviceAnnotations"].(type) {
+	case map[string]string:
+		return annotations
+	case map[string]interface{}:
+		result := make(map[string]string, len(annotations))
+		for k, v := range annotations {
+			if value, ok := v.(string); ok {
+				result[k] = value
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
 func MergeOverrides(template []byte, overrides ClusterConfiguration) ([]byte, error) {
 	var templateMap map[string]interface{}
 	err := yaml.Unmarshal(template, &templateMap)
@@ -322,6 +322,36 @@ var _ = Describe("EvaluateClusterConfiguration", func() {
 	})
 })
 
+var _ = Describe("IngressGatewayServiceAnnotationConflicts", func() {
+	It("should return the annotations that have a different value in the cluster configuration", func() {
+		//given
+		annotations := map[string]string{
+			"service.beta.kubernetes.io/aws-load-balancer-type":   "external",
+			"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+			"example.com/custom": "value",
+		}
+
+		//when
+		conflicts := clusterconfig.AWSNLBConfig.IngressGatewayServiceAnnotationConflicts(annotations)
+
+		//then
+		Expect(conflicts).To(Equal([]string{"service.beta.kubernetes.io/aws-load-balancer-type"}))
+	})
+
+	It("should return no conflicts when the cluster configuration has no service annotations", func() {
+		//given
+		annotations := map[string]string{
+			"loadbalancer.openstack.org/proxy-protocol": "false",
+		}
+
+		//when
+		conflicts := clusterconfig.ClusterConfiguration{}.IngressGatewayServiceAnnotationConflicts(annotations)
+
+		//then
+		Expect(conflicts).To(BeEmpty())
+	})
+})
+
 var _ = Describe("EvaluateClusterSize", func() {
 	It("should return Evaluation when cpu capacity is less than ProductionClusterCPUThreshold", func() {
 		//given
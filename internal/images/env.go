@@ -26,6 +26,8 @@ type Images struct {
 	Pilot      Image `env:"pilot,notEmpty"`
 	InstallCNI Image `env:"install-cni,notEmpty"`
 	ProxyV2    Image `env:"proxyv2,notEmpty"`
+	// Ztunnel is only required for the ambient data plane mode.
+	Ztunnel Image `env:"ztunnel"`
 }
 
 func GetImages() (*Images, error) {
@@ -39,6 +41,9 @@ func GetImages() (*Images, error) {
 
 func (e *Images) GetHub() (string, error) {
 	environments := []Image{e.Pilot, e.InstallCNI, e.ProxyV2}
+	if e.Ztunnel != "" {
+		environments = append(environments, e.Ztunnel)
+	}
 
 	initialHub, err := environments[0].GetHub()
 	if err != nil {
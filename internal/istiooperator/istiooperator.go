@@ -2,6 +2,7 @@ package istiooperator
 
 import (
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -19,9 +20,7 @@ var ProductionOperator []byte
 //go:embed istio-operator-light.yaml
 var EvaluationOperator []byte
 
-const (
-	MergedIstioOperatorFile = "merged-istio-operator.yaml"
-)
+const MergedIstioOperatorFile = "merged-istio-operator.yaml"
 
 type IstioImageVersion struct {
 	semanticVersion *semver.Version
@@ -51,8 +50,17 @@ func (i *IstioImageVersion) Empty() bool {
 	return i.semanticVersion == nil
 }
 
+// Revision returns the name of the Istio revision used to install the version next to another version, e.g. "1-27-1" for 1.27.1.
+// An empty version has no revision.
+func (i *IstioImageVersion) Revision() string {
+	if i.Empty() {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d-%d", i.semanticVersion.Major, i.semanticVersion.Minor, i.semanticVersion.Patch)
+}
+
 type Merger interface {
-	Merge(clusterSize clusterconfig.ClusterSize, istioCR *operatorv1alpha2.Istio, overrides clusterconfig.ClusterConfiguration, istioImagesHub string) (string, error)
+	Merge(clusterSize clusterconfig.ClusterSize, istioCR *operatorv1alpha2.Istio, overrides clusterconfig.ClusterConfiguration, istioImagesHub string, revision string) (string, error)
 	GetIstioOperator(clusterSize clusterconfig.ClusterSize) (iopv1alpha1.IstioOperator, error)
 	GetIstioImageVersion() (IstioImageVersion, error)
 }
@@ -103,6 +111,40 @@ func (m *IstioMerger) GetIstioOperator(clusterSize clusterconfig.ClusterSize) (i
 	return toBeInstalledIop, nil
 }
 
+// ModuleExtensionProviderNames returns the names of the extension providers the module configures in the mesh config of every
+// cluster size.
+func ModuleExtensionProviderNames() ([]string, error) {
+	var names []string
+	for _, istioOperator := range [][]byte{ProductionOperator, EvaluationOperator} {
+		iop := iopv1alpha1.IstioOperator{}
+		if err := yaml.Unmarshal(istioOperator, &iop); err != nil {
+			return nil, err
+		}
+
+		meshConfig := struct {
+			ExtensionProviders []struct {
+				Name string `json:"name"`
+			} `json:"extensionProviders"`
+		}{}
+		if err := json.Unmarshal(iop.Spec.MeshConfig, &meshConfig); err != nil {
+			return nil, err
+		}
+		for _, provider := range meshConfig.ExtensionProviders {
+			names = append(names, provider.Name)
+		}
+	}
+	return names, nil
+}
+
+// setRevision sets the revision the IstioOperator is installed with. The default revision is installed without a revision name,
+// because Istio would otherwise suffix the names of the control plane resources with it.
+func setRevision(iop *iopv1alpha1.IstioOperator, revision string) {
+	if revision == operatorv1alpha2.DefaultRevision {
+		revision = ""
+	}
+	iop.Spec.Revision = revision
+}
+
 func applyIstioCR(istioCR *operatorv1alpha2.Istio, toBeInstalledIop iopv1alpha1.IstioOperator) ([]byte, error) {
 	mergedIOP, err := istioCR.MergeInto(toBeInstalledIop)
 	if err != nil {
@@ -53,7 +53,7 @@ var _ = Describe("Merge", func() {
 		sut := istiooperator.NewDefaultIstioMerger()
 
 		// when
-		mergedIstioOperatorPath, err := sut.Merge(clusterSize, istioCR, clusterconfig.ClusterConfiguration{}, "docker.io/istio")
+		mergedIstioOperatorPath, err := sut.Merge(clusterSize, istioCR, clusterconfig.ClusterConfiguration{}, "docker.io/istio", v1alpha2.DefaultRevision)
 
 		// then
 		if shouldError {
@@ -107,7 +107,7 @@ var _ = Describe("Merge", func() {
 		sut := istiooperator.NewDefaultIstioMerger()
 
 		// when
-		mergedIstioOperatorPath, err := sut.Merge(clusterconfig.Production, istioCR, clusterConfig, "docker.io/istio")
+		mergedIstioOperatorPath, err := sut.Merge(clusterconfig.Production, istioCR, clusterConfig, "docker.io/istio", v1alpha2.DefaultRevision)
 
 		// then
 		Expect(err).ShouldNot(HaveOccurred())
@@ -157,7 +157,7 @@ var _ = Describe("Merge", func() {
 				},
 			}}
 		// when
-		mergedIstioOperatorPath, err := sut.Merge(clusterconfig.Production, istioCR, clusterConfig, istioImagesHub)
+		mergedIstioOperatorPath, err := sut.Merge(clusterconfig.Production, istioCR, clusterConfig, istioImagesHub, v1alpha2.DefaultRevision)
 
 		// then
 		Expect(err).ShouldNot(HaveOccurred())
@@ -165,6 +165,32 @@ var _ = Describe("Merge", func() {
 		iop := readIOP(mergedIstioOperatorPath)
 		Expect(iop.Spec.Hub).To(Equal(istioImagesHub))
 	})
+
+	It("should set the revision of the merged configuration", func() {
+		// given
+		sut := istiooperator.NewDefaultIstioMerger()
+
+		// when
+		mergedIstioOperatorPath, err := sut.Merge(clusterconfig.Production, istioCR, clusterconfig.ClusterConfiguration{}, "docker.io/istio", "1-27-1")
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+		iop := readIOP(mergedIstioOperatorPath)
+		Expect(iop.Spec.Revision).To(Equal("1-27-1"))
+	})
+
+	It("should not set a revision name for the default revision", func() {
+		// given
+		sut := istiooperator.NewDefaultIstioMerger()
+
+		// when
+		mergedIstioOperatorPath, err := sut.Merge(clusterconfig.Production, istioCR, clusterconfig.ClusterConfiguration{}, "docker.io/istio", v1alpha2.DefaultRevision)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+		iop := readIOP(mergedIstioOperatorPath)
+		Expect(iop.Spec.Revision).To(BeEmpty())
+	})
 })
 
 var _ = Describe("NewIstioImageVersionFromTag", func() {
@@ -177,6 +203,7 @@ var _ = Describe("NewIstioImageVersionFromTag", func() {
 		Expect(version.Version()).Should(Equal("1.12.3"))
 		Expect(version.Flavor()).Should(Equal("blah"))
 		Expect(version.Tag()).Should(Equal("1.12.3-blah"))
+		Expect(version.Revision()).Should(Equal("1-12-3"))
 	})
 
 	It("should return error for an incorrect semantic version", func() {
@@ -187,6 +214,7 @@ var _ = Describe("NewIstioImageVersionFromTag", func() {
 		Expect(err).Should(HaveOccurred())
 		Expect(err.Error()).Should(ContainSubstring("invalid syntax"))
 		Expect(version.Empty()).Should(BeTrue())
+		Expect(version.Revision()).Should(BeEmpty())
 	})
 })
 
@@ -11,11 +11,12 @@ import (
 	"github.com/kyma-project/istio/operator/internal/images"
 )
 
-func (m *IstioMerger) Merge(clusterSize clusterconfig.ClusterSize, istioCR *operatorv1alpha2.Istio, overrides clusterconfig.ClusterConfiguration, istioImagesHub string) (string, error) {
+func (m *IstioMerger) Merge(clusterSize clusterconfig.ClusterSize, istioCR *operatorv1alpha2.Istio, overrides clusterconfig.ClusterConfiguration, istioImagesHub string, revision string) (string, error) {
 	toBeInstalledIop, err := m.GetIstioOperator(clusterSize)
 	if err != nil {
 		return "", err
 	}
+	setRevision(&toBeInstalledIop, revision)
 	mergedManifest, err := applyIstioCR(istioCR, toBeInstalledIop)
 	if err != nil {
 		return "", err
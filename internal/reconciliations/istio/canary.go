@@ -0,0 +1,222 @@
+package istio
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/masterminds/semver"
+	"istio.io/api/annotation"
+	corev1 "k8s.io/api/core/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/istiooperator"
+	"github.com/kyma-project/istio/operator/internal/webhooks"
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
+)
+
+// revisions describes the Istio revisions of an installation. Outside a canary upgrade, Istio is installed into the current revision.
+type revisions struct {
+	// current is the revision the default revision tag points to.
+	current string
+	// target is the revision the Istio version is installed with.
+	target string
+	// targetInstalled is true if the target revision was already installed before this reconciliation.
+	targetInstalled bool
+}
+
+func (r revisions) isCanary() bool {
+	return r.current != r.target
+}
+
+// resolveRevisions determines the revision the Istio version is installed with. A canary upgrade installs the new version as a new
+// revision named after the version. A canary upgrade that was already started is continued, even if the upgrade strategy changed.
+func resolveRevisions(ctx context.Context, k8sClient client.Client, istioCR *operatorv1alpha2.Istio, istioImageVersion istiooperator.IstioImageVersion) (revisions, error) {
+	current, err := webhooks.GetDefaultRevision(ctx, k8sClient)
+	if err != nil {
+		return revisions{}, err
+	}
+
+	installed, err := gatherer.ListInstalledIstioRevisions(ctx, k8sClient)
+	if err != nil {
+		return revisions{}, err
+	}
+
+	canaryRevision := istioImageVersion.Revision()
+	if _, ok := installed[canaryRevision]; ok && canaryRevision != current {
+		return revisions{current: current, target: canaryRevision, targetInstalled: true}, nil
+	}
+
+	currentVersion, ok := installed[current]
+	if !ok || !istioCR.Spec.IsCanaryUpgrade() {
+		return revisions{current: current, target: current, targetInstalled: ok}, nil
+	}
+
+	targetVersion, err := semver.NewVersion(istioImageVersion.Version())
+	if err != nil {
+		return revisions{}, err
+	}
+	if currentVersion.Equal(targetVersion) {
+		return revisions{current: current, target: current, targetInstalled: true}, nil
+	}
+
+	return revisions{current: current, target: canaryRevision}, nil
+}
+
+// progressCanaryUpgrade runs the next step of a canary upgrade after the target revision is installed. Namespaces are moved to the
+// target revision one at a time, after the proxies of the previously moved namespace were restarted. When all namespaces are moved,
+// the default revision tag is pointed to the target revision. The previous revisions are removed after every proxy runs the new version.
+func progressCanaryUpgrade(ctx context.Context, args installArgs, revs revisions) describederrors.DescribedError {
+	k8sClient := args.client
+	istioCR := args.istioCR
+	statusHandler := args.statusHandler
+
+	if revs.isCanary() && !revs.targetInstalled {
+		ctrl.Log.Info("Installed canary Istio revision", "revision", revs.target, "current revision", revs.current)
+		message := fmt.Sprintf("Istio revision %s is installed next to revision %s", revs.target, revs.current)
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCanaryRevisionInstalled, message))
+		return nil
+	}
+
+	previousRevisions, err := gatherer.ListPreviousIstioRevisions(ctx, k8sClient, revs.target)
+	if err != nil {
+		return describederrors.NewDescribedError(err, "Could not list previous Istio revisions")
+	}
+	if len(previousRevisions) == 0 {
+		return nil
+	}
+
+	tags, err := webhooks.GetRevisionTags(ctx, k8sClient)
+	if err != nil {
+		return describederrors.NewDescribedError(err, "Could not get Istio revision tags")
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err = k8sClient.List(ctx, namespaces); err != nil {
+		return describederrors.NewDescribedError(err, "Could not list namespaces")
+	}
+
+	var pendingNamespaces, movedNamespaces []string
+	for _, ns := range namespaces.Items {
+		revision := webhooks.InjectionRevision(ns, tags)
+		switch {
+		case revision == revs.target:
+			movedNamespaces = append(movedNamespaces, ns.Name)
+		case slices.Contains(previousRevisions, revision) && hasInjectionLabel(ns):
+			pendingNamespaces = append(pendingNamespaces, ns.Name)
+		}
+	}
+
+	if len(pendingNamespaces) > 0 {
+		pendingProxies, err := countPendingProxiesOfMovedNamespaces(ctx, k8sClient, previousRevisions, movedNamespaces)
+		if err != nil {
+			return describederrors.NewDescribedError(err, "Could not count Istio proxies of previous revisions")
+		}
+		if pendingProxies > 0 {
+			setWaitingForProxiesCondition(args, pendingProxies)
+			return nil
+		}
+
+		namespace := pendingNamespaces[0]
+		if err = moveNamespaceToRevision(ctx, k8sClient, namespace, revs.target); err != nil {
+			return describederrors.NewDescribedError(err, "Could not move namespace to the new Istio revision")
+		}
+		ctrl.Log.Info("Moved namespace to canary Istio revision", "namespace", namespace, "revision", revs.target)
+		message := fmt.Sprintf("Namespace %s is moved to Istio revision %s. Namespaces left: %d", namespace, revs.target, len(pendingNamespaces)-1)
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCanaryNamespaceMigrated, message))
+		return nil
+	}
+
+	if revs.isCanary() {
+		if err = args.istioClient.SetDefaultRevision(ctx, revs.target); err != nil {
+			return describederrors.NewDescribedError(err, "Could not point the default revision tag to the new Istio revision")
+		}
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCanaryWaitingForProxies))
+		return nil
+	}
+
+	pendingProxies, err := countProxiesOfRevisions(ctx, k8sClient, previousRevisions, nil)
+	if err != nil {
+		return describederrors.NewDescribedError(err, "Could not count Istio proxies of previous revisions")
+	}
+	if pendingProxies > 0 {
+		setWaitingForProxiesCondition(args, pendingProxies)
+		return nil
+	}
+
+	for _, revision := range previousRevisions {
+		if err = args.istioClient.UninstallRevision(ctx, revision); err != nil {
+			return describederrors.NewDescribedError(err, "Could not remove the previous Istio revision")
+		}
+	}
+	ctrl.Log.Info("Canary upgrade succeeded", "removed revisions", previousRevisions)
+	statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCanaryUpgradeSucceeded))
+
+	return nil
+}
+
+func setWaitingForProxiesCondition(args installArgs, pendingProxies int) {
+	message := fmt.Sprintf("%s. Pods with proxies of the previous revision: %d", operatorv1alpha2.ConditionReasonCanaryWaitingForProxiesMessage, pendingProxies)
+	args.statusHandler.SetCondition(args.istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCanaryWaitingForProxies, message))
+}
+
+func hasInjectionLabel(ns corev1.Namespace) bool {
+	_, hasRevisionLabel := ns.Labels[gatherer.RevisionLabelName]
+	return ns.Labels[webhooks.IstioInjectionLabel] == "enabled" || hasRevisionLabel
+}
+
+// moveNamespaceToRevision labels the namespace with the revision. The istio-injection label is removed, because it takes precedence
+// over the istio.io/rev label.
+func moveNamespaceToRevision(ctx context.Context, k8sClient client.Client, name, revision string) error {
+	ns := &corev1.Namespace{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(ns.DeepCopy())
+	delete(ns.Labels, webhooks.IstioInjectionLabel)
+	ns.Labels = addToMap(ns.Labels, gatherer.RevisionLabelName, revision)
+
+	return k8sClient.Patch(ctx, ns, patch)
+}
+
+// countPendingProxiesOfMovedNamespaces counts the running Pods in the moved namespaces that still have a sidecar proxy of one of the
+// previous revisions. Before the first namespace is moved, there are no proxies to wait for.
+func countPendingProxiesOfMovedNamespaces(ctx context.Context, k8sClient client.Client, previousRevisions []string, movedNamespaces []string) (int, error) {
+	if len(movedNamespaces) == 0 {
+		return 0, nil
+	}
+	return countProxiesOfRevisions(ctx, k8sClient, previousRevisions, movedNamespaces)
+}
+
+// countProxiesOfRevisions counts the running Pods with a sidecar proxy injected by one of the revisions. If namespaces are given,
+// only Pods in these namespaces are counted.
+func countProxiesOfRevisions(ctx context.Context, k8sClient client.Client, revisions []string, namespaces []string) (int, error) {
+	requirement, err := k8slabels.NewRequirement(gatherer.RevisionLabelName, selection.In, revisions)
+	if err != nil {
+		return 0, err
+	}
+
+	podList := &corev1.PodList{}
+	err = k8sClient.List(ctx, podList, client.MatchingLabelsSelector{Selector: k8slabels.NewSelector().Add(*requirement)})
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, pod := range podList.Items {
+		if _, injected := pod.Annotations[annotation.SidecarStatus.Name]; !injected || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if namespaces != nil && !slices.Contains(namespaces, pod.Namespace) {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,338 @@
+package istio_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/reconciliations/istio"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
+)
+
+const (
+	previousIstioVersion = "1.16.0"
+	newerIstioVersion    = "1.17.0"
+	canaryRevision       = "1-16-1"
+)
+
+var _ = Describe("Canary upgrade", func() {
+	newIstioCR := func() *operatorv1alpha2.Istio {
+		return &operatorv1alpha2.Istio{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", ResourceVersion: "1"},
+			Spec:       operatorv1alpha2.IstioSpec{UpgradeStrategy: operatorv1alpha2.UpgradeStrategyCanary},
+		}
+	}
+
+	istiodDeployment := func(name, revision, version string) *appsv1.Deployment {
+		return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: gatherer.IstioNamespace,
+			Labels:    map[string]string{"app": "istiod", "istio.io/rev": revision, "operator.istio.io/version": version},
+		}}
+	}
+
+	defaultTag := func(revision string) *admissionv1.MutatingWebhookConfiguration {
+		return &admissionv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{
+			Name:   "istio-revision-tag-default",
+			Labels: map[string]string{"istio.io/tag": "default", "istio.io/rev": revision},
+		}}
+	}
+
+	namespaceWithLabels := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+
+	injectedPod := func(name, namespace, revision string) *corev1.Pod {
+		pod := createPod(name, namespace, "istio-proxy", istioVersion, "istio.io/rev="+revision)
+		pod.Annotations = map[string]string{"sidecar.istio.io/status": "{}"}
+		return pod
+	}
+
+	controlPlaneObjects := func(installedRevisions ...client.Object) []client.Object {
+		objects := []client.Object{
+			createNamespace("istio-system"),
+			createPod("istiod-old", gatherer.IstioNamespace, "discovery", previousIstioVersion, "kyma-project.io/module=istio", "app=istiod", "istio.io/rev=default"),
+			createPod("istiod-canary", gatherer.IstioNamespace, "discovery", istioVersion, "kyma-project.io/module=istio", "app=istiod", "istio.io/rev="+canaryRevision),
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-ingressgateway"}},
+		}
+		return append(objects, installedRevisions...)
+	}
+
+	reconcile := func(c client.Client, istioCR *operatorv1alpha2.Istio, mockClient *mockLibraryClient) {
+		installation := istio.Installation{
+			Client:      c,
+			IstioClient: mockClient,
+			Merger:      MergerMock{tag: istioTag},
+		}
+		_, err := installation.Reconcile(context.Background(), istioCR, status.NewStatusHandler(c), "docker.io/istio")
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	canaryCondition := func(istioCR *operatorv1alpha2.Istio) *metav1.Condition {
+		Expect(istioCR.Status.Conditions).ToNot(BeNil())
+		return meta.FindStatusCondition(*istioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeCanaryUpgrade))
+	}
+
+	It("should install the new version as a separate revision", func() {
+		// given
+		istioCR := newIstioCR()
+		objects := []client.Object{
+			createNamespace("istio-system"),
+			createPod("istiod-canary", gatherer.IstioNamespace, "discovery", istioVersion, "kyma-project.io/module=istio", "app=istiod", "istio.io/rev="+canaryRevision),
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-ingressgateway"}},
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			defaultTag("default"),
+		}
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(mockClient.installCalled).To(BeTrue())
+		condition := canaryCondition(istioCR)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryRevisionInstalled)))
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Message).To(Equal("Istio revision 1-16-1 is installed next to revision default"))
+	})
+
+	It("should not start a canary upgrade when the upgrade strategy is in-place", func() {
+		// given
+		istioCR := newIstioCR()
+		istioCR.Spec.UpgradeStrategy = operatorv1alpha2.UpgradeStrategyInPlace
+		objects := []client.Object{
+			createNamespace("istio-system"),
+			createPod("istiod", gatherer.IstioNamespace, "discovery", istioVersion, "kyma-project.io/module=istio", "app=istiod", "istio.io/rev=default"),
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-ingressgateway"}},
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			defaultTag("default"),
+		}
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(mockClient.installCalled).To(BeTrue())
+		Expect(canaryCondition(istioCR)).To(BeNil())
+	})
+
+	It("should move one namespace to the new revision", func() {
+		// given
+		istioCR := newIstioCR()
+		objects := controlPlaneObjects(
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			istiodDeployment("istiod-"+canaryRevision, canaryRevision, istioVersion),
+			defaultTag("default"),
+			namespaceWithLabels("ns-a", map[string]string{"istio-injection": "enabled"}),
+			namespaceWithLabels("ns-b", map[string]string{"istio-injection": "enabled"}),
+		)
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		nsA := corev1.Namespace{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: "ns-a"}, &nsA)).To(Succeed())
+		Expect(nsA.Labels).To(HaveKeyWithValue("istio.io/rev", canaryRevision))
+		Expect(nsA.Labels).ToNot(HaveKey("istio-injection"))
+
+		nsB := corev1.Namespace{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: "ns-b"}, &nsB)).To(Succeed())
+		Expect(nsB.Labels).To(HaveKeyWithValue("istio-injection", "enabled"))
+
+		condition := canaryCondition(istioCR)
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryNamespaceMigrated)))
+		Expect(condition.Message).To(Equal("Namespace ns-a is moved to Istio revision 1-16-1. Namespaces left: 1"))
+	})
+
+	It("should move the first namespace to the new revision while proxies of the previous revision are running in pending namespaces", func() {
+		// given
+		istioCR := newIstioCR()
+		objects := controlPlaneObjects(
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			istiodDeployment("istiod-"+canaryRevision, canaryRevision, istioVersion),
+			defaultTag("default"),
+			namespaceWithLabels("ns-a", map[string]string{"istio-injection": "enabled"}),
+			namespaceWithLabels("ns-b", map[string]string{"istio-injection": "enabled"}),
+			injectedPod("app-a", "ns-a", "default"),
+			injectedPod("app-b", "ns-b", "default"),
+		)
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		nsA := corev1.Namespace{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: "ns-a"}, &nsA)).To(Succeed())
+		Expect(nsA.Labels).To(HaveKeyWithValue("istio.io/rev", canaryRevision))
+
+		condition := canaryCondition(istioCR)
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryNamespaceMigrated)))
+	})
+
+	It("should wait with the next namespace until the proxies of the moved namespace are restarted", func() {
+		// given
+		istioCR := newIstioCR()
+		objects := controlPlaneObjects(
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			istiodDeployment("istiod-"+canaryRevision, canaryRevision, istioVersion),
+			defaultTag("default"),
+			namespaceWithLabels("ns-a", map[string]string{"istio.io/rev": canaryRevision}),
+			namespaceWithLabels("ns-b", map[string]string{"istio-injection": "enabled"}),
+			injectedPod("app", "ns-a", "default"),
+		)
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		nsB := corev1.Namespace{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: "ns-b"}, &nsB)).To(Succeed())
+		Expect(nsB.Labels).To(HaveKeyWithValue("istio-injection", "enabled"))
+
+		condition := canaryCondition(istioCR)
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryWaitingForProxies)))
+		Expect(condition.Message).To(ContainSubstring("Pods with proxies of the previous revision: 1"))
+	})
+
+	It("should point the default revision tag to the new revision when all namespaces are moved", func() {
+		// given
+		istioCR := newIstioCR()
+		objects := controlPlaneObjects(
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			istiodDeployment("istiod-"+canaryRevision, canaryRevision, istioVersion),
+			defaultTag("default"),
+			namespaceWithLabels("ns-a", map[string]string{"istio.io/rev": canaryRevision}),
+		)
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(mockClient.defaultRevision).To(Equal(canaryRevision))
+		Expect(mockClient.uninstalledRevision).To(BeEmpty())
+		Expect(canaryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryWaitingForProxies)))
+	})
+
+	It("should not remove the previous revision while proxies of the previous revision are running", func() {
+		// given
+		istioCR := newIstioCR()
+		objects := controlPlaneObjects(
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			istiodDeployment("istiod-"+canaryRevision, canaryRevision, istioVersion),
+			defaultTag(canaryRevision),
+			namespaceWithLabels("ns-a", map[string]string{"istio.io/rev": canaryRevision}),
+			namespaceWithLabels("ns-c", nil),
+			injectedPod("app", "ns-c", "default"),
+		)
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(mockClient.uninstalledRevision).To(BeEmpty())
+		Expect(canaryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryWaitingForProxies)))
+	})
+
+	It("should remove the previous revision when every proxy runs the new version", func() {
+		// given
+		istioCR := newIstioCR()
+		objects := controlPlaneObjects(
+			istiodDeployment("istiod", "default", previousIstioVersion),
+			istiodDeployment("istiod-"+canaryRevision, canaryRevision, istioVersion),
+			defaultTag(canaryRevision),
+			namespaceWithLabels("ns-a", map[string]string{"istio.io/rev": canaryRevision}),
+			injectedPod("app", "ns-a", canaryRevision),
+		)
+		c := createFakeClient(append(objects, istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(mockClient.uninstalledRevision).To(Equal([]string{"default"}))
+		condition := canaryCondition(istioCR)
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryUpgradeSucceeded)))
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	Context("downgrade", func() {
+		downgradeControlPlaneObjects := func(installedRevisions ...client.Object) []client.Object {
+			objects := []client.Object{
+				createNamespace("istio-system"),
+				createPod("istiod-newer", gatherer.IstioNamespace, "discovery", newerIstioVersion, "kyma-project.io/module=istio", "app=istiod", "istio.io/rev=default"),
+				createPod("istiod-canary", gatherer.IstioNamespace, "discovery", istioVersion, "kyma-project.io/module=istio", "app=istiod", "istio.io/rev="+canaryRevision),
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-ingressgateway"}},
+				istiodDeployment("istiod", "default", newerIstioVersion),
+				istiodDeployment("istiod-"+canaryRevision, canaryRevision, istioVersion),
+			}
+			return append(objects, installedRevisions...)
+		}
+
+		It("should move namespaces to the target revision with the lower version", func() {
+			// given
+			istioCR := newIstioCR()
+			objects := downgradeControlPlaneObjects(
+				defaultTag("default"),
+				namespaceWithLabels("ns-a", map[string]string{"istio.io/rev": canaryRevision}),
+				namespaceWithLabels("ns-b", map[string]string{"istio-injection": "enabled"}),
+			)
+			c := createFakeClient(append(objects, istioCR)...)
+			mockClient := &mockLibraryClient{}
+
+			// when
+			reconcile(c, istioCR, mockClient)
+
+			// then
+			nsB := corev1.Namespace{}
+			Expect(c.Get(context.Background(), types.NamespacedName{Name: "ns-b"}, &nsB)).To(Succeed())
+			Expect(nsB.Labels).To(HaveKeyWithValue("istio.io/rev", canaryRevision))
+			Expect(mockClient.uninstalledRevision).To(BeEmpty())
+			Expect(canaryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryNamespaceMigrated)))
+		})
+
+		It("should remove the previous revision with the higher version when every proxy runs the target version", func() {
+			// given
+			istioCR := newIstioCR()
+			objects := downgradeControlPlaneObjects(
+				defaultTag(canaryRevision),
+				namespaceWithLabels("ns-a", map[string]string{"istio.io/rev": canaryRevision}),
+				injectedPod("app", "ns-a", canaryRevision),
+			)
+			c := createFakeClient(append(objects, istioCR)...)
+			mockClient := &mockLibraryClient{}
+
+			// when
+			reconcile(c, istioCR, mockClient)
+
+			// then
+			Expect(mockClient.uninstalledRevision).To(Equal([]string{"default"}))
+			Expect(canaryCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonCanaryUpgradeSucceeded)))
+		})
+	})
+})
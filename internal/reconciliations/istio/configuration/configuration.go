@@ -12,19 +12,43 @@ import (
 
 type AppliedConfig struct {
 	v1alpha2.IstioSpec `json:",inline"`
-	IstioTag           string `json:"IstioTag"`
+	IstioTag           string                 `json:"IstioTag"`
+	VersionChange      *ApprovedVersionChange `json:"versionChange,omitempty"`
 }
 
+// ApprovedVersionChange records a version change that is not allowed by CheckIstioVersionUpdate and was approved with the
+// allow-version-change annotation.
+type ApprovedVersionChange struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	ApprovedBy string `json:"approvedBy"`
+}
+
+const unknownApprover = "unknown"
+
 // UpdateLastAppliedConfiguration annotates the passed CR with LastAppliedConfiguration, which holds information about last applied
-// IstioCR spec and IstioTag (IstioVersion-IstioImageBase).
+// IstioCR spec and IstioTag (IstioVersion-IstioImageBase). If the version change to IstioTag was approved with the allow-version-change
+// annotation, the approval is recorded as well and kept as long as IstioTag has the same version, and the annotation is removed.
 func UpdateLastAppliedConfiguration(istioCR *v1alpha2.Istio, istioTag string) error {
 	if len(istioCR.Annotations) == 0 {
 		istioCR.Annotations = map[string]string{}
 	}
 
+	lastAppliedConfig, err := GetLastAppliedConfiguration(istioCR)
+	if err != nil {
+		return err
+	}
+
 	newAppliedConfig := AppliedConfig{
-		IstioSpec: istioCR.Spec,
-		IstioTag:  istioTag,
+		IstioSpec:     istioCR.Spec,
+		IstioTag:      istioTag,
+		VersionChange: approvedVersionChange(istioCR, lastAppliedConfig, istioTag),
+	}
+
+	// The approval is recorded in the last applied configuration, so the annotations are removed to not approve later version changes.
+	if IsVersionChangeApproved(istioCR, istioTag) {
+		delete(istioCR.Annotations, labels.AllowVersionChange)
+		delete(istioCR.Annotations, labels.VersionChangeApprovedBy)
 	}
 
 	config, err := json.Marshal(newAppliedConfig)
@@ -52,6 +76,84 @@ func GetLastAppliedConfiguration(istioCR *v1alpha2.Istio) (AppliedConfig, error)
 	return lastAppliedConfig, nil
 }
 
+// approvedVersionChange returns the approval of the version change from the last applied IstioTag to istioTag, or the approval of the
+// last applied configuration if the version did not change.
+func approvedVersionChange(istioCR *v1alpha2.Istio, lastAppliedConfig AppliedConfig, istioTag string) *ApprovedVersionChange {
+	if lastAppliedConfig.IstioTag == "" {
+		return nil
+	}
+
+	if sameVersion(lastAppliedConfig.IstioTag, istioTag) {
+		return lastAppliedConfig.VersionChange
+	}
+
+	if !IsVersionChangeApproved(istioCR, istioTag) {
+		return nil
+	}
+
+	return &ApprovedVersionChange{
+		From:       lastAppliedConfig.IstioTag,
+		To:         istioTag,
+		ApprovedBy: versionChangeApprover(istioCR),
+	}
+}
+
+// IsVersionChangeApproved returns true if the Istio CR has the allow-version-change annotation with the version of the target IstioTag.
+// The annotation may contain the version (e.g. 1.16.1) or the full IstioTag (e.g. 1.16.1-distroless).
+func IsVersionChangeApproved(istioCR *v1alpha2.Istio, targetIstioTag string) bool {
+	approvedVersion, found := istioCR.Annotations[labels.AllowVersionChange]
+	if !found || approvedVersion == "" {
+		return false
+	}
+
+	return sameVersion(approvedVersion, targetIstioTag)
+}
+
+// versionChangeApprover returns the user who set the allow-version-change annotation, as recorded by the mutating webhook of the Istio CR.
+func versionChangeApprover(istioCR *v1alpha2.Istio) string {
+	approver, found := istioCR.Annotations[labels.VersionChangeApprovedBy]
+	if !found || approver == "" {
+		return unknownApprover
+	}
+	return approver
+}
+
+func sameVersion(versionA, versionB string) bool {
+	a, err := semver.NewVersion(versionA)
+	if err != nil {
+		return false
+	}
+	b, err := semver.NewVersion(versionB)
+	if err != nil {
+		return false
+	}
+
+	return a.Major == b.Major && a.Minor == b.Minor && a.Patch == b.Patch
+}
+
+// CheckApprovedIstioVersionChange checks a version change that was approved with the allow-version-change annotation. Downgrades and
+// upgrades of more than one minor version are allowed, but a change of the major version is still not supported.
+func CheckApprovedIstioVersionChange(currentIstioVersionString, targetIstioVersionString string) error {
+	currentIstioVersion, err := semver.NewVersion(currentIstioVersionString)
+	if err != nil {
+		return err
+	}
+	targetIstioVersion, err := semver.NewVersion(targetIstioVersionString)
+	if err != nil {
+		return err
+	}
+
+	if currentIstioVersion.Major != targetIstioVersion.Major {
+		return fmt.Errorf(
+			"target Istio version (%s) is different than current Istio version (%s) - major version change is not supported",
+			targetIstioVersion.String(),
+			currentIstioVersion.String(),
+		)
+	}
+
+	return nil
+}
+
 func CheckIstioVersionUpdate(currentIstioVersionString, targetIstioVersionString string) error {
 	currentIstioVersion, err := semver.NewVersion(currentIstioVersionString)
 	if err != nil {
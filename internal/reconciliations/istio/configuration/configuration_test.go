@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kyma-project/istio/operator/internal/tests"
 	"github.com/onsi/ginkgo/v2/types"
@@ -17,6 +18,8 @@ import (
 const (
 	mockIstioTag             string = "1.16.1-distroless"
 	lastAppliedConfiguration string = "operator.kyma-project.io/lastAppliedConfiguration"
+	allowVersionChange       string = "operator.kyma-project.io/allow-version-change"
+	versionChangeApprovedBy  string = "operator.kyma-project.io/version-change-approved-by"
 )
 
 func TestRestarter(t *testing.T) {
@@ -51,6 +54,117 @@ var _ = Describe("Istio Configuration", func() {
 		})
 	})
 
+	Context("Approved version change", func() {
+		approvedIstioCR := func(lastAppliedIstioTag, approvedVersion string) *operatorv1alpha2.Istio {
+			return &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					lastAppliedConfiguration: fmt.Sprintf(`{"IstioTag":"%s"}`, lastAppliedIstioTag),
+					allowVersionChange:       approvedVersion,
+					versionChangeApprovedBy:  "admin@example.com",
+				},
+			}}
+		}
+
+		It("should record the approval of the version change in lastAppliedConfiguration", func() {
+			// given
+			istioCR := approvedIstioCR("1.17.0-distroless", "1.16.1")
+
+			// when
+			err := configuration.UpdateLastAppliedConfiguration(istioCR, mockIstioTag)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			appliedConfig, err := configuration.GetLastAppliedConfiguration(istioCR)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(appliedConfig.VersionChange).To(Equal(&configuration.ApprovedVersionChange{
+				From:       "1.17.0-distroless",
+				To:         mockIstioTag,
+				ApprovedBy: "admin@example.com",
+			}))
+		})
+
+		It("should remove the approval annotations once the approved version is applied", func() {
+			// given
+			istioCR := approvedIstioCR("1.17.0-distroless", "1.16.1")
+
+			// when
+			err := configuration.UpdateLastAppliedConfiguration(istioCR, mockIstioTag)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(istioCR.Annotations).ToNot(HaveKey(allowVersionChange))
+			Expect(istioCR.Annotations).ToNot(HaveKey(versionChangeApprovedBy))
+		})
+
+		It("should record an unknown approver if the approver annotation is missing", func() {
+			// given
+			istioCR := approvedIstioCR("1.17.0-distroless", "1.16.1")
+			delete(istioCR.Annotations, versionChangeApprovedBy)
+
+			// when
+			err := configuration.UpdateLastAppliedConfiguration(istioCR, mockIstioTag)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			appliedConfig, err := configuration.GetLastAppliedConfiguration(istioCR)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(appliedConfig.VersionChange.ApprovedBy).To(Equal("unknown"))
+		})
+
+		It("should keep the recorded approval as long as the version does not change", func() {
+			// given
+			istioCR := approvedIstioCR("1.17.0-distroless", "1.16.1")
+			Expect(configuration.UpdateLastAppliedConfiguration(istioCR, mockIstioTag)).To(Succeed())
+
+			// when
+			err := configuration.UpdateLastAppliedConfiguration(istioCR, mockIstioTag)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			appliedConfig, err := configuration.GetLastAppliedConfiguration(istioCR)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(appliedConfig.VersionChange).ToNot(BeNil())
+			Expect(appliedConfig.VersionChange.ApprovedBy).To(Equal("admin@example.com"))
+		})
+
+		It("should not record an approval when the approved version is not the applied version", func() {
+			// given
+			istioCR := approvedIstioCR("1.17.0-distroless", "1.15.0")
+
+			// when
+			err := configuration.UpdateLastAppliedConfiguration(istioCR, mockIstioTag)
+
+			// then
+			Expect(err).ShouldNot(HaveOccurred())
+			appliedConfig, err := configuration.GetLastAppliedConfiguration(istioCR)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(appliedConfig.VersionChange).To(BeNil())
+		})
+
+		DescribeTable("IsVersionChangeApproved",
+			func(approvedVersion string, expected bool) {
+				istioCR := approvedIstioCR("1.17.0-distroless", approvedVersion)
+				Expect(configuration.IsVersionChangeApproved(istioCR, mockIstioTag)).To(Equal(expected))
+			},
+			Entry("should be approved with the version", "1.16.1", true),
+			Entry("should be approved with the tag", "1.16.1-distroless", true),
+			Entry("should not be approved with another version", "1.16.0", false),
+			Entry("should not be approved with an invalid version", "latest", false),
+			Entry("should not be approved with an empty annotation", "", false),
+		)
+
+		It("should not allow an approved major version change", func() {
+			err := configuration.CheckApprovedIstioVersionChange("1.10.0", "2.10.0")
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("major version change is not supported"))
+		})
+
+		It("should allow an approved downgrade", func() {
+			err := configuration.CheckApprovedIstioVersionChange("1.12.0", "1.10.0")
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
 	Context("CheckIstioVersionUpdate", func() {
 		It("should return nil when target version is the same as current version", func() {
 			err := configuration.CheckIstioVersionUpdate("1.10.0", "1.10.0")
@@ -0,0 +1,89 @@
+package istio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"istio.io/istio/manifests"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	istioCRDsManifest = "charts/base/files/crd-all.gen.yaml"
+	istioGroupSuffix  = "istio.io"
+)
+
+// checkIstioCRDsCompatibility checks that the Istio CRDs installed in the cluster can be replaced with the CRDs of the Istio version
+// bundled with the operator. This is not the case if the cluster stores custom resources in an API version the bundled CRDs do not serve,
+// which happens when Istio is downgraded to a version that does not know the API version yet.
+func checkIstioCRDsCompatibility(ctx context.Context, k8sClient client.Client) error {
+	servedVersions, err := bundledIstioCRDsServedVersions()
+	if err != nil {
+		return err
+	}
+
+	crdList := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := k8sClient.List(ctx, &crdList); err != nil {
+		return err
+	}
+
+	var incompatible []string
+	for _, crd := range crdList.Items {
+		if !strings.HasSuffix(crd.Spec.Group, istioGroupSuffix) {
+			continue
+		}
+		served, bundled := servedVersions[crd.Name]
+		if !bundled {
+			continue
+		}
+		for _, storedVersion := range crd.Status.StoredVersions {
+			if !slices.Contains(served, storedVersion) {
+				incompatible = append(incompatible, fmt.Sprintf("%s/%s", crd.Name, storedVersion))
+			}
+		}
+	}
+
+	if len(incompatible) > 0 {
+		return fmt.Errorf("stored versions of Istio CRDs are not served by the target Istio version: %s", strings.Join(incompatible, ", "))
+	}
+
+	return nil
+}
+
+// bundledIstioCRDsServedVersions returns the served API versions of each CRD bundled with the Istio library.
+func bundledIstioCRDsServedVersions() (map[string][]string, error) {
+	manifest, err := fs.ReadFile(manifests.BuiltinOrDir(""), istioCRDsManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	servedVersions := map[string][]string{}
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		crd := apiextensionsv1.CustomResourceDefinition{}
+		if err := decoder.Decode(&crd); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if crd.Name == "" {
+			continue
+		}
+		for _, version := range crd.Spec.Versions {
+			if version.Served {
+				servedVersions[crd.Name] = append(servedVersions[crd.Name], version.Name)
+			}
+		}
+	}
+
+	return servedVersions, nil
+}
@@ -2,9 +2,12 @@ package istio
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
 
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -12,7 +15,6 @@ import (
 	"github.com/kyma-project/istio/operator/internal/clusterconfig"
 	"github.com/kyma-project/istio/operator/internal/describederrors"
 	"github.com/kyma-project/istio/operator/internal/istiooperator"
-	"github.com/kyma-project/istio/operator/internal/reconciliations/istio/configuration"
 	"github.com/kyma-project/istio/operator/internal/status"
 	"github.com/kyma-project/istio/operator/internal/webhooks"
 	"github.com/kyma-project/istio/operator/pkg/labels"
@@ -28,7 +30,7 @@ type installArgs struct {
 	istioImagesHub      string
 }
 
-//nolint:funlen // Function 'installIstio' has too many statements (51 > 50) TODO: refactor.
+//nolint:funlen // Function 'installIstio' has too many statements (54 > 50) TODO: refactor.
 func installIstio(ctx context.Context, args installArgs) (istiooperator.IstioImageVersion, describederrors.DescribedError) {
 	istioImageVersion := args.istioImageVersion
 	k8sClient := args.client
@@ -40,18 +42,17 @@ func installIstio(ctx context.Context, args installArgs) (istiooperator.IstioIma
 
 	ctrl.Log.Info("Starting Istio install", "istio version", istioImageVersion.Version())
 
-	if _, ok := istioCR.Annotations[labels.LastAppliedConfiguration]; ok {
-		lastAppliedConfig, err := configuration.GetLastAppliedConfiguration(istioCR)
-		if err != nil {
-			ctrl.Log.Error(err, "Error evaluating Istio CR changes")
-			return istioImageVersion, describederrors.NewDescribedError(err, "Istio install check failed")
-		}
+	// The succeeded Event is only emitted if the installation changes the Istio version, so that it is not repeated on every reconciliation.
+	versionChange := isAppliedVersionChange(istioCR, istioImageVersion)
 
-		if err = configuration.CheckIstioVersionUpdate(lastAppliedConfig.IstioTag, istioImageVersion.Tag()); err != nil {
-			statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIstioVersionUpdateNotAllowed))
-			// We are already updating the condition, that's why we need to avoid another condition update by applying SetCondition(false)
-			return istioImageVersion, describederrors.NewDescribedError(err, "Istio version update is not allowed").SetWarning().SetCondition(false)
+	if _, ok := istioCR.Annotations[labels.LastAppliedConfiguration]; ok {
+		if describedErr := checkVersionChange(ctx, k8sClient, istioCR, statusHandler, istioImageVersion); describedErr != nil {
+			return istioImageVersion, describedErr
 		}
+	} else {
+		// Without a last applied configuration Istio was never installed by the module. An upgrade is recorded by checkVersionChange.
+		statusHandler.RecordEvent(istioCR, corev1.EventTypeNormal, operatorv1alpha2.ConditionReasonIstioInstallStarted,
+			fmt.Sprintf("Istio installation with version %s started", istioImageVersion.Version()))
 	}
 
 	if !hasInstallationFinalizer(istioCR) {
@@ -78,9 +79,18 @@ func installIstio(ctx context.Context, args installArgs) (istiooperator.IstioIma
 		return istioImageVersion, describederrors.NewDescribedError(err, "Could not evaluate cluster size")
 	}
 
-	ctrl.Log.Info("Installing Istio with", "profile", clusterSize.String())
+	revs, err := resolveRevisions(ctx, k8sClient, istioCR, istioImageVersion)
+	if err != nil {
+		return istioImageVersion, describederrors.NewDescribedError(err, "Could not determine Istio revision")
+	}
 
-	mergedIstioOperatorPath, err := iopMerger.Merge(clusterSize, istioCR, clusterConfiguration, istioImagesHub)
+	if describedErr := reconcilePluginCA(ctx, k8sClient, istioCR, statusHandler); describedErr != nil {
+		return istioImageVersion, describedErr
+	}
+
+	ctrl.Log.Info("Installing Istio with", "profile", clusterSize.String(), "revision", revs.target)
+
+	mergedIstioOperatorPath, err := iopMerger.Merge(clusterSize, istioCR, clusterConfiguration, istioImagesHub, revs.target)
 	if err != nil {
 		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonCustomResourceMisconfigured))
 		return istioImageVersion, describederrors.NewDescribedError(err, "Could not merge Istio operator configuration").SetCondition(false)
@@ -106,13 +116,42 @@ func installIstio(ctx context.Context, args installArgs) (istiooperator.IstioIma
 		return istioImageVersion, describederrors.NewDescribedError(err, "could not update managed metadata")
 	}
 
-	err = gatherer.VerifyIstioPodsVersion(ctx, k8sClient, istioImageVersion.Version())
+	err = gatherer.VerifyIstioPodsVersion(ctx, k8sClient, istioImageVersion.Version(), revs.target)
 	if err != nil {
 		return istioImageVersion, describederrors.NewDescribedError(err, "Verifying Pod versions in istio-system namespace failed")
 	}
 
+	if describedErr := progressCanaryUpgrade(ctx, args, revs); describedErr != nil {
+		return istioImageVersion, describedErr
+	}
+
+	setProviderServiceAnnotationsCondition(statusHandler, istioCR, clusterConfiguration)
+
 	ctrl.Log.Info("Istio installation succeeded")
 	statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIstioInstallSucceeded))
+	if versionChange {
+		statusHandler.RecordEvent(istioCR, corev1.EventTypeNormal, operatorv1alpha2.ConditionReasonIstioInstallSucceeded,
+			fmt.Sprintf("Istio installation with version %s succeeded", istioImageVersion.Version()))
+	}
 
 	return istioImageVersion, nil
 }
+
+// setProviderServiceAnnotationsCondition reports whether the istio-ingressgateway Service annotations of the Istio CR override the annotations
+// detected for the cluster provider. The annotations of the Istio CR take precedence, because Istio patches them into the rendered Service.
+func setProviderServiceAnnotationsCondition(statusHandler status.Status, istioCR *operatorv1alpha2.Istio, clusterConfiguration clusterconfig.ClusterConfiguration) {
+	components := istioCR.Spec.Components
+	if components == nil || components.IngressGateway == nil || components.IngressGateway.Service == nil || len(components.IngressGateway.Service.Annotations) == 0 {
+		statusHandler.RemoveCondition(istioCR, operatorv1alpha2.ConditionTypeProviderServiceAnnotationsOverridden)
+		return
+	}
+
+	conflicts := clusterConfiguration.IngressGatewayServiceAnnotationConflicts(components.IngressGateway.Service.Annotations)
+	if len(conflicts) > 0 {
+		ctrl.Log.Info("Istio Ingress Gateway Service annotations override annotations detected for the cluster provider", "annotations", conflicts)
+		message := fmt.Sprintf("%s: %s", operatorv1alpha2.ConditionReasonProviderServiceAnnotationsOverriddenMessage, strings.Join(conflicts, ", "))
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonProviderServiceAnnotationsOverridden, message))
+		return
+	}
+	statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonProviderServiceAnnotationsNotOverridden))
+}
@@ -0,0 +1,195 @@
+package istio
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/labels"
+	"github.com/kyma-project/istio/operator/pkg/lib/annotations"
+)
+
+// pluginCASourceAnnotation marks the cacerts Secret managed by the module and holds the Secret it is copied from.
+const pluginCASourceAnnotation = "operator.kyma-project.io/plugin-ca-source"
+
+// reconcilePluginCA copies the plug-in CA Secret referenced in the Istio CR to the cacerts Secret in the istio-system namespace, or deletes
+// the cacerts Secret managed by the module if no plug-in CA is configured. istiod only decides at startup whether it uses the plug-in CA,
+// so it is restarted when the cacerts Secret is created or deleted. Updated certificates are reloaded by istiod without a restart.
+func reconcilePluginCA(ctx context.Context, k8sClient client.Client, istioCR *operatorv1alpha2.Istio, statusHandler status.Status) describederrors.DescribedError {
+	current := corev1.Secret{}
+	err := k8sClient.Get(ctx, types.NamespacedName{Namespace: istioNamespace, Name: cacerts.SecretName}, &current)
+	if client.IgnoreNotFound(err) != nil {
+		return describederrors.NewDescribedError(err, "Could not get plug-in CA Secret")
+	}
+	exists := err == nil
+	managed := exists && current.Annotations[pluginCASourceAnnotation] != ""
+
+	secretRef := istioCR.Spec.Config.PluginCASecret()
+	if secretRef == nil {
+		if !managed {
+			return nil
+		}
+		ctrl.Log.Info("Deleting plug-in CA Secret", "name", cacerts.SecretName)
+		if err := client.IgnoreNotFound(k8sClient.Delete(ctx, &current)); err != nil {
+			return describederrors.NewDescribedError(err, "Could not delete plug-in CA Secret")
+		}
+		return restartIstiod(ctx, k8sClient)
+	}
+
+	if exists && !managed {
+		return pluginCAInvalid(istioCR, statusHandler,
+			fmt.Errorf("secret %s/%s already exists and is not managed by the Istio module", istioNamespace, cacerts.SecretName))
+	}
+
+	source := corev1.Secret{}
+	err = k8sClient.Get(ctx, types.NamespacedName{Namespace: secretRef.Namespace, Name: secretRef.Name}, &source)
+	if k8serrors.IsNotFound(err) {
+		return pluginCAInvalid(istioCR, statusHandler, fmt.Errorf("secret %s/%s not found", secretRef.Namespace, secretRef.Name))
+	}
+	if err != nil {
+		return describederrors.NewDescribedError(err, "Could not get plug-in CA source Secret")
+	}
+
+	if err := cacerts.Validate(source.Data, time.Now()); err != nil {
+		return pluginCAInvalid(istioCR, statusHandler, fmt.Errorf("secret %s/%s: %w", secretRef.Namespace, secretRef.Name, err))
+	}
+
+	sourceName := fmt.Sprintf("%s/%s", secretRef.Namespace, secretRef.Name)
+	data := cacerts.PluginCAData(source.Data)
+
+	if exists {
+		return updatePluginCA(ctx, k8sClient, istioCR, statusHandler, &current, data, sourceName)
+	}
+
+	if err := ensureIstioNamespace(ctx, k8sClient); err != nil {
+		return describederrors.NewDescribedError(err, "Could not create Istio namespace")
+	}
+
+	ctrl.Log.Info("Creating plug-in CA Secret", "source", sourceName)
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cacerts.SecretName,
+			Namespace:   istioNamespace,
+			Labels:      labels.SetModuleLabels(nil),
+			Annotations: map[string]string{pluginCASourceAnnotation: sourceName},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+	if err := k8sClient.Create(ctx, &secret); err != nil {
+		return describederrors.NewDescribedError(err, "Could not create plug-in CA Secret")
+	}
+
+	return restartIstiod(ctx, k8sClient)
+}
+
+// updatePluginCA updates the managed cacerts Secret. If the root CA rotation is enabled and the plug-in CA has a new root CA, the rotation
+// is started instead, and the Secret is updated by the root CA rotation restarter until the rotation is finished.
+func updatePluginCA(ctx context.Context, k8sClient client.Client, istioCR *operatorv1alpha2.Istio, statusHandler status.Status,
+	current *corev1.Secret, data map[string][]byte, sourceName string) describederrors.DescribedError {
+	rotationEnabled := istioCR.Spec.Config.IsRootCARotationEnabled()
+	_, rotationInProgress := current.Annotations[cacerts.RotationPhaseAnnotation]
+	if rotationInProgress && rotationEnabled {
+		return nil
+	}
+
+	if !rotationInProgress && current.Annotations[pluginCASourceAnnotation] == sourceName &&
+		maps.EqualFunc(current.Data, data, func(a, b []byte) bool { return string(a) == string(b) }) {
+		return nil
+	}
+
+	current.Annotations[pluginCASourceAnnotation] = sourceName
+	delete(current.Annotations, cacerts.RotationPhaseAnnotation)
+	delete(current.Annotations, cacerts.RotationPhaseStartedAnnotation)
+
+	if rotationEnabled && cacerts.IsRootChanged(current.Data, data) {
+		rotationData, err := cacerts.RotationData(cacerts.RotationPhaseAddRoot, current.Data, data)
+		if err != nil {
+			return describederrors.NewDescribedError(err, "Could not start root CA rotation")
+		}
+		ctrl.Log.Info("Starting root CA rotation", "source", sourceName)
+		current.Data = rotationData
+		current.Annotations[cacerts.RotationPhaseAnnotation] = string(cacerts.RotationPhaseAddRoot)
+		current.Annotations[cacerts.RotationPhaseStartedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		if err := k8sClient.Update(ctx, current); err != nil {
+			return describederrors.NewDescribedError(err, "Could not start root CA rotation")
+		}
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonRootCARotationInProgress,
+			fmt.Sprintf("Root CA rotation is in phase %s", cacerts.RotationPhaseAddRoot)))
+		return nil
+	}
+
+	ctrl.Log.Info("Updating plug-in CA Secret", "source", sourceName)
+	current.Data = data
+	if err := k8sClient.Update(ctx, current); err != nil {
+		return describederrors.NewDescribedError(err, "Could not update plug-in CA Secret")
+	}
+	return nil
+}
+
+func pluginCAInvalid(istioCR *operatorv1alpha2.Istio, statusHandler status.Status, err error) describederrors.DescribedError {
+	statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonPluginCAInvalid))
+	// We are already updating the condition, that's why we need to avoid another condition update by applying SetCondition(false)
+	return describederrors.NewDescribedError(err, "Plug-in CA certificates cannot be applied").SetWarning().SetCondition(false)
+}
+
+// ensureIstioNamespace creates the istio-system namespace, because the cacerts Secret must exist before istiod is installed.
+func ensureIstioNamespace(ctx context.Context, k8sClient client.Client) error {
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: istioNamespace}}
+	err := k8sClient.Create(ctx, &ns)
+	if k8serrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// restartIstiod restarts the istiod Deployments of all revisions, so that they switch between the self-signed and the plug-in CA. The
+// switch is marked on the istio-system namespace, so that the Istio proxies with certificates of the previous CA are restarted once istiod
+// is rolled out.
+func restartIstiod(ctx context.Context, k8sClient client.Client) describederrors.DescribedError {
+	deployments := appsv1.DeploymentList{}
+	if err := k8sClient.List(ctx, &deployments, client.InNamespace(istioNamespace), client.MatchingLabels{"app": "istiod"}); err != nil {
+		return describederrors.NewDescribedError(err, "Could not list istiod Deployments")
+	}
+	if len(deployments.Items) == 0 {
+		return nil
+	}
+
+	for _, deployment := range deployments.Items {
+		patch := client.StrategicMergeFrom(deployment.DeepCopy())
+		deployment.Spec.Template.Annotations = annotations.AddRestartAnnotation(deployment.Spec.Template.Annotations)
+		if err := k8sClient.Patch(ctx, &deployment, patch); err != nil {
+			return describederrors.NewDescribedError(err, "Could not restart istiod")
+		}
+		ctrl.Log.Info("Restarted istiod to apply the plug-in CA configuration", "name", deployment.Name)
+	}
+
+	ns := corev1.Namespace{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: istioNamespace}, &ns); err != nil {
+		return describederrors.NewDescribedError(err, "Could not mark the CA switch")
+	}
+	patch := client.MergeFrom(ns.DeepCopy())
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	ns.Annotations[cacerts.SwitchStartedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	delete(ns.Annotations, cacerts.SwitchRestartBeforeAnnotation)
+	if err := k8sClient.Patch(ctx, &ns, patch); err != nil {
+		return describederrors.NewDescribedError(err, "Could not mark the CA switch")
+	}
+
+	return nil
+}
@@ -0,0 +1,318 @@
+package istio_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+	"github.com/kyma-project/istio/operator/internal/reconciliations/istio"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/lib/annotations"
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
+)
+
+const pluginCASourceAnnotation = "operator.kyma-project.io/plugin-ca-source"
+
+var _ = Describe("Plug-in CA", func() {
+	newIstioCR := func(secretRef *operatorv1alpha2.CASecretReference) *operatorv1alpha2.Istio {
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default", ResourceVersion: "1"}}
+		if secretRef != nil {
+			istioCR.Spec.Config.CertificateAuthority = &operatorv1alpha2.CertificateAuthority{Secret: secretRef}
+		}
+		return istioCR
+	}
+
+	sourceRef := &operatorv1alpha2.CASecretReference{Name: "my-ca", Namespace: "my-namespace"}
+
+	sourceSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: sourceRef.Name, Namespace: sourceRef.Namespace}, Data: data}
+	}
+
+	managedSecret := func() *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:        cacerts.SecretName,
+			Namespace:   gatherer.IstioNamespace,
+			Annotations: map[string]string{pluginCASourceAnnotation: "my-namespace/my-ca"},
+		}}
+	}
+
+	controlPlaneObjects := func(objects ...client.Object) []client.Object {
+		return append([]client.Object{
+			createNamespace("istio-system"),
+			createPod("istiod", gatherer.IstioNamespace, "discovery", istioVersion, "kyma-project.io/module=istio", "app=istiod"),
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-ingressgateway"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istiod", Labels: map[string]string{"app": "istiod", "istio.io/rev": "default", "operator.istio.io/version": istioVersion}}},
+		}, objects...)
+	}
+
+	reconcile := func(c client.Client, istioCR *operatorv1alpha2.Istio, mockClient *mockLibraryClient) error {
+		installation := istio.Installation{
+			Client:      c,
+			IstioClient: mockClient,
+			Merger:      MergerMock{tag: istioTag},
+		}
+		_, err := installation.Reconcile(context.Background(), istioCR, status.NewStatusHandler(c), "docker.io/istio")
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	istiodRestarted := func(c client.Client) bool {
+		istiod := appsv1.Deployment{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: "istiod"}, &istiod)).To(Succeed())
+		return annotations.HasRestartAnnotation(istiod.Spec.Template.Annotations)
+	}
+
+	caSwitchStarted := func(c client.Client) bool {
+		ns := corev1.Namespace{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: "istio-system"}, &ns)).To(Succeed())
+		_, ok := ns.Annotations[cacerts.SwitchStartedAnnotation]
+		return ok
+	}
+
+	It("should copy the plug-in CA Secret to istio-system and restart istiod", func() {
+		// given
+		istioCR := newIstioCR(sourceRef)
+		data := pluginCAData()
+		c := createFakeClient(append(controlPlaneObjects(sourceSecret(data)), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mockClient.installCalled).To(BeTrue())
+
+		secret := corev1.Secret{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(data))
+		Expect(secret.Annotations).To(HaveKeyWithValue(pluginCASourceAnnotation, "my-namespace/my-ca"))
+		Expect(secret.Labels).To(HaveKeyWithValue("kyma-project.io/module", "istio"))
+		Expect(istiodRestarted(c)).To(BeTrue())
+		Expect(caSwitchStarted(c)).To(BeTrue())
+	})
+
+	It("should update the managed cacerts Secret without restarting istiod", func() {
+		// given
+		istioCR := newIstioCR(sourceRef)
+		data := pluginCAData()
+		c := createFakeClient(append(controlPlaneObjects(sourceSecret(data), managedSecret()), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		secret := corev1.Secret{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(data))
+		Expect(istiodRestarted(c)).To(BeFalse())
+		Expect(caSwitchStarted(c)).To(BeFalse())
+	})
+
+	It("should start the root CA rotation when the plug-in CA has a new root CA and the automatic rotation is enabled", func() {
+		// given
+		istioCR := newIstioCR(sourceRef)
+		istioCR.Spec.Config.RootCA = &operatorv1alpha2.RootCA{AutomaticRotation: true}
+		current := managedSecret()
+		current.Data = pluginCAData()
+		c := createFakeClient(append(controlPlaneObjects(sourceSecret(pluginCAData()), current), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		secret := corev1.Secret{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &secret)).To(Succeed())
+		Expect(secret.Annotations).To(HaveKeyWithValue(cacerts.RotationPhaseAnnotation, string(cacerts.RotationPhaseAddRoot)))
+		Expect(secret.Annotations).To(HaveKey(cacerts.RotationPhaseStartedAnnotation))
+		Expect(secret.Data[cacerts.CACertKey]).To(Equal(current.Data[cacerts.CACertKey]))
+		roots, parseErr := cacerts.ParseCertificates(secret.Data[cacerts.RootCertKey])
+		Expect(parseErr).ShouldNot(HaveOccurred())
+		Expect(roots).To(HaveLen(2))
+		Expect(istiodRestarted(c)).To(BeFalse())
+	})
+
+	It("should not update the cacerts Secret while the root CA rotation is in progress", func() {
+		// given
+		istioCR := newIstioCR(sourceRef)
+		istioCR.Spec.Config.RootCA = &operatorv1alpha2.RootCA{AutomaticRotation: true}
+		current := managedSecret()
+		current.Annotations[cacerts.RotationPhaseAnnotation] = string(cacerts.RotationPhaseSwitchCA)
+		current.Data = pluginCAData()
+		c := createFakeClient(append(controlPlaneObjects(sourceSecret(pluginCAData()), current), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		secret := corev1.Secret{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(current.Data))
+		Expect(secret.Annotations).To(HaveKeyWithValue(cacerts.RotationPhaseAnnotation, string(cacerts.RotationPhaseSwitchCA)))
+	})
+
+	It("should set a warning and not install Istio when the plug-in CA Secret is invalid", func() {
+		// given
+		istioCR := newIstioCR(sourceRef)
+		data := pluginCAData()
+		delete(data, cacerts.CAKeyKey)
+		c := createFakeClient(append(controlPlaneObjects(sourceSecret(data)), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ca-key.pem is missing"))
+		Expect(mockClient.installCalled).To(BeFalse())
+		Expect(istioCR.Status.Conditions).ToNot(BeNil())
+		condition := meta.FindStatusCondition(*istioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeReady))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonPluginCAInvalid)))
+
+		err = c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &corev1.Secret{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should set a warning when the plug-in CA Secret does not exist", func() {
+		// given
+		istioCR := newIstioCR(sourceRef)
+		c := createFakeClient(append(controlPlaneObjects(), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("secret my-namespace/my-ca not found"))
+		Expect(mockClient.installCalled).To(BeFalse())
+	})
+
+	It("should not overwrite a cacerts Secret that is not managed by the module", func() {
+		// given
+		istioCR := newIstioCR(sourceRef)
+		unmanaged := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: cacerts.SecretName, Namespace: gatherer.IstioNamespace},
+			Data:       map[string][]byte{"custom": []byte("value")},
+		}
+		c := createFakeClient(append(controlPlaneObjects(sourceSecret(pluginCAData()), unmanaged), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not managed by the Istio module"))
+
+		secret := corev1.Secret{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(unmanaged.Data))
+	})
+
+	It("should delete the managed cacerts Secret and restart istiod when the plug-in CA is removed", func() {
+		// given
+		istioCR := newIstioCR(nil)
+		c := createFakeClient(append(controlPlaneObjects(managedSecret()), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+		err = c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &corev1.Secret{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		Expect(istiodRestarted(c)).To(BeTrue())
+		Expect(caSwitchStarted(c)).To(BeTrue())
+	})
+
+	It("should keep a cacerts Secret that is not managed by the module when no plug-in CA is configured", func() {
+		// given
+		istioCR := newIstioCR(nil)
+		unmanaged := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: cacerts.SecretName, Namespace: gatherer.IstioNamespace}}
+		c := createFakeClient(append(controlPlaneObjects(unmanaged), istioCR)...)
+		mockClient := &mockLibraryClient{}
+
+		// when
+		err := reconcile(c, istioCR, mockClient)
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &corev1.Secret{})).To(Succeed())
+		Expect(istiodRestarted(c)).To(BeFalse())
+	})
+})
+
+// pluginCAData returns the data of a valid plug-in CA Secret with an intermediate CA signed by a root CA.
+func pluginCAData() map[string][]byte {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	root := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, root, root, &rootKey.PublicKey, rootKey)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, root, &caKey.PublicKey, rootKey)
+	Expect(err).ShouldNot(HaveOccurred())
+	caKeyDER, err := x509.MarshalECPrivateKey(caKey)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	rootPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER})
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})
+	return map[string][]byte{
+		cacerts.CACertKey:    caPEM,
+		cacerts.CAKeyKey:     pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: caKeyDER}),
+		cacerts.RootCertKey:  rootPEM,
+		cacerts.CertChainKey: append(append([]byte{}, caPEM...), rootPEM...),
+	}
+}
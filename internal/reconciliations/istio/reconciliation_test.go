@@ -28,11 +28,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/scheme"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -73,7 +77,8 @@ var _ = Describe("Installation reconciliation", func() {
 			IstioClient: &mockClient,
 			Merger:      MergerMock{tag: istioTag},
 		}
-		statusHandler := status.NewStatusHandler(c)
+		recorder := record.NewFakeRecorder(2)
+		statusHandler := status.NewEventRecordingStatusHandler(c, recorder)
 
 		// when
 		_, err := installation.Reconcile(context.Background(), &istioCR, statusHandler, "docker.io/istio")
@@ -88,6 +93,8 @@ var _ = Describe("Installation reconciliation", func() {
 		Expect((*istioCR.Status.Conditions)[0].Type).To(Equal(string(operatorv1alpha2.ConditionTypeReady)))
 		Expect((*istioCR.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonIstioInstallSucceeded)))
 		Expect((*istioCR.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
+		// The last applied configuration already has the installed version, so no Event is emitted
+		Expect(recorder.Events).To(BeEmpty())
 	})
 
 	It("should install and update Istio CR status when Istio is not installed", func() {
@@ -118,7 +125,8 @@ var _ = Describe("Installation reconciliation", func() {
 			IstioClient: &mockClient,
 			Merger:      MergerMock{tag: istioTag},
 		}
-		statusHandler := status.NewStatusHandler(c)
+		recorder := record.NewFakeRecorder(2)
+		statusHandler := status.NewEventRecordingStatusHandler(c, recorder)
 
 		// when
 		_, err := installation.Reconcile(context.Background(), &istioCR, statusHandler, "docker.io/istio")
@@ -134,6 +142,95 @@ var _ = Describe("Installation reconciliation", func() {
 		Expect((*istioCR.Status.Conditions)[0].Type).To(Equal(string(operatorv1alpha2.ConditionTypeReady)))
 		Expect((*istioCR.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonIstioInstallSucceeded)))
 		Expect((*istioCR.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal IstioInstallStarted Istio installation with version %s started", istioVersion))))
+		Expect(recorder.Events).To(Receive(Equal(fmt.Sprintf("Normal IstioInstallSucceeded Istio installation with version %s succeeded", istioVersion))))
+	})
+
+	It("should set condition when Istio Ingress Gateway Service annotations override annotations detected for the cluster provider", func() {
+		// given
+		istioCR := operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{
+			Name:            "default",
+			ResourceVersion: "1",
+			Annotations:     map[string]string{},
+		},
+			Spec: operatorv1alpha2.IstioSpec{
+				Components: &operatorv1alpha2.Components{
+					IngressGateway: &operatorv1alpha2.IngressGateway{
+						Service: &operatorv1alpha2.IngressGatewayService{
+							Annotations: map[string]string{"loadbalancer.openstack.org/proxy-protocol": "false"},
+						},
+					},
+				},
+			},
+		}
+
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", istioVersion, "kyma-project.io/module=istio")
+		istioNamespace := createNamespace("istio-system")
+		openstackNode := createGardenerOpenStackNode()
+		c := createFakeClient(&istioCR, istiod, istioNamespace, openstackNode)
+		mockClient := mockLibraryClient{}
+		installation := istio.Installation{
+			Client:      c,
+			IstioClient: &mockClient,
+			Merger:      MergerMock{tag: istioTag},
+		}
+		statusHandler := status.NewStatusHandler(c)
+
+		// when
+		_, err := installation.Reconcile(context.Background(), &istioCR, statusHandler, "docker.io/istio")
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mockClient.installCalled).To(BeTrue())
+
+		Expect(*istioCR.Status.Conditions).To(HaveLen(2))
+		condition := meta.FindStatusCondition(*istioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeProviderServiceAnnotationsOverridden))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonProviderServiceAnnotationsOverridden)))
+		Expect(condition.Message).To(ContainSubstring("loadbalancer.openstack.org/proxy-protocol"))
+	})
+
+	It("should set condition when Istio Ingress Gateway Service annotations don't override annotations detected for the cluster provider", func() {
+		// given
+		istioCR := operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{
+			Name:            "default",
+			ResourceVersion: "1",
+			Annotations:     map[string]string{},
+		},
+			Spec: operatorv1alpha2.IstioSpec{
+				Components: &operatorv1alpha2.Components{
+					IngressGateway: &operatorv1alpha2.IngressGateway{
+						Service: &operatorv1alpha2.IngressGatewayService{
+							Annotations: map[string]string{"example.com/team": "networking"},
+						},
+					},
+				},
+			},
+		}
+
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", istioVersion, "kyma-project.io/module=istio")
+		istioNamespace := createNamespace("istio-system")
+		openstackNode := createGardenerOpenStackNode()
+		c := createFakeClient(&istioCR, istiod, istioNamespace, openstackNode)
+		mockClient := mockLibraryClient{}
+		installation := istio.Installation{
+			Client:      c,
+			IstioClient: &mockClient,
+			Merger:      MergerMock{tag: istioTag},
+		}
+		statusHandler := status.NewStatusHandler(c)
+
+		// when
+		_, err := installation.Reconcile(context.Background(), &istioCR, statusHandler, "docker.io/istio")
+
+		// then
+		Expect(err).ShouldNot(HaveOccurred())
+
+		condition := meta.FindStatusCondition(*istioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeProviderServiceAnnotationsOverridden))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(string(operatorv1alpha2.ConditionReasonProviderServiceAnnotationsNotOverridden)))
 	})
 
 	It("should label and annotate istio-system namespace after Istio installation without overriding existing labels and annotations", func() {
@@ -289,7 +386,8 @@ var _ = Describe("Installation reconciliation", func() {
 			IstioClient: &mockClient,
 			Merger:      MergerMock{tag: "1.17.0-distroless"},
 		}
-		statusHandler := status.NewStatusHandler(c)
+		recorder := record.NewFakeRecorder(2)
+		statusHandler := status.NewEventRecordingStatusHandler(c, recorder)
 
 		// when
 		_, err := installation.Reconcile(context.Background(), &istioCR, statusHandler, "docker.io/istio")
@@ -300,6 +398,8 @@ var _ = Describe("Installation reconciliation", func() {
 		Expect(mockClient.uninstallCalled).To(BeFalse())
 		Expect(istioCR.Status.State).To(Equal(operatorv1alpha2.Processing))
 		Expect(istioCR.Status.Conditions).ToNot(BeNil())
+		Expect(recorder.Events).To(Receive(Equal("Normal IstioVersionUpdate Updating Istio from version 1.16.1 to 1.17.0")))
+		Expect(recorder.Events).To(Receive(Equal("Normal IstioInstallSucceeded Istio installation with version 1.17.0 succeeded")))
 	})
 
 	It("should execute install when only Istio image type has changed to debug", func() {
@@ -395,6 +495,114 @@ var _ = Describe("Installation reconciliation", func() {
 		Entry("should return warning and not execute install when new Istio version has a higher major version", "2.0.0-distroless", "target Istio version (2.0.0-distroless) is different than current Istio version (1.16.1-distroless) - major version upgrade is not supported"),
 	)
 
+	Context("approved Istio version change", func() {
+		newApprovedIstioCR := func(approvedVersion string) *operatorv1alpha2.Istio {
+			return &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{
+				Name:            "default",
+				ResourceVersion: "1",
+				Annotations: map[string]string{
+					labels.LastAppliedConfiguration: fmt.Sprintf(`{"IstioTag":"%s"}`, istioTag),
+					labels.AllowVersionChange:       approvedVersion,
+				},
+			}}
+		}
+
+		DescribeTable("should install Istio when the version change is approved",
+			func(targetIstioVersion string) {
+				// given
+				istioCR := newApprovedIstioCR(targetIstioVersion)
+				istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", targetIstioVersion, "kyma-project.io/module=istio")
+				mockClient := mockLibraryClient{}
+				c := createFakeClient(istioCR, istiod, createNamespace("istio-system"),
+					&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "istio-ingressgateway"}})
+				installation := istio.Installation{
+					Client:      c,
+					IstioClient: &mockClient,
+					Merger:      MergerMock{tag: targetIstioVersion + "-distroless"},
+				}
+
+				// when
+				_, err := installation.Reconcile(context.Background(), istioCR, status.NewStatusHandler(c), "docker.io/istio")
+
+				// then
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(mockClient.installCalled).To(BeTrue())
+			},
+			Entry("downgrade", "1.16.0"),
+			Entry("upgrade of more than one minor version", "1.18.0"),
+		)
+
+		It("should not allow the version change when the approved version is different from the target version", func() {
+			// given
+			istioCR := newApprovedIstioCR("1.15.0")
+			istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.0", "kyma-project.io/module=istio")
+			mockClient := mockLibraryClient{}
+			c := createFakeClient(istioCR, istiod)
+			installation := istio.Installation{
+				Client:      c,
+				IstioClient: &mockClient,
+				Merger:      MergerMock{tag: "1.16.0-distroless"},
+			}
+
+			// when
+			_, err := installation.Reconcile(context.Background(), istioCR, status.NewStatusHandler(c), "docker.io/istio")
+
+			// then
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Description()).To(ContainSubstring("Istio version update is not allowed"))
+			Expect(mockClient.installCalled).To(BeFalse())
+			Expect((*istioCR.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonIstioVersionUpdateNotAllowed)))
+		})
+
+		DescribeTable("should not install Istio when a preflight check fails",
+			func(targetIstioVersion string, objects []client.Object, expectedErrorMessage string) {
+				// given
+				istioCR := newApprovedIstioCR(targetIstioVersion)
+				istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", targetIstioVersion, "kyma-project.io/module=istio")
+				mockClient := mockLibraryClient{}
+				c := createFakeClient(append(objects, istioCR, istiod)...)
+				installation := istio.Installation{
+					Client:      c,
+					IstioClient: &mockClient,
+					Merger:      MergerMock{tag: targetIstioVersion + "-distroless"},
+				}
+
+				// when
+				_, err := installation.Reconcile(context.Background(), istioCR, status.NewStatusHandler(c), "docker.io/istio")
+
+				// then
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Description()).To(ContainSubstring("Approved Istio version change is not possible"))
+				Expect(err.Error()).To(ContainSubstring(expectedErrorMessage))
+				Expect(err.Level()).To(Equal(describederrors.Warning))
+				Expect(mockClient.installCalled).To(BeFalse())
+
+				Expect(istioCR.Status.Conditions).ToNot(BeNil())
+				Expect((*istioCR.Status.Conditions)[0].Type).To(Equal(string(operatorv1alpha2.ConditionTypeReady)))
+				Expect((*istioCR.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonIstioVersionChangePreflightFailed)))
+			},
+			Entry("major version change", "2.0.0", []client.Object{}, "major version change is not supported"),
+			Entry("Istio proxies are not restarted with the current version", "1.16.0",
+				[]client.Object{
+					func() client.Object {
+						pod := createPod("app", "default", "istio-proxy", "1.15.0")
+						pod.Annotations = map[string]string{"sidecar.istio.io/status": "{}"}
+						return pod
+					}(),
+				},
+				"1 Istio proxies are not restarted with the current Istio version 1.16.1-distroless"),
+			Entry("stored version of an Istio CRD is not served by the target version", "1.16.0",
+				[]client.Object{
+					&apiextensionsv1.CustomResourceDefinition{
+						ObjectMeta: metav1.ObjectMeta{Name: "virtualservices.networking.istio.io"},
+						Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "networking.istio.io"},
+						Status:     apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1", "v2"}},
+					},
+				},
+				"virtualservices.networking.istio.io/v2"),
+		)
+	})
+
 	It("should fail when istio version is invalid", func() {
 		// given
 		numTrustedProxies := 1
@@ -821,7 +1029,8 @@ var _ = Describe("Installation reconciliation", func() {
 			IstioClient: &mockClient,
 			Merger:      MergerMock{tag: istioTag},
 		}
-		statusHandler := status.NewStatusHandler(c)
+		recorder := record.NewFakeRecorder(1)
+		statusHandler := status.NewEventRecordingStatusHandler(c, recorder)
 
 		// when
 		_, err := installation.Reconcile(context.Background(), &istioCR, statusHandler, "docker.io/istio")
@@ -840,6 +1049,8 @@ var _ = Describe("Installation reconciliation", func() {
 		Expect((*istioCR.Status.Conditions)[0].Type).To(Equal(string(operatorv1alpha2.ConditionTypeReady)))
 		Expect((*istioCR.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonIstioCRsDangling)))
 		Expect((*istioCR.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
+		Expect((*istioCR.Status.Conditions)[0].Message).To(Equal("Istio deletion blocked because of existing Istio custom resources: VirtualService mock-ns/mock-vs"))
+		Expect(recorder.Events).To(Receive(Equal("Warning IstioCustomResourcesDangling Istio deletion blocked because of existing Istio custom resources: VirtualService mock-ns/mock-vs")))
 	})
 
 	It("should have all istio components labeled with kyma-project.io/module=istio label", func() {
@@ -900,6 +1111,9 @@ type mockLibraryClient struct {
 	*istio.Client
 	installError   error
 	uninstallError error
+
+	defaultRevision     string
+	uninstalledRevision []string
 }
 
 func (c *mockLibraryClient) Install(_ string) error {
@@ -912,6 +1126,16 @@ func (c *mockLibraryClient) Uninstall(_ context.Context) error {
 	return c.uninstallError
 }
 
+func (c *mockLibraryClient) SetDefaultRevision(_ context.Context, revision string) error {
+	c.defaultRevision = revision
+	return nil
+}
+
+func (c *mockLibraryClient) UninstallRevision(_ context.Context, revision string) error {
+	c.uninstalledRevision = append(c.uninstalledRevision, revision)
+	return nil
+}
+
 func createFakeClient(objects ...client.Object) client.Client {
 	err := operatorv1alpha2.AddToScheme(scheme.Scheme)
 	Expect(err).ShouldNot(HaveOccurred())
@@ -923,6 +1147,8 @@ func createFakeClient(objects ...client.Object) client.Client {
 	Expect(err).ShouldNot(HaveOccurred())
 	err = networkingv1.AddToScheme(scheme.Scheme)
 	Expect(err).ShouldNot(HaveOccurred())
+	err = admissionv1.AddToScheme(scheme.Scheme)
+	Expect(err).ShouldNot(HaveOccurred())
 
 	return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).WithStatusSubresource(objects...).Build()
 }
@@ -958,6 +1184,20 @@ func createPod(name, namespace, containerName, imageVersion string, labels ...st
 	}
 }
 
+func createGardenerOpenStackNode() *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "openstack-node",
+		},
+		Spec: corev1.NodeSpec{ProviderID: "openstack://example"},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{
+				OSImage: "Garden Linux 1443.3",
+			},
+		},
+	}
+}
+
 func createNamespace(name string) *corev1.Namespace {
 	return &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -978,7 +1218,7 @@ type MergerMock struct {
 	tag                   string
 }
 
-func (m MergerMock) Merge(_ clusterconfig.ClusterSize, _ *operatorv1alpha2.Istio, _ clusterconfig.ClusterConfiguration, _ string) (string, error) {
+func (m MergerMock) Merge(_ clusterconfig.ClusterSize, _ *operatorv1alpha2.Istio, _ clusterconfig.ClusterConfiguration, _ string, _ string) (string, error) {
 	return "mocked istio operator merge result", m.mergeError
 }
 
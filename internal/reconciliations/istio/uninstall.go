@@ -3,6 +3,7 @@ package istio
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/thoas/go-funk"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/kyma-project/istio/operator/internal/status"
 	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/remove"
 
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -48,7 +50,9 @@ func uninstallIstio(ctx context.Context, args uninstallArgs) (istiooperator.Isti
 		funk.ForEach(clientResources, func(a resources.Resource) {
 			ctrl.Log.Info("Customer resource is blocking Istio deletion", a.GVK.Kind, fmt.Sprintf("%s/%s", a.Namespace, a.Name))
 		})
-		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIstioCRsDangling))
+		message := fmt.Sprintf("%s: %s", operatorv1alpha2.ConditionReasonIstioCRsDanglingMessage, listBlockingResources(clientResources))
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIstioCRsDangling, message))
+		statusHandler.RecordEvent(istioCR, corev1.EventTypeWarning, operatorv1alpha2.ConditionReasonIstioCRsDangling, message)
 		return istioImageVersion, describederrors.NewDescribedError(fmt.Errorf("could not delete Istio module instance since there are %d customer resources present", len(clientResources)),
 			"There are Istio resources that block deletion. Please take a look at kyma-system/istio-controller-manager logs to see more information about the warning").
 			DisableErrorWrap().
@@ -74,6 +78,8 @@ func uninstallIstio(ctx context.Context, args uninstallArgs) (istiooperator.Isti
 
 	ctrl.Log.Info("Istio uninstall succeeded")
 	statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIstioUninstallSucceeded))
+	statusHandler.RecordEvent(istioCR, corev1.EventTypeNormal, operatorv1alpha2.ConditionReasonIstioUninstallSucceeded,
+		operatorv1alpha2.ConditionReasonIstioUninstallSucceededMessage)
 
 	if err = removeInstallationFinalizer(ctx, k8sClient, istioCR); err != nil {
 		ctrl.Log.Error(err, "Error happened during istio installation finalizer removal")
@@ -82,3 +88,20 @@ func uninstallIstio(ctx context.Context, args uninstallArgs) (istiooperator.Isti
 
 	return istioImageVersion, nil
 }
+
+// listBlockingResources lists the first customer resources that block the deletion, because the list is shown in the condition and the
+// Event of the Istio CR. All blocking resources are logged.
+func listBlockingResources(clientResources []resources.Resource) string {
+	resourcesLimit := 5
+	blocking := []string{}
+	for _, r := range clientResources {
+		if resourcesLimit--; resourcesLimit >= 0 {
+			blocking = append(blocking, fmt.Sprintf("%s %s/%s", r.GVK.Kind, r.Namespace, r.Name))
+		}
+	}
+	list := strings.Join(blocking, ", ")
+	if len(clientResources)-len(blocking) > 0 {
+		list += fmt.Sprintf(" and %d additional resource(s)", len(clientResources)-len(blocking))
+	}
+	return list
+}
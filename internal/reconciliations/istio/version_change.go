@@ -0,0 +1,104 @@
+package istio
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/istiooperator"
+	"github.com/kyma-project/istio/operator/internal/reconciliations/istio/configuration"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
+)
+
+// checkVersionChange checks if the change from the last applied Istio version to the target Istio version is allowed. A downgrade or an
+// upgrade of more than one minor version is only allowed if it is approved with the allow-version-change annotation and the preflight
+// checks pass.
+func checkVersionChange(ctx context.Context, k8sClient client.Client, istioCR *operatorv1alpha2.Istio, statusHandler status.Status,
+	istioImageVersion istiooperator.IstioImageVersion) describederrors.DescribedError {
+	lastAppliedConfig, err := configuration.GetLastAppliedConfiguration(istioCR)
+	if err != nil {
+		ctrl.Log.Error(err, "Error evaluating Istio CR changes")
+		return describederrors.NewDescribedError(err, "Istio install check failed")
+	}
+
+	updateErr := configuration.CheckIstioVersionUpdate(lastAppliedConfig.IstioTag, istioImageVersion.Tag())
+	if updateErr == nil {
+		recordVersionUpdate(istioCR, statusHandler, lastAppliedConfig.IstioTag, istioImageVersion)
+		return nil
+	}
+
+	if !configuration.IsVersionChangeApproved(istioCR, istioImageVersion.Tag()) {
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIstioVersionUpdateNotAllowed))
+		statusHandler.RecordEvent(istioCR, corev1.EventTypeWarning, operatorv1alpha2.ConditionReasonIstioVersionUpdateNotAllowed, updateErr.Error())
+		// We are already updating the condition, that's why we need to avoid another condition update by applying SetCondition(false)
+		return describederrors.NewDescribedError(updateErr, "Istio version update is not allowed").SetWarning().SetCondition(false)
+	}
+
+	ctrl.Log.Info("Istio version change is approved, running preflight checks", "current tag", lastAppliedConfig.IstioTag, "target tag", istioImageVersion.Tag())
+	if err := versionChangePreflightChecks(ctx, k8sClient, lastAppliedConfig.IstioTag, istioImageVersion.Tag()); err != nil {
+		statusHandler.SetCondition(istioCR, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonIstioVersionChangePreflightFailed))
+		statusHandler.RecordEvent(istioCR, corev1.EventTypeWarning, operatorv1alpha2.ConditionReasonIstioVersionChangePreflightFailed, err.Error())
+		return describederrors.NewDescribedError(err, "Approved Istio version change is not possible").SetWarning().SetCondition(false)
+	}
+
+	recordVersionUpdate(istioCR, statusHandler, lastAppliedConfig.IstioTag, istioImageVersion)
+	return nil
+}
+
+// recordVersionUpdate emits an Event if the installation changes the Istio version. A changed image flavor is not a version change. The
+// Event is emitted until the target version is installed and the last applied configuration is updated.
+func recordVersionUpdate(istioCR *operatorv1alpha2.Istio, statusHandler status.Status, currentIstioTag string,
+	targetIstioImageVersion istiooperator.IstioImageVersion) {
+	if !isVersionChange(currentIstioTag, targetIstioImageVersion) {
+		return
+	}
+	currentIstioImageVersion, _ := istiooperator.NewIstioImageVersionFromTag(currentIstioTag)
+	statusHandler.RecordEvent(istioCR, corev1.EventTypeNormal, operatorv1alpha2.ConditionReasonIstioVersionUpdate,
+		fmt.Sprintf("Updating Istio from version %s to %s", currentIstioImageVersion.Version(), targetIstioImageVersion.Version()))
+}
+
+// isAppliedVersionChange returns true if the target Istio version differs from the version of the last applied configuration. Without
+// a last applied configuration Istio was never installed by the module, so the installation is a version change as well.
+func isAppliedVersionChange(istioCR *operatorv1alpha2.Istio, targetIstioImageVersion istiooperator.IstioImageVersion) bool {
+	lastAppliedConfig, err := configuration.GetLastAppliedConfiguration(istioCR)
+	if err != nil {
+		return false
+	}
+	if lastAppliedConfig.IstioTag == "" {
+		return true
+	}
+	return isVersionChange(lastAppliedConfig.IstioTag, targetIstioImageVersion)
+}
+
+// isVersionChange returns true if the version of the current Istio tag differs from the target version. A changed image flavor is not
+// a version change.
+func isVersionChange(currentIstioTag string, targetIstioImageVersion istiooperator.IstioImageVersion) bool {
+	currentIstioImageVersion, err := istiooperator.NewIstioImageVersionFromTag(currentIstioTag)
+	return err == nil && currentIstioImageVersion.Version() != targetIstioImageVersion.Version()
+}
+
+func versionChangePreflightChecks(ctx context.Context, k8sClient client.Client, currentIstioTag, targetIstioTag string) error {
+	if err := configuration.CheckApprovedIstioVersionChange(currentIstioTag, targetIstioTag); err != nil {
+		return err
+	}
+
+	if err := checkIstioCRDsCompatibility(ctx, k8sClient); err != nil {
+		return err
+	}
+
+	outdatedProxies, err := gatherer.CountOutdatedProxies(ctx, k8sClient, currentIstioTag)
+	if err != nil {
+		return err
+	}
+	if outdatedProxies > 0 {
+		return fmt.Errorf("%d Istio proxies are not restarted with the current Istio version %s", outdatedProxies, currentIstioTag)
+	}
+
+	return nil
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	_ "embed"
 
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
 	"github.com/kyma-project/istio/operator/internal/resources"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 )
 
 //go:embed peer_authentication_mtls.yaml
@@ -16,16 +19,41 @@ var paMtls []byte
 
 type PeerAuthenticationMtls struct {
 	k8sClient client.Client
+	mtls      *v1alpha2.MTLS
 }
 
-func NewPeerAuthenticationMtls(k8sClient client.Client) PeerAuthenticationMtls {
-	return PeerAuthenticationMtls{k8sClient: k8sClient}
+func NewPeerAuthenticationMtls(k8sClient client.Client, mtls *v1alpha2.MTLS) PeerAuthenticationMtls {
+	return PeerAuthenticationMtls{k8sClient: k8sClient, mtls: mtls}
 }
 
-func (PeerAuthenticationMtls) reconcile(ctx context.Context, k8sClient client.Client, _ metav1.OwnerReference, _ map[string]string) (controllerutil.OperationResult, error) {
-	return resources.Apply(ctx, k8sClient, paMtls, nil)
+func (pa PeerAuthenticationMtls) reconcile(ctx context.Context, k8sClient client.Client, _ metav1.OwnerReference, _ map[string]string) (controllerutil.OperationResult, error) {
+	manifest, err := renderPeerAuthentication(paMtls, "", pa.mtls.MeshMode())
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	return resources.Apply(ctx, k8sClient, manifest, nil)
 }
 
 func (PeerAuthenticationMtls) Name() string {
 	return "PeerAuthentication/default"
 }
+
+// renderPeerAuthentication sets the mTLS mode of the PeerAuthentication manifest. If a namespace is given, the namespace of the manifest is replaced.
+func renderPeerAuthentication(manifest []byte, namespace string, mode v1alpha2.MTLSMode) ([]byte, error) {
+	var pa unstructured.Unstructured
+	err := yaml.Unmarshal(manifest, &pa.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace != "" {
+		pa.SetNamespace(namespace)
+	}
+
+	err = unstructured.SetNestedField(pa.Object, string(mode), "spec", "mtls", "mode")
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(pa.Object)
+}
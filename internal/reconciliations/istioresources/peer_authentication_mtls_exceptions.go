@@ -0,0 +1,94 @@
+package istioresources
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/resources"
+	"github.com/kyma-project/istio/operator/pkg/labels"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+//go:embed peer_authentication_mtls_exception.yaml
+var paMtlsException []byte
+
+const mtlsExceptionName = "kyma-mtls"
+
+var peerAuthenticationGVK = schema.GroupVersionKind{Group: "security.istio.io", Version: "v1", Kind: "PeerAuthentication"}
+
+// PeerAuthenticationMtlsExceptions renders a PeerAuthentication for each namespace exception of the mTLS configuration
+// and prunes the PeerAuthentications of namespaces that are no longer listed.
+type PeerAuthenticationMtlsExceptions struct {
+	k8sClient client.Client
+	mtls      *v1alpha2.MTLS
+}
+
+func NewPeerAuthenticationMtlsExceptions(k8sClient client.Client, mtls *v1alpha2.MTLS) PeerAuthenticationMtlsExceptions {
+	return PeerAuthenticationMtlsExceptions{k8sClient: k8sClient, mtls: mtls}
+}
+
+func (pa PeerAuthenticationMtlsExceptions) reconcile(ctx context.Context, k8sClient client.Client, _ metav1.OwnerReference, _ map[string]string) (controllerutil.OperationResult, error) {
+	result := controllerutil.OperationResultNone
+	desiredNamespaces := make(map[string]bool)
+
+	if pa.mtls != nil {
+		for _, exception := range pa.mtls.NamespaceExceptions {
+			var ns corev1.Namespace
+			err := k8sClient.Get(ctx, client.ObjectKey{Name: exception.Namespace}, &ns)
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					ctrl.Log.Info("Skipped mTLS exception, because namespace does not exist", "namespace", exception.Namespace)
+					continue
+				}
+				return controllerutil.OperationResultNone, err
+			}
+			desiredNamespaces[exception.Namespace] = true
+
+			manifest, err := renderPeerAuthentication(paMtlsException, exception.Namespace, exception.Mode)
+			if err != nil {
+				return controllerutil.OperationResultNone, err
+			}
+
+			applyResult, err := resources.Apply(ctx, k8sClient, manifest, nil)
+			if err != nil {
+				return controllerutil.OperationResultNone, err
+			}
+			if applyResult != controllerutil.OperationResultNone {
+				result = applyResult
+			}
+		}
+	}
+
+	var existing unstructured.UnstructuredList
+	existing.SetGroupVersionKind(peerAuthenticationGVK)
+	err := k8sClient.List(ctx, &existing, client.MatchingLabels{labels.ModuleLabelKey: labels.ModuleLabelValue})
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	for _, item := range existing.Items {
+		if item.GetName() != mtlsExceptionName || desiredNamespaces[item.GetNamespace()] {
+			continue
+		}
+		err = k8sClient.Delete(ctx, &item)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+		result = controllerutil.OperationResultUpdated
+	}
+
+	return result, nil
+}
+
+func (PeerAuthenticationMtlsExceptions) Name() string {
+	return "PeerAuthentication/" + mtlsExceptionName
+}
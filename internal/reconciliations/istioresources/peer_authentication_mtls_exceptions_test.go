@@ -0,0 +1,139 @@
+package istioresources
+
+import (
+	"context"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	securityapiv1beta1 "istio.io/api/security/v1beta1"
+	securityv1 "istio.io/client-go/pkg/apis/security/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("PeerAuthenticationMtlsExceptions", func() {
+	templateValues := map[string]string{}
+	owner := metav1.OwnerReference{
+		APIVersion: "operator.kyma-project.io/v1alpha2",
+		Kind:       "Istio",
+		Name:       "owner-name",
+		UID:        "owner-uid",
+	}
+
+	legacy := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "legacy"}}
+	other := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+
+	It("should create a module-owned PeerAuthentication for each namespace exception", func() {
+		//given
+		client := createFakeClient(legacy, other)
+		sample := NewPeerAuthenticationMtlsExceptions(client, &v1alpha2.MTLS{
+			NamespaceExceptions: []v1alpha2.MTLSNamespaceException{
+				{Namespace: "legacy", Mode: v1alpha2.MTLSModePermissive},
+				{Namespace: "other", Mode: v1alpha2.MTLSModeStrict},
+			},
+		})
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultCreated))
+
+		var pa securityv1.PeerAuthentication
+		Expect(client.Get(context.Background(), ctrlclient.ObjectKey{Name: "kyma-mtls", Namespace: "legacy"}, &pa)).Should(Succeed())
+		Expect(pa.Spec.Mtls.Mode).To(Equal(securityapiv1beta1.PeerAuthentication_MutualTLS_PERMISSIVE))
+		Expect(pa.GetLabels()).To(HaveKeyWithValue("kyma-project.io/module", "istio"))
+
+		Expect(client.Get(context.Background(), ctrlclient.ObjectKey{Name: "kyma-mtls", Namespace: "other"}, &pa)).Should(Succeed())
+		Expect(pa.Spec.Mtls.Mode).To(Equal(securityapiv1beta1.PeerAuthentication_MutualTLS_STRICT))
+	})
+
+	It("should return not changed if no change was applied", func() {
+		//given
+		client := createFakeClient(legacy)
+		sample := NewPeerAuthenticationMtlsExceptions(client, &v1alpha2.MTLS{
+			NamespaceExceptions: []v1alpha2.MTLSNamespaceException{
+				{Namespace: "legacy", Mode: v1alpha2.MTLSModePermissive},
+			},
+		})
+		_, err := sample.reconcile(context.Background(), client, owner, templateValues)
+		Expect(err).To(Not(HaveOccurred()))
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultNone))
+	})
+
+	It("should skip namespaces that do not exist", func() {
+		//given
+		client := createFakeClient()
+		sample := NewPeerAuthenticationMtlsExceptions(client, &v1alpha2.MTLS{
+			NamespaceExceptions: []v1alpha2.MTLSNamespaceException{
+				{Namespace: "legacy", Mode: v1alpha2.MTLSModePermissive},
+			},
+		})
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultNone))
+
+		var s securityv1.PeerAuthenticationList
+		Expect(client.List(context.Background(), &s)).Should(Succeed())
+		Expect(s.Items).To(BeEmpty())
+	})
+
+	It("should prune PeerAuthentications of namespaces that are no longer listed", func() {
+		//given
+		client := createFakeClient(legacy, other)
+		sample := NewPeerAuthenticationMtlsExceptions(client, &v1alpha2.MTLS{
+			NamespaceExceptions: []v1alpha2.MTLSNamespaceException{
+				{Namespace: "legacy", Mode: v1alpha2.MTLSModePermissive},
+				{Namespace: "other", Mode: v1alpha2.MTLSModePermissive},
+			},
+		})
+		_, err := sample.reconcile(context.Background(), client, owner, templateValues)
+		Expect(err).To(Not(HaveOccurred()))
+
+		//when
+		changed, err := NewPeerAuthenticationMtlsExceptions(client, &v1alpha2.MTLS{
+			NamespaceExceptions: []v1alpha2.MTLSNamespaceException{
+				{Namespace: "other", Mode: v1alpha2.MTLSModePermissive},
+			},
+		}).reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultUpdated))
+
+		var s securityv1.PeerAuthenticationList
+		Expect(client.List(context.Background(), &s)).Should(Succeed())
+		Expect(s.Items).To(HaveLen(1))
+		Expect(s.Items[0].Namespace).To(Equal("other"))
+	})
+
+	It("should not prune PeerAuthentications created by the user", func() {
+		//given
+		userPa := &securityv1.PeerAuthentication{ObjectMeta: metav1.ObjectMeta{Name: "kyma-mtls", Namespace: "legacy"}}
+		client := createFakeClient(legacy, userPa)
+		sample := NewPeerAuthenticationMtlsExceptions(client, nil)
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultNone))
+		Expect(client.Get(context.Background(), ctrlclient.ObjectKeyFromObject(userPa), &securityv1.PeerAuthentication{})).Should(Succeed())
+	})
+})
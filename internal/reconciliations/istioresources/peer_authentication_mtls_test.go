@@ -3,10 +3,12 @@ package istioresources
 import (
 	"context"
 
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
 	"github.com/kyma-project/istio/operator/internal/resources"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	securityapiv1beta1 "istio.io/api/security/v1beta1"
 	securityv1 "istio.io/client-go/pkg/apis/security/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -24,7 +26,7 @@ var _ = Describe("Apply", func() {
 
 	It("should return created if no resource was present", func() {
 		client := createFakeClient()
-		sample := NewPeerAuthenticationMtls(client)
+		sample := NewPeerAuthenticationMtls(client, nil)
 
 		//when
 		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
@@ -53,7 +55,7 @@ var _ = Describe("Apply", func() {
 
 	It("should return not changed if no change was applied", func() {
 		client := createFakeClient()
-		sample := NewPeerAuthenticationMtls(client)
+		sample := NewPeerAuthenticationMtls(client, nil)
 
 		//when
 		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
@@ -71,7 +73,7 @@ var _ = Describe("Apply", func() {
 
 		// then
 		// we check in the second reconciliation that nothing changed
-		sample = NewPeerAuthenticationMtls(client)
+		sample = NewPeerAuthenticationMtls(client, nil)
 		changed, err = sample.reconcile(context.Background(), client, owner, templateValues)
 		Expect(err).To(Not(HaveOccurred()))
 		Expect(changed).To(Equal(controllerutil.OperationResultNone))
@@ -91,7 +93,7 @@ var _ = Describe("Apply", func() {
 		p.Spec.Mtls.Mode = 0
 		client := createFakeClient(&p)
 
-		sample := NewPeerAuthenticationMtls(client)
+		sample := NewPeerAuthenticationMtls(client, nil)
 
 		//when
 		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
@@ -108,4 +110,24 @@ var _ = Describe("Apply", func() {
 		Expect(s.Items[0].Annotations).To(Not(BeNil()))
 		Expect(s.Items[0].Annotations[resources.DisclaimerKey]).To(Not(BeNil()))
 	})
+
+	It("should apply the mesh-wide mode from the mTLS configuration", func() {
+		//given
+		client := createFakeClient()
+		sample := NewPeerAuthenticationMtls(client, &v1alpha2.MTLS{Mode: v1alpha2.MTLSModePermissive})
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultCreated))
+
+		var s securityv1.PeerAuthenticationList
+		listErr := client.List(context.Background(), &s)
+		Expect(listErr).To(Not(HaveOccurred()))
+		Expect(s.Items).To(HaveLen(1))
+		Expect(s.Items[0].Namespace).To(Equal("istio-system"))
+		Expect(s.Items[0].Spec.Mtls.Mode).To(Equal(securityapiv1beta1.PeerAuthentication_MutualTLS_PERMISSIVE))
+	})
 })
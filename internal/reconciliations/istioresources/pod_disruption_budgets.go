@@ -0,0 +1,130 @@
+package istioresources
+
+import (
+	"context"
+
+	"istio.io/istio/pkg/config/constants"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/clusterconfig"
+	"github.com/kyma-project/istio/operator/pkg/labels"
+)
+
+const (
+	podDisruptionBudgetNamePrefix = "kyma-"
+	podDisruptionBudgetLabelKey   = "operator.kyma-project.io/pod-disruption-budget"
+)
+
+// PodDisruptionBudgets creates a PodDisruptionBudget for each component that has one configured in the Istio CR, if the cluster
+// uses the evaluation profile. The evaluation profile disables the default PodDisruptionBudgets of Istio, because they block
+// node drains for single-replica components, so the module renders the PodDisruptionBudget only for the components that ask for it.
+// In production clusters Istio renders the PodDisruptionBudgets itself and the configuration is merged into the IstioOperator.
+type PodDisruptionBudgets struct {
+	k8sClient   client.Client
+	istioCR     v1alpha2.Istio
+	clusterSize clusterconfig.ClusterSize
+}
+
+func NewPodDisruptionBudgets(k8sClient client.Client, istioCR v1alpha2.Istio, clusterSize clusterconfig.ClusterSize) PodDisruptionBudgets {
+	return PodDisruptionBudgets{k8sClient: k8sClient, istioCR: istioCR, clusterSize: clusterSize}
+}
+
+func (p PodDisruptionBudgets) reconcile(ctx context.Context, k8sClient client.Client, _ metav1.OwnerReference, _ map[string]string) (controllerutil.OperationResult, error) {
+	result := controllerutil.OperationResultNone
+	desired := make(map[string]bool)
+
+	if p.clusterSize == clusterconfig.Evaluation {
+		for _, budget := range p.desiredPodDisruptionBudgets() {
+			desired[budget.Name] = true
+
+			applyResult, err := applyPodDisruptionBudget(ctx, k8sClient, budget)
+			if err != nil {
+				return controllerutil.OperationResultNone, err
+			}
+			if applyResult != controllerutil.OperationResultNone {
+				result = applyResult
+			}
+		}
+	}
+
+	var existing policyv1.PodDisruptionBudgetList
+	err := k8sClient.List(ctx, &existing, client.InNamespace(constants.IstioSystemNamespace), client.HasLabels{podDisruptionBudgetLabelKey})
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	for _, item := range existing.Items {
+		if desired[item.Name] {
+			continue
+		}
+		err = k8sClient.Delete(ctx, &item)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+		result = controllerutil.OperationResultUpdated
+	}
+
+	return result, nil
+}
+
+func (PodDisruptionBudgets) Name() string {
+	return "PodDisruptionBudget/" + podDisruptionBudgetNamePrefix + "*"
+}
+
+// desiredPodDisruptionBudgets returns the PodDisruptionBudgets of the components that have one configured in the Istio CR.
+func (p PodDisruptionBudgets) desiredPodDisruptionBudgets() []policyv1.PodDisruptionBudget {
+	components := p.istioCR.Spec.Components
+	if components == nil {
+		return nil
+	}
+
+	var budgets []policyv1.PodDisruptionBudget
+	if components.Pilot != nil && components.Pilot.K8s != nil && components.Pilot.K8s.PodDisruptionBudget != nil {
+		budgets = append(budgets, newPodDisruptionBudget("istiod", map[string]string{"app": "istiod", "istio": "pilot"}, *components.Pilot.K8s.PodDisruptionBudget))
+	}
+	if components.IngressGateway != nil && components.IngressGateway.K8s != nil && components.IngressGateway.K8s.PodDisruptionBudget != nil {
+		budgets = append(budgets, newPodDisruptionBudget(v1alpha2.DefaultIngressGatewayName,
+			map[string]string{"app": v1alpha2.DefaultIngressGatewayName, "istio": "ingressgateway"}, *components.IngressGateway.K8s.PodDisruptionBudget))
+	}
+	if components.EgressGateway != nil && components.EgressGateway.Enabled != nil && *components.EgressGateway.Enabled &&
+		components.EgressGateway.K8s != nil && components.EgressGateway.K8s.PodDisruptionBudget != nil {
+		budgets = append(budgets, newPodDisruptionBudget("istio-egressgateway",
+			map[string]string{"app": "istio-egressgateway", "istio": "egressgateway"}, *components.EgressGateway.K8s.PodDisruptionBudget))
+	}
+	for _, gateway := range components.AdditionalIngressGateways {
+		if gateway.K8s != nil && gateway.K8s.PodDisruptionBudget != nil {
+			budgets = append(budgets, newPodDisruptionBudget(gateway.Name, gateway.PodLabels(), *gateway.K8s.PodDisruptionBudget))
+		}
+	}
+	return budgets
+}
+
+func newPodDisruptionBudget(component string, selector map[string]string, config v1alpha2.PodDisruptionBudget) policyv1.PodDisruptionBudget {
+	budgetLabels := labels.SetModuleLabels(map[string]string{podDisruptionBudgetLabelKey: component})
+	return policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podDisruptionBudgetNamePrefix + component,
+			Namespace: constants.IstioSystemNamespace,
+			Labels:    budgetLabels,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable:   config.MinAvailable,
+			MaxUnavailable: config.MaxUnavailable,
+			Selector:       &metav1.LabelSelector{MatchLabels: selector},
+		},
+	}
+}
+
+func applyPodDisruptionBudget(ctx context.Context, k8sClient client.Client, desired policyv1.PodDisruptionBudget) (controllerutil.OperationResult, error) {
+	budget := policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+	return controllerutil.CreateOrUpdate(ctx, k8sClient, &budget, func() error {
+		budget.Labels = desired.Labels
+		budget.Spec = desired.Spec
+		return nil
+	})
+}
@@ -0,0 +1,150 @@
+package istioresources
+
+import (
+	"context"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/clusterconfig"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("PodDisruptionBudgets", func() {
+	templateValues := map[string]string{}
+	owner := metav1.OwnerReference{
+		APIVersion: "operator.kyma-project.io/v1alpha2",
+		Kind:       "Istio",
+		Name:       "owner-name",
+		UID:        "owner-uid",
+	}
+
+	minAvailable := intstr.FromInt32(1)
+	istioCRWithIngressGatewayPodDisruptionBudget := func() v1alpha2.Istio {
+		return v1alpha2.Istio{Spec: v1alpha2.IstioSpec{Components: &v1alpha2.Components{
+			IngressGateway: &v1alpha2.IngressGateway{K8s: &v1alpha2.KubernetesResourcesConfig{
+				PodDisruptionBudget: &v1alpha2.PodDisruptionBudget{MinAvailable: &minAvailable},
+			}},
+			Pilot: &v1alpha2.IstioComponent{K8s: &v1alpha2.KubernetesResourcesConfig{
+				NodeSelector: map[string]string{"node-role": "istio"},
+			}},
+		}}}
+	}
+
+	It("should create a PodDisruptionBudget only for the component that has one configured in evaluation clusters", func() {
+		//given
+		client := createFakeClient()
+		sample := NewPodDisruptionBudgets(client, istioCRWithIngressGatewayPodDisruptionBudget(), clusterconfig.Evaluation)
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultCreated))
+
+		var budgets policyv1.PodDisruptionBudgetList
+		Expect(client.List(context.Background(), &budgets)).Should(Succeed())
+		Expect(budgets.Items).To(HaveLen(1))
+
+		budget := budgets.Items[0]
+		Expect(budget.Name).To(Equal("kyma-istio-ingressgateway"))
+		Expect(budget.Namespace).To(Equal("istio-system"))
+		Expect(*budget.Spec.MinAvailable).To(Equal(minAvailable))
+		Expect(budget.Spec.MaxUnavailable).To(BeNil())
+		Expect(budget.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app": "istio-ingressgateway", "istio": "ingressgateway"}))
+		Expect(budget.GetLabels()).To(HaveKeyWithValue("kyma-project.io/module", "istio"))
+	})
+
+	It("should use the Pod labels of an additional ingress gateway as selector", func() {
+		//given
+		client := createFakeClient()
+		istioCR := v1alpha2.Istio{Spec: v1alpha2.IstioSpec{Components: &v1alpha2.Components{
+			AdditionalIngressGateways: []v1alpha2.AdditionalIngressGateway{
+				{
+					Name:   "internal-ingressgateway",
+					Labels: map[string]string{"istio": "internal"},
+					K8s: &v1alpha2.KubernetesResourcesConfig{
+						PodDisruptionBudget: &v1alpha2.PodDisruptionBudget{MinAvailable: &minAvailable},
+					},
+				},
+			},
+		}}}
+		sample := NewPodDisruptionBudgets(client, istioCR, clusterconfig.Evaluation)
+
+		//when
+		_, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+
+		var budget policyv1.PodDisruptionBudget
+		Expect(client.Get(context.Background(), ctrlclient.ObjectKey{Name: "kyma-internal-ingressgateway", Namespace: "istio-system"}, &budget)).Should(Succeed())
+		Expect(budget.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app": "internal-ingressgateway", "istio": "internal"}))
+	})
+
+	It("should return not changed if no change was applied", func() {
+		//given
+		client := createFakeClient()
+		sample := NewPodDisruptionBudgets(client, istioCRWithIngressGatewayPodDisruptionBudget(), clusterconfig.Evaluation)
+		_, err := sample.reconcile(context.Background(), client, owner, templateValues)
+		Expect(err).To(Not(HaveOccurred()))
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultNone))
+	})
+
+	It("should delete the PodDisruptionBudget when it is removed from the Istio CR", func() {
+		//given
+		client := createFakeClient()
+		_, err := NewPodDisruptionBudgets(client, istioCRWithIngressGatewayPodDisruptionBudget(), clusterconfig.Evaluation).
+			reconcile(context.Background(), client, owner, templateValues)
+		Expect(err).To(Not(HaveOccurred()))
+
+		sample := NewPodDisruptionBudgets(client, v1alpha2.Istio{}, clusterconfig.Evaluation)
+
+		//when
+		changed, err := sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+		Expect(changed).To(Equal(controllerutil.OperationResultUpdated))
+
+		var budgets policyv1.PodDisruptionBudgetList
+		Expect(client.List(context.Background(), &budgets)).Should(Succeed())
+		Expect(budgets.Items).To(BeEmpty())
+	})
+
+	It("should not create PodDisruptionBudgets in production clusters and delete the ones of the module", func() {
+		//given
+		istioPodDisruptionBudget := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "istio-ingressgateway", Namespace: "istio-system"},
+		}
+		client := createFakeClient(istioPodDisruptionBudget)
+		_, err := NewPodDisruptionBudgets(client, istioCRWithIngressGatewayPodDisruptionBudget(), clusterconfig.Evaluation).
+			reconcile(context.Background(), client, owner, templateValues)
+		Expect(err).To(Not(HaveOccurred()))
+
+		sample := NewPodDisruptionBudgets(client, istioCRWithIngressGatewayPodDisruptionBudget(), clusterconfig.Production)
+
+		//when
+		_, err = sample.reconcile(context.Background(), client, owner, templateValues)
+
+		//then
+		Expect(err).To(Not(HaveOccurred()))
+
+		var budgets policyv1.PodDisruptionBudgetList
+		Expect(client.List(context.Background(), &budgets)).Should(Succeed())
+		Expect(budgets.Items).To(HaveLen(1))
+		Expect(budgets.Items[0].Name).To(Equal("istio-ingressgateway"))
+	})
+})
@@ -43,7 +43,12 @@ func (r *ResourcesReconciler) Reconcile(ctx context.Context, istioCR v1alpha2.Is
 		return describederrors.NewDescribedError(err, "could not determine cluster provider")
 	}
 
-	resources, err := getResources(r.client, provider)
+	clusterSize, err := clusterconfig.EvaluateClusterSize(ctx, r.client)
+	if err != nil {
+		return describederrors.NewDescribedError(err, "could not evaluate cluster size")
+	}
+
+	resources, err := getResources(r.client, provider, clusterSize, istioCR)
 	if err != nil {
 		ctrl.Log.Error(err, "Failed to initialise Istio resources")
 		return describederrors.NewDescribedError(err, "Istio controller failed to initialise Istio resources")
@@ -72,8 +77,12 @@ func (r *ResourcesReconciler) Reconcile(ctx context.Context, istioCR v1alpha2.Is
 }
 
 // getResources returns all Istio resources required for the reconciliation specific for the given hyperscaler.
-func getResources(k8sClient client.Client, provider string) ([]Resource, error) {
-	istioResources := []Resource{NewPeerAuthenticationMtls(k8sClient)}
+func getResources(k8sClient client.Client, provider string, clusterSize clusterconfig.ClusterSize, istioCR v1alpha2.Istio) ([]Resource, error) {
+	istioResources := []Resource{
+		NewPeerAuthenticationMtls(k8sClient, istioCR.Spec.Config.MTLS),
+		NewPeerAuthenticationMtlsExceptions(k8sClient, istioCR.Spec.Config.MTLS),
+		NewPodDisruptionBudgets(k8sClient, istioCR, clusterSize),
+	}
 
 	switch provider {
 	case clusterconfig.Aws:
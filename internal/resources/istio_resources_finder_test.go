@@ -7,6 +7,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	networkingv1 "istio.io/client-go/pkg/apis/networking/v1"
 	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -20,6 +21,7 @@ var sc *runtime.Scheme
 var _ = Describe("Resources", func() {
 	sc = runtime.NewScheme()
 	Expect(networkingv1alpha3.AddToScheme(sc)).To(Succeed())
+	Expect(networkingv1.AddToScheme(sc)).To(Succeed())
 
 	DescribeTable("FindUserCreatedIstioResourcesDescribe", func(ctx context.Context, logger logr.Logger, client client.Client, configuration resourceFinderConfiguration, want []Resource, wantErr bool) {
 		i := &IstioResourcesFinder{
@@ -100,6 +102,27 @@ var _ = Describe("Resources", func() {
 				},
 			},
 			false,
+		), Entry("should get nothing if the resource is labelled as module-owned", context.Background(),
+			logr.Discard(),
+			fake.NewClientBuilder().WithScheme(sc).WithObjects(&networkingv1alpha3.EnvoyFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "other-system",
+					Name:      "module-owned-filter",
+					Labels:    map[string]string{"kyma-project.io/module": "istio"},
+				},
+			}).Build(),
+			resourceFinderConfiguration{Resources: []ResourceConfiguration{
+				{
+					GroupVersionKind: schema.GroupVersionKind{
+						Group:   "networking.istio.io",
+						Version: "v1alpha3",
+						Kind:    "EnvoyFilter",
+					},
+				},
+			},
+			},
+			nil,
+			false,
 		))
 })
 
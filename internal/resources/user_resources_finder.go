@@ -4,16 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	networkingv1 "istio.io/client-go/pkg/apis/networking/v1"
 	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
 	"github.com/kyma-project/istio/operator/internal/describederrors"
 )
 
 // UserResourcesFinder is an interface that defines methods for detecting user-created resources in a Kubernetes cluster.
 type UserResourcesFinder interface {
-	// DetectUserCreatedEfOnIngress detects user-created EnvoyFilters that target istio-ingress-gateway.
-	DetectUserCreatedEfOnIngress(ctx context.Context) describederrors.DescribedError
+	// DetectUserCreatedEfOnIngress detects user-created EnvoyFilters that target istio-ingress-gateway or any additional ingress gateway of the Istio CR.
+	DetectUserCreatedEfOnIngress(ctx context.Context, istioCR operatorv1alpha2.Istio) describederrors.DescribedError
+	// DetectMissingServiceEntries detects that no ServiceEntry exists in the cluster, so with the REGISTRY_ONLY outbound traffic policy all external traffic is blocked.
+	DetectMissingServiceEntries(ctx context.Context) describederrors.DescribedError
 }
 
 type UserResources struct {
@@ -26,7 +30,7 @@ func NewUserResources(c client.Client) UserResources {
 	}
 }
 
-func (urm UserResources) DetectUserCreatedEfOnIngress(ctx context.Context) describederrors.DescribedError {
+func (urm UserResources) DetectUserCreatedEfOnIngress(ctx context.Context, istioCR operatorv1alpha2.Istio) describederrors.DescribedError {
 	envoyFilterList := networkingv1alpha3.EnvoyFilterList{}
 
 	err := urm.c.List(ctx, &envoyFilterList, client.InNamespace("istio-system"))
@@ -34,7 +38,7 @@ func (urm UserResources) DetectUserCreatedEfOnIngress(ctx context.Context) descr
 		return describederrors.NewDescribedError(err, "could not list EnvoyFilters")
 	}
 	for _, ef := range envoyFilterList.Items {
-		if !isEfOwnedByRateLimit(ef) && !isEfOwnedByKymaModule(ef) && isTargetingIstioIngress(ef) {
+		if !isEfOwnedByRateLimit(ef) && !isEfOwnedByKymaModule(ef) && isTargetingIngressGateway(ef, istioCR) {
 			return describederrors.NewDescribedError(
 				fmt.Errorf(
 					"user-created EnvoyFilter %s/%s targeting Ingress Gateway found",
@@ -48,6 +52,22 @@ func (urm UserResources) DetectUserCreatedEfOnIngress(ctx context.Context) descr
 	return nil
 }
 
+func (urm UserResources) DetectMissingServiceEntries(ctx context.Context) describederrors.DescribedError {
+	serviceEntryList := networkingv1.ServiceEntryList{}
+
+	err := urm.c.List(ctx, &serviceEntryList, client.Limit(1))
+	if err != nil {
+		return describederrors.NewDescribedError(err, "could not list ServiceEntries")
+	}
+	if len(serviceEntryList.Items) == 0 {
+		return describederrors.NewDescribedError(
+			fmt.Errorf("no ServiceEntry found while outbound traffic policy is REGISTRY_ONLY"),
+			"traffic to all external hosts is blocked",
+		).SetWarning()
+	}
+	return nil
+}
+
 func isEfOwnedByRateLimit(ef *networkingv1alpha3.EnvoyFilter) bool {
 	for _, owner := range ef.OwnerReferences {
 		if owner.Kind == "RateLimit" {
@@ -62,13 +82,38 @@ func isEfOwnedByKymaModule(ef *networkingv1alpha3.EnvoyFilter) bool {
 	return ok
 }
 
-func isTargetingIstioIngress(ef *networkingv1alpha3.EnvoyFilter) bool {
-	if ef.Spec.GetWorkloadSelector() == nil {
+func isTargetingIngressGateway(ef *networkingv1alpha3.EnvoyFilter, istioCR operatorv1alpha2.Istio) bool {
+	if ef.Spec.GetWorkloadSelector() == nil || ef.Namespace != "istio-system" {
 		return false
 	}
-	if ef.Namespace == "istio-system" &&
-		(ef.Spec.GetWorkloadSelector().GetLabels()["istio"] == "ingressgateway" || ef.Spec.GetWorkloadSelector().GetLabels()["app"] == "istio-ingressgateway") {
+	if isTargetingIstioIngress(ef) {
 		return true
 	}
+	if istioCR.Spec.Components == nil {
+		return false
+	}
+	for _, gateway := range istioCR.Spec.Components.AdditionalIngressGateways {
+		if isTargetingGatewayPods(ef, gateway.PodLabels()) {
+			return true
+		}
+	}
 	return false
 }
+
+func isTargetingIstioIngress(ef *networkingv1alpha3.EnvoyFilter) bool {
+	return ef.Spec.GetWorkloadSelector().GetLabels()["istio"] == "ingressgateway" || ef.Spec.GetWorkloadSelector().GetLabels()["app"] == "istio-ingressgateway"
+}
+
+// isTargetingGatewayPods returns true if the workload selector of the EnvoyFilter matches the labels of the gateway Pods.
+func isTargetingGatewayPods(ef *networkingv1alpha3.EnvoyFilter, podLabels map[string]string) bool {
+	selector := ef.Spec.GetWorkloadSelector().GetLabels()
+	if len(selector) == 0 {
+		return false
+	}
+	for k, v := range selector {
+		if podLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
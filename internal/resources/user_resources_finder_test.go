@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
 	"github.com/kyma-project/istio/operator/internal/describederrors"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"istio.io/api/networking/v1alpha3"
+	networkingv1 "istio.io/client-go/pkg/apis/networking/v1"
 	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -35,7 +37,7 @@ var _ = Describe("IstioResourceFinder - UserCreated EnvoyFilters", func() {
 
 		urf := NewUserResources(k8sClient)
 
-		err := urf.DetectUserCreatedEfOnIngress(context.Background())
+		err := urf.DetectUserCreatedEfOnIngress(context.Background(), operatorv1alpha2.Istio{})
 
 		Expect(err).To(Not(HaveOccurred()))
 	})
@@ -54,7 +56,7 @@ var _ = Describe("IstioResourceFinder - UserCreated EnvoyFilters", func() {
 
 		urf := NewUserResources(k8sClient)
 
-		err := urf.DetectUserCreatedEfOnIngress(context.Background())
+		err := urf.DetectUserCreatedEfOnIngress(context.Background(), operatorv1alpha2.Istio{})
 		Expect(err).To(HaveOccurred())
 		Expect(err.Level()).To(Equal(describederrors.Warning))
 		Expect(err.Error()).To(Equal(fmt.Sprintf("user-created EnvoyFilter %s/%s targeting Ingress Gateway found", efNamespace, efName)))
@@ -75,7 +77,7 @@ var _ = Describe("IstioResourceFinder - UserCreated EnvoyFilters", func() {
 
 		urf := NewUserResources(k8sClient)
 
-		err := urf.DetectUserCreatedEfOnIngress(context.Background())
+		err := urf.DetectUserCreatedEfOnIngress(context.Background(), operatorv1alpha2.Istio{})
 		Expect(err).To(Not(HaveOccurred()))
 	})
 
@@ -96,7 +98,96 @@ var _ = Describe("IstioResourceFinder - UserCreated EnvoyFilters", func() {
 
 		urf := NewUserResources(k8sClient)
 
-		err := urf.DetectUserCreatedEfOnIngress(context.Background())
+		err := urf.DetectUserCreatedEfOnIngress(context.Background(), operatorv1alpha2.Istio{})
 		Expect(err).To(Not(HaveOccurred()))
 	})
 })
+
+var _ = Describe("IstioResourceFinder - UserCreated EnvoyFilters on additional ingress gateways", func() {
+	istioCR := operatorv1alpha2.Istio{
+		Spec: operatorv1alpha2.IstioSpec{
+			Components: &operatorv1alpha2.Components{
+				AdditionalIngressGateways: []operatorv1alpha2.AdditionalIngressGateway{
+					{Name: "internal-ingressgateway", Labels: map[string]string{"network": "internal"}},
+				},
+			},
+		},
+	}
+
+	It("Should return described error if there is a user-created EnvoyFilter targeting an additional ingress gateway", func() {
+		k8sClient := fake.NewClientBuilder().WithScheme(sc).WithObjects(
+			&networkingv1alpha3.EnvoyFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "istio-system",
+					Name:      "ef-targeting-internal",
+				},
+				Spec: v1alpha3.EnvoyFilter{WorkloadSelector: &v1alpha3.WorkloadSelector{Labels: map[string]string{"network": "internal"}}},
+			}).Build()
+
+		urf := NewUserResources(k8sClient)
+
+		err := urf.DetectUserCreatedEfOnIngress(context.Background(), istioCR)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(err.Error()).To(Equal("user-created EnvoyFilter istio-system/ef-targeting-internal targeting Ingress Gateway found"))
+	})
+
+	It("Should return described error if the EnvoyFilter selects the additional ingress gateway by its default label", func() {
+		k8sClient := fake.NewClientBuilder().WithScheme(sc).WithObjects(
+			&networkingv1alpha3.EnvoyFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "istio-system",
+					Name:      "ef-targeting-internal",
+				},
+				Spec: v1alpha3.EnvoyFilter{WorkloadSelector: &v1alpha3.WorkloadSelector{Labels: map[string]string{"istio": "internal-ingressgateway"}}},
+			}).Build()
+
+		urf := NewUserResources(k8sClient)
+
+		err := urf.DetectUserCreatedEfOnIngress(context.Background(), istioCR)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should return nil if the EnvoyFilter selects other workloads", func() {
+		k8sClient := fake.NewClientBuilder().WithScheme(sc).WithObjects(
+			&networkingv1alpha3.EnvoyFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "istio-system",
+					Name:      "ef-targeting-other",
+				},
+				Spec: v1alpha3.EnvoyFilter{WorkloadSelector: &v1alpha3.WorkloadSelector{Labels: map[string]string{"network": "internal", "app": "other"}}},
+			}).Build()
+
+		urf := NewUserResources(k8sClient)
+
+		err := urf.DetectUserCreatedEfOnIngress(context.Background(), istioCR)
+		Expect(err).To(Not(HaveOccurred()))
+	})
+})
+
+var _ = Describe("UserResources - ServiceEntries", func() {
+	It("Should return nil if there is a ServiceEntry in the cluster", func() {
+		k8sClient := fake.NewClientBuilder().WithScheme(sc).WithObjects(&networkingv1.ServiceEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "external-api",
+			},
+		}).Build()
+
+		urf := NewUserResources(k8sClient)
+
+		err := urf.DetectMissingServiceEntries(context.Background())
+		Expect(err).To(Not(HaveOccurred()))
+	})
+
+	It("Should return a warning if there is no ServiceEntry in the cluster", func() {
+		k8sClient := fake.NewClientBuilder().WithScheme(sc).Build()
+
+		urf := NewUserResources(k8sClient)
+
+		err := urf.DetectMissingServiceEntries(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(err.Description()).To(Equal("traffic to all external hosts is blocked: no ServiceEntry found while outbound traffic policy is REGISTRY_ONLY"))
+	})
+})
@@ -0,0 +1,145 @@
+package restarter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/restarter/predicates"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/pods"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/restart"
+)
+
+const caSwitchErrorDescription = "Error occurred during the restart of Istio proxies after the CA switch"
+
+// CASwitchRestarter restarts the Istio proxies after istiod switched between the self-signed and the plug-in CA. The proxies created
+// before istiod was rolled out with the new CA have certificates of the previous CA and can't establish mTLS connections with new proxies.
+type CASwitchRestarter struct {
+	client         client.Client
+	podsLister     pods.Getter
+	proxyRestarter sidecars.ProxyRestarter
+	statusHandler  status.Status
+}
+
+func NewCASwitchRestarter(client client.Client, podsLister pods.Getter, proxyRestarter sidecars.ProxyRestarter, statusHandler status.Status) *CASwitchRestarter {
+	return &CASwitchRestarter{
+		client:         client,
+		podsLister:     podsLister,
+		proxyRestarter: proxyRestarter,
+		statusHandler:  statusHandler,
+	}
+}
+
+func (r *CASwitchRestarter) Restart(ctx context.Context, istioCR *v1alpha2.Istio) (describederrors.DescribedError, bool) {
+	ns := corev1.Namespace{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: gatherer.IstioNamespace}, &ns); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, false
+		}
+		return describederrors.NewDescribedError(err, caSwitchErrorDescription), false
+	}
+	if _, ok := ns.Annotations[cacerts.SwitchStartedAnnotation]; !ok {
+		return nil, false
+	}
+
+	restartBefore, ok := ns.Annotations[cacerts.SwitchRestartBeforeAnnotation]
+	if !ok {
+		rolledOut, err := r.isIstiodRolledOut(ctx)
+		if err != nil {
+			return describederrors.NewDescribedError(err, caSwitchErrorDescription), false
+		}
+		if !rolledOut {
+			ctrl.Log.Info("Waiting for the rollout of istiod after the CA switch")
+			return nil, true
+		}
+		return r.updateAnnotations(ctx, &ns, func(annotations map[string]string) {
+			annotations[cacerts.SwitchRestartBeforeAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		}, true)
+	}
+
+	before, err := time.Parse(time.RFC3339, restartBefore)
+	if err != nil {
+		return describederrors.NewDescribedError(fmt.Errorf("invalid restart time of CA switch: %w", err), caSwitchErrorDescription), false
+	}
+
+	warnings, restarted, err := restartProxiesCreatedBefore(ctx, r.podsLister, r.proxyRestarter, before)
+	if err != nil {
+		return describederrors.NewDescribedError(err, caSwitchErrorDescription), false
+	}
+	if len(warnings) > 0 {
+		logger := ctrl.Log.WithName("ca-switch")
+		message := fmt.Sprintf("The CA switch waits for the manual restart of workloads. %s", sidecars.BuildWarningMessage(warnings, &logger))
+		r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonProxySidecarManualRestartRequired, message))
+		return describederrors.NewDescribedError(fmt.Errorf("could not restart one or more Istio-injected Pods"),
+			"Some Pods with Istio sidecar injection must be restarted manually to complete the CA switch").SetWarning(), false
+	}
+	if restarted {
+		ctrl.Log.Info("Restarted Istio proxies for the CA switch")
+		return nil, true
+	}
+
+	ctrl.Log.Info("CA switch finished")
+	return r.updateAnnotations(ctx, &ns, func(annotations map[string]string) {
+		delete(annotations, cacerts.SwitchStartedAnnotation)
+		delete(annotations, cacerts.SwitchRestartBeforeAnnotation)
+	}, false)
+}
+
+func (r *CASwitchRestarter) updateAnnotations(ctx context.Context, ns *corev1.Namespace, update func(map[string]string), requeue bool) (describederrors.DescribedError, bool) {
+	patch := client.MergeFrom(ns.DeepCopy())
+	update(ns.Annotations)
+	if err := r.client.Patch(ctx, ns, patch); err != nil {
+		return describederrors.NewDescribedError(err, caSwitchErrorDescription), false
+	}
+	return nil, requeue
+}
+
+// isIstiodRolledOut returns true if all replicas of the istiod Deployments are updated and available.
+func (r *CASwitchRestarter) isIstiodRolledOut(ctx context.Context) (bool, error) {
+	deployments := appsv1.DeploymentList{}
+	if err := r.client.List(ctx, &deployments, client.InNamespace(gatherer.IstioNamespace), client.MatchingLabels{"app": "istiod"}); err != nil {
+		return false, err
+	}
+	for _, deployment := range deployments.Items {
+		replicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		if deployment.Status.ObservedGeneration < deployment.Generation ||
+			deployment.Status.UpdatedReplicas < replicas ||
+			deployment.Status.Replicas > deployment.Status.UpdatedReplicas ||
+			deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// restartProxiesCreatedBefore restarts a batch of the Istio proxies created before the given time. It returns true if any such proxy was
+// found, so the caller has to check for remaining proxies in the next reconciliation.
+func restartProxiesCreatedBefore(ctx context.Context, podsLister pods.Getter, proxyRestarter sidecars.ProxyRestarter, before time.Time) ([]restart.Warning, bool, error) {
+	preds := []predicates.SidecarProxyPredicate{predicates.NewCreatedBeforeRestartPredicate(before)}
+	podsToRestart, err := podsLister.GetPodsToRestart(ctx, preds, pods.NewPodsRestartLimits(1, rootCARotationPodsToList))
+	if err != nil {
+		return nil, false, err
+	}
+	if len(podsToRestart.Items) == 0 {
+		return nil, false, nil
+	}
+
+	limits := pods.NewPodsRestartLimits(rootCARotationPodsToRestart, rootCARotationPodsToList)
+	warnings, _, err := proxyRestarter.RestartWithPredicates(ctx, preds, limits, false)
+	return warnings, true, err
+}
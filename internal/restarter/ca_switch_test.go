@@ -0,0 +1,161 @@
+package restarter_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/restarter"
+	"github.com/kyma-project/istio/operator/internal/restarter/predicates"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/restart"
+)
+
+var _ = Describe("CASwitchRestarter", func() {
+	switchStarted := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	istioNamespace := func(annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "istio-system", Annotations: annotations}}
+	}
+
+	istiod := func(rolledOut bool) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system", Generation: 2, Labels: map[string]string{"app": "istiod"}},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+			Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 1, AvailableReplicas: 2},
+		}
+		if rolledOut {
+			deployment.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}
+		}
+		return deployment
+	}
+
+	getAnnotations := func(c client.Client) map[string]string {
+		ns := corev1.Namespace{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: "istio-system"}, &ns)).To(Succeed())
+		return ns.Annotations
+	}
+
+	It("should do nothing when no CA switch is in progress", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		c := createFakeClient(istioCR, istioNamespace(nil), istiod(true))
+		proxyRestarter := &proxyRestarterMock{}
+		switchRestarter := restarter.NewCASwitchRestarter(c, &podsGetterMock{pods: []corev1.Pod{{}}}, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := switchRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect(proxyRestarter.restartCalled).To(BeFalse())
+	})
+
+	It("should wait for the rollout of istiod", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		c := createFakeClient(istioCR, istioNamespace(map[string]string{cacerts.SwitchStartedAnnotation: switchStarted}), istiod(false))
+		proxyRestarter := &proxyRestarterMock{}
+		switchRestarter := restarter.NewCASwitchRestarter(c, &podsGetterMock{pods: []corev1.Pod{{}}}, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := switchRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+		Expect(proxyRestarter.restartCalled).To(BeFalse())
+		Expect(getAnnotations(c)).ToNot(HaveKey(cacerts.SwitchRestartBeforeAnnotation))
+	})
+
+	It("should record the restart time once istiod is rolled out", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		c := createFakeClient(istioCR, istioNamespace(map[string]string{cacerts.SwitchStartedAnnotation: switchStarted}), istiod(true))
+		proxyRestarter := &proxyRestarterMock{}
+		switchRestarter := restarter.NewCASwitchRestarter(c, &podsGetterMock{pods: []corev1.Pod{{}}}, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := switchRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+		Expect(proxyRestarter.restartCalled).To(BeFalse())
+		Expect(getAnnotations(c)).To(HaveKey(cacerts.SwitchRestartBeforeAnnotation))
+	})
+
+	It("should restart the proxies created before istiod was rolled out", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		c := createFakeClient(istioCR, istioNamespace(map[string]string{
+			cacerts.SwitchStartedAnnotation:       switchStarted,
+			cacerts.SwitchRestartBeforeAnnotation: switchStarted,
+		}), istiod(true))
+		proxyRestarter := &proxyRestarterMock{}
+		podsLister := &podsGetterMock{pods: []corev1.Pod{{}}}
+		switchRestarter := restarter.NewCASwitchRestarter(c, podsLister, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := switchRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+		Expect(proxyRestarter.restartCalled).To(BeTrue())
+		Expect(podsLister.preds).To(HaveLen(1))
+		Expect(podsLister.preds[0]).To(BeAssignableToTypeOf(&predicates.CreatedBeforeRestartPredicate{}))
+		Expect(getAnnotations(c)).To(HaveKey(cacerts.SwitchStartedAnnotation))
+	})
+
+	It("should return a warning when proxies must be restarted manually", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		c := createFakeClient(istioCR, istioNamespace(map[string]string{
+			cacerts.SwitchStartedAnnotation:       switchStarted,
+			cacerts.SwitchRestartBeforeAnnotation: switchStarted,
+		}), istiod(true))
+		proxyRestarter := &proxyRestarterMock{restartWarnings: []restart.Warning{{Name: "pod1", Namespace: "ns1", Kind: "Pod", Message: "pod is not part of a workload"}}}
+		switchRestarter := restarter.NewCASwitchRestarter(c, &podsGetterMock{pods: []corev1.Pod{{}}}, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := switchRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(requeue).To(BeFalse())
+		Expect(getAnnotations(c)).To(HaveKey(cacerts.SwitchStartedAnnotation))
+	})
+
+	It("should finish the CA switch when all proxies are restarted", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		c := createFakeClient(istioCR, istioNamespace(map[string]string{
+			cacerts.SwitchStartedAnnotation:       switchStarted,
+			cacerts.SwitchRestartBeforeAnnotation: switchStarted,
+		}), istiod(true))
+		switchRestarter := restarter.NewCASwitchRestarter(c, &podsGetterMock{}, &proxyRestarterMock{}, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := switchRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect(getAnnotations(c)).ToNot(HaveKey(cacerts.SwitchStartedAnnotation))
+		Expect(getAnnotations(c)).ToNot(HaveKey(cacerts.SwitchRestartBeforeAnnotation))
+	})
+})
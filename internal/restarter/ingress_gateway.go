@@ -2,8 +2,12 @@ package restarter
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,8 +23,7 @@ import (
 )
 
 const (
-	ingressNamespace      string = "istio-system"
-	ingressDeploymentName string = "istio-ingressgateway"
+	ingressNamespace string = "istio-system"
 )
 
 type IngressGatewayRestarter struct {
@@ -41,6 +44,7 @@ func (r *IngressGatewayRestarter) Restart(ctx context.Context, istioCR *v1alpha2
 	ctrl.Log.Info("Restarting Istio Ingress Gateway")
 
 	r.predicates = append(r.predicates, predicates.NewIngressGatewayRestartPredicate(istioCR))
+	var restarted []string
 	for _, predicate := range r.predicates {
 		evaluator, err := predicate.NewIngressGatewayEvaluator(ctx)
 		if err != nil {
@@ -48,24 +52,35 @@ func (r *IngressGatewayRestarter) Restart(ctx context.Context, istioCR *v1alpha2
 		}
 
 		if evaluator.RequiresIngressGatewayRestart() {
-			err = restartIngressGateway(ctx, r.client)
-			if err != nil {
-				r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonIngressGatewayRestartFailed))
-				return describederrors.NewDescribedError(err, "Failed to restart Ingress Gateway"), false
+			for _, name := range istioCR.IngressGatewayNames() {
+				err = restartIngressGateway(ctx, r.client, name)
+				if err != nil {
+					r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonIngressGatewayRestartFailed))
+					r.statusHandler.RecordEvent(istioCR, corev1.EventTypeWarning, v1alpha2.ConditionReasonIngressGatewayRestartFailed,
+						fmt.Sprintf("Failed to restart Istio Ingress Gateway %s: %s", name, err))
+					return describederrors.NewDescribedError(err, "Failed to restart Ingress Gateway"), false
+				}
+				if !slices.Contains(restarted, name) {
+					restarted = append(restarted, name)
+				}
 			}
 		}
 	}
 
 	r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonIngressGatewayRestartSucceeded))
+	if len(restarted) > 0 {
+		r.statusHandler.RecordEvent(istioCR, corev1.EventTypeNormal, v1alpha2.ConditionReasonIngressGatewayRestartSucceeded,
+			fmt.Sprintf("Restarted Istio Ingress Gateway %s", strings.Join(restarted, ", ")))
+	}
 	ctrl.Log.Info("Successfully restarted Istio Ingress Gateway")
 	return nil, false
 }
 
-func restartIngressGateway(ctx context.Context, k8sClient client.Client) error {
-	ctrl.Log.Info("Restarting istio-ingressgateway")
+func restartIngressGateway(ctx context.Context, k8sClient client.Client, name string) error {
+	ctrl.Log.Info("Restarting ingress gateway", "name", name)
 
 	deployment := appsv1.Deployment{}
-	err := k8sClient.Get(ctx, types.NamespacedName{Namespace: ingressNamespace, Name: ingressDeploymentName}, &deployment)
+	err := k8sClient.Get(ctx, types.NamespacedName{Namespace: ingressNamespace, Name: name}, &deployment)
 	if err != nil {
 		// If ingress gateway deployment is missing, we should not fail, as it may have not yet been created
 		// In that case, the upcoming creation of the deployment will do the same thing as we would require from the restart
@@ -89,7 +104,7 @@ func restartIngressGateway(ctx context.Context, k8sClient client.Client) error {
 	if err != nil {
 		return err
 	}
-	ctrl.Log.Info("istio-ingressgateway restarted")
+	ctrl.Log.Info("Ingress gateway restarted", "name", name)
 
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -53,6 +54,48 @@ var _ = Describe("Istio Ingress Gateway restart", func() {
 		Expect((*istioCR.Status.Conditions)[0].Message).Should(Equal(operatorv1alpha2.ConditionReasonIngressGatewayRestartSucceededMessage))
 	})
 
+	It("should restart additional ingress gateways when predicate requires restart", func() {
+		// given
+		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{
+			Name:            "default",
+			ResourceVersion: "1",
+			Annotations:     map[string]string{},
+		},
+			Spec: operatorv1alpha2.IstioSpec{
+				Components: &operatorv1alpha2.Components{
+					AdditionalIngressGateways: []operatorv1alpha2.AdditionalIngressGateway{
+						{Name: "internal-ingressgateway"},
+					},
+				},
+			},
+		}
+
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1")
+		igDep := createIngressGatewayDep(time.Now().Add(-time.Hour))
+		internalIgDep := createIngressGatewayDep(time.Now().Add(-time.Hour))
+		internalIgDep.Name = "internal-ingressgateway"
+		fakeClient := createFakeClient(istioCR, istiod, igDep, internalIgDep)
+		recorder := record.NewFakeRecorder(1)
+		statusHandler := status.NewEventRecordingStatusHandler(fakeClient, recorder)
+		igRestarter := restarter.NewIngressGatewayRestarter(fakeClient, []predicates.IngressGatewayPredicate{mockIgPredicate{shouldRestart: true}}, statusHandler)
+
+		//when
+		err, requeue := igRestarter.Restart(context.Background(), istioCR)
+
+		//then
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(requeue).To(BeFalse())
+
+		for _, name := range []string{"istio-ingressgateway", "internal-ingressgateway"} {
+			dep := appsv1.Deployment{}
+			e := fakeClient.Get(context.Background(), client.ObjectKey{Namespace: gatherer.IstioNamespace, Name: name}, &dep)
+			Expect(e).Should(Not(HaveOccurred()))
+			Expect(annotations.HasRestartAnnotation(dep.Spec.Template.Annotations)).To(BeTrue())
+		}
+		Expect((*istioCR.Status.Conditions)[0].Reason).Should(Equal(string(operatorv1alpha2.ConditionReasonIngressGatewayRestartSucceeded)))
+		Expect(recorder.Events).To(Receive(Equal("Normal IngressGatewayRestartSucceeded Restarted Istio Ingress Gateway istio-ingressgateway, internal-ingressgateway")))
+	})
+
 	It("does not restart ingress gateway when predicate does not require it", func() {
 		// given
 		istioCR := &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{
@@ -69,7 +112,8 @@ var _ = Describe("Istio Ingress Gateway restart", func() {
 		igDep := createIngressGatewayDep(time.Now())
 		igPod := createIgPodWithCreationTimestamp("istio-ingressgateway", gatherer.IstioNamespace, "discovery", "1.16.1", time.Now())
 		fakeClient := createFakeClient(istioCR, istiod, igDep, igPod)
-		statusHandler := status.NewStatusHandler(fakeClient)
+		recorder := record.NewFakeRecorder(1)
+		statusHandler := status.NewEventRecordingStatusHandler(fakeClient, recorder)
 		igRestarter := restarter.NewIngressGatewayRestarter(fakeClient, []predicates.IngressGatewayPredicate{mockIgPredicate{shouldRestart: false}}, statusHandler)
 
 		//when
@@ -85,6 +129,7 @@ var _ = Describe("Istio Ingress Gateway restart", func() {
 		Expect(annotations.HasRestartAnnotation(igDep.Spec.Template.Annotations)).To(BeFalse())
 		Expect((*istioCR.Status.Conditions)[0].Reason).Should(Equal(string(operatorv1alpha2.ConditionReasonIngressGatewayRestartSucceeded)))
 		Expect((*istioCR.Status.Conditions)[0].Message).Should(Equal(operatorv1alpha2.ConditionReasonIngressGatewayRestartSucceededMessage))
+		Expect(recorder.Events).To(BeEmpty())
 	})
 
 	It("should not fail ingress gateway restarting when there is no ingress gateway pods found", func() {
@@ -0,0 +1,39 @@
+package predicates
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+// AmbientNamespaceRestartPredicate excludes pods that are part of the ambient mesh from the sidecar restart, because
+// their traffic is handled by ztunnel instead of sidecar proxies.
+type AmbientNamespaceRestartPredicate struct {
+	ambientNamespaces map[string]bool
+}
+
+func NewAmbientNamespaceRestartPredicate(ctx context.Context, c client.Client) (*AmbientNamespaceRestartPredicate, error) {
+	namespaces := &v1.NamespaceList{}
+	err := c.List(ctx, namespaces, client.MatchingLabels{v1alpha2.DataPlaneModeLabel: string(v1alpha2.DataPlaneModeAmbient)})
+	if err != nil {
+		return nil, err
+	}
+
+	ambientNamespaces := make(map[string]bool, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		ambientNamespaces[ns.Name] = true
+	}
+
+	return &AmbientNamespaceRestartPredicate{ambientNamespaces: ambientNamespaces}, nil
+}
+
+func (p AmbientNamespaceRestartPredicate) Matches(pod v1.Pod) bool {
+	return !p.ambientNamespaces[pod.Namespace]
+}
+
+func (p AmbientNamespaceRestartPredicate) MustMatch() bool {
+	return true
+}
@@ -0,0 +1,39 @@
+package predicates
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ambient Namespace Predicate", func() {
+	c := makeClientWithObjects(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "ambient-ns",
+			Labels: map[string]string{"istio.io/dataplane-mode": "ambient"},
+		}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "sidecar-ns"}},
+	)
+
+	It("should evaluate to false for pods in namespaces labeled with ambient data plane mode", func() {
+		predicate, err := NewAmbientNamespaceRestartPredicate(context.Background(), c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predicate.Matches(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ambient-ns"}})).To(BeFalse())
+	})
+
+	It("should evaluate to true for pods in namespaces without ambient data plane mode", func() {
+		predicate, err := NewAmbientNamespaceRestartPredicate(context.Background(), c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predicate.Matches(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "sidecar-ns"}})).To(BeTrue())
+	})
+
+	It("should be a required predicate", func() {
+		predicate, err := NewAmbientNamespaceRestartPredicate(context.Background(), c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predicate.MustMatch()).To(BeTrue())
+	})
+})
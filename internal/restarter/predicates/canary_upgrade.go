@@ -0,0 +1,58 @@
+package predicates
+
+import (
+	"context"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/internal/webhooks"
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
+)
+
+// CanaryUpgradeRestartPredicate excludes pods in namespaces that are still injected by a previous Istio revision during a canary upgrade.
+// Restarting these pods would inject the sidecar proxy of the previous revision again, so they are restarted only after their
+// namespace is moved to the new revision.
+type CanaryUpgradeRestartPredicate struct {
+	pendingNamespaces map[string]bool
+}
+
+func NewCanaryUpgradeRestartPredicate(ctx context.Context, c client.Client, targetRevision string) (*CanaryUpgradeRestartPredicate, error) {
+	previousRevisions, err := gatherer.ListPreviousIstioRevisions(ctx, c, targetRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingNamespaces := make(map[string]bool)
+	if len(previousRevisions) == 0 {
+		return &CanaryUpgradeRestartPredicate{pendingNamespaces: pendingNamespaces}, nil
+	}
+
+	tags, err := webhooks.GetRevisionTags(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := &v1.NamespaceList{}
+	err = c.List(ctx, namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ns := range namespaces.Items {
+		if slices.Contains(previousRevisions, webhooks.InjectionRevision(ns, tags)) {
+			pendingNamespaces[ns.Name] = true
+		}
+	}
+
+	return &CanaryUpgradeRestartPredicate{pendingNamespaces: pendingNamespaces}, nil
+}
+
+func (p CanaryUpgradeRestartPredicate) Matches(pod v1.Pod) bool {
+	return !p.pendingNamespaces[pod.Namespace]
+}
+
+func (p CanaryUpgradeRestartPredicate) MustMatch() bool {
+	return true
+}
@@ -0,0 +1,70 @@
+package predicates
+
+import (
+	"context"
+
+	v1admission "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Canary Upgrade Predicate", func() {
+	istiod := func(name, revision, version string) *appsv1.Deployment {
+		return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "istio-system",
+			Labels:    map[string]string{"app": "istiod", "istio.io/rev": revision, "operator.istio.io/version": version},
+		}}
+	}
+	defaultTag := &v1admission.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{
+		Name:   "istio-revision-tag-default",
+		Labels: map[string]string{"istio.io/tag": "default", "istio.io/rev": "default"},
+	}}
+	namespaces := []*v1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "not-migrated", Labels: map[string]string{"istio-injection": "enabled"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "migrated", Labels: map[string]string{"istio.io/rev": "1-27-1"}}},
+	}
+
+	It("should evaluate to false for pods in namespaces injected by a previous revision", func() {
+		// given
+		c := makeClientWithObjects(istiod("istiod", "default", "1.26.3"), istiod("istiod-1-27-1", "1-27-1", "1.27.1"), defaultTag, namespaces[0], namespaces[1])
+
+		// when
+		predicate, err := NewCanaryUpgradeRestartPredicate(context.Background(), c, "1-27-1")
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predicate.Matches(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "not-migrated"}})).To(BeFalse())
+		Expect(predicate.Matches(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "migrated"}})).To(BeTrue())
+	})
+
+	It("should evaluate to false for pods in namespaces injected by the previous revision during a canary downgrade", func() {
+		// given
+		c := makeClientWithObjects(istiod("istiod", "default", "1.28.0"), istiod("istiod-1-27-1", "1-27-1", "1.27.1"), defaultTag, namespaces[0], namespaces[1])
+
+		// when
+		predicate, err := NewCanaryUpgradeRestartPredicate(context.Background(), c, "1-27-1")
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predicate.Matches(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "not-migrated"}})).To(BeFalse())
+		Expect(predicate.Matches(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "migrated"}})).To(BeTrue())
+	})
+
+	It("should evaluate to true for all pods when no canary upgrade is in progress", func() {
+		// given
+		c := makeClientWithObjects(istiod("istiod", "default", "1.27.1"), defaultTag, namespaces[0], namespaces[1])
+
+		// when
+		predicate, err := NewCanaryUpgradeRestartPredicate(context.Background(), c, "default")
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(predicate.Matches(v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "not-migrated"}})).To(BeTrue())
+		Expect(predicate.MustMatch()).To(BeTrue())
+	})
+})
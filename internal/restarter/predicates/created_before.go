@@ -0,0 +1,25 @@
+package predicates
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// CreatedBeforeRestartPredicate restarts pods created before the given time, so that their proxies pick up a mesh-wide change
+// that is not reflected in the pod spec, for example a new root CA.
+type CreatedBeforeRestartPredicate struct {
+	time time.Time
+}
+
+func NewCreatedBeforeRestartPredicate(t time.Time) *CreatedBeforeRestartPredicate {
+	return &CreatedBeforeRestartPredicate{time: t}
+}
+
+func (p CreatedBeforeRestartPredicate) Matches(pod v1.Pod) bool {
+	return pod.CreationTimestamp.Time.Before(p.time)
+}
+
+func (p CreatedBeforeRestartPredicate) MustMatch() bool {
+	return false
+}
@@ -0,0 +1,45 @@
+package predicates
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Created Before Predicate", func() {
+	rotationStarted := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	podCreatedAt := func(t time.Time) v1.Pod {
+		return v1.Pod{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(t)}}
+	}
+
+	It("should match a pod created before the given time", func() {
+		// given
+		predicate := NewCreatedBeforeRestartPredicate(rotationStarted)
+
+		// when
+		matched := predicate.Matches(podCreatedAt(rotationStarted.Add(-time.Minute)))
+
+		// then
+		Expect(matched).To(BeTrue())
+	})
+
+	It("should not match a pod created after the given time", func() {
+		// given
+		predicate := NewCreatedBeforeRestartPredicate(rotationStarted)
+
+		// when
+		matched := predicate.Matches(podCreatedAt(rotationStarted.Add(time.Minute)))
+
+		// then
+		Expect(matched).To(BeFalse())
+	})
+
+	It("should not be required to match", func() {
+		Expect(NewCreatedBeforeRestartPredicate(rotationStarted).MustMatch()).To(BeFalse())
+	})
+})
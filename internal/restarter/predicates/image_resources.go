@@ -3,6 +3,7 @@ package predicates
 import (
 	"fmt"
 
+	"istio.io/api/annotation"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -59,7 +60,7 @@ func IsReadyWithIstioAnnotation(pod v1.Pod) bool {
 }
 
 func HasIstioSidecarStatusAnnotation(pod v1.Pod) bool {
-	_, exists := pod.Annotations["sidecar.istio.io/status"]
+	_, exists := pod.Annotations[annotation.SidecarStatus.Name]
 	return exists
 }
 
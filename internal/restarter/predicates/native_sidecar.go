@@ -0,0 +1,65 @@
+package predicates
+
+import (
+	"strconv"
+
+	"istio.io/api/annotation"
+	v1 "k8s.io/api/core/v1"
+)
+
+// NativeSidecarRestartPredicate restarts pods whose istio-proxy placement does not match the configured sidecar mode.
+// A native sidecar is injected as an init container and a regular sidecar as a container.
+type NativeSidecarRestartPredicate struct {
+	nativeSidecar bool
+}
+
+// NewNativeSidecarRestartPredicate creates the predicate for the expected sidecar mode, which is read from the merged IstioOperator,
+// so that the default of the module version is used if the Istio CR does not configure it.
+func NewNativeSidecarRestartPredicate(nativeSidecar bool) *NativeSidecarRestartPredicate {
+	return &NativeSidecarRestartPredicate{nativeSidecar: nativeSidecar}
+}
+
+func (p NativeSidecarRestartPredicate) Matches(pod v1.Pod) bool {
+	injectedAsNative, injected := isSidecarInjectedAsNative(pod)
+	if !injected {
+		return false
+	}
+
+	return injectedAsNative != p.expectedNativeSidecar(pod)
+}
+
+func (p NativeSidecarRestartPredicate) MustMatch() bool {
+	return false
+}
+
+// expectedNativeSidecar returns the sidecar mode of the pod, which can be overridden with the sidecar.istio.io/nativeSidecar annotation.
+func (p NativeSidecarRestartPredicate) expectedNativeSidecar(pod v1.Pod) bool {
+	value, found := pod.Annotations[annotation.SidecarNativeSidecar.Name]
+	if !found {
+		return p.nativeSidecar
+	}
+
+	nativeSidecar, err := strconv.ParseBool(value)
+	if err != nil {
+		return p.nativeSidecar
+	}
+
+	return nativeSidecar
+}
+
+// isSidecarInjectedAsNative returns whether the istio-proxy is injected as an init container and whether it is injected at all.
+func isSidecarInjectedAsNative(pod v1.Pod) (bool, bool) {
+	for _, container := range pod.Spec.InitContainers {
+		if isContainerIstioSidecar(container) {
+			return true, true
+		}
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if isContainerIstioSidecar(container) {
+			return false, true
+		}
+	}
+
+	return false, false
+}
@@ -0,0 +1,68 @@
+package predicates
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Native Sidecar Predicate", func() {
+	regularSidecarPod := func(annotations map[string]string) v1.Pod {
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec: v1.PodSpec{
+				InitContainers: []v1.Container{{Name: "istio-validation"}},
+				Containers:     []v1.Container{{Name: "app"}, {Name: "istio-proxy"}},
+			},
+		}
+	}
+
+	nativeSidecarPod := func(annotations map[string]string) v1.Pod {
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec: v1.PodSpec{
+				InitContainers: []v1.Container{{Name: "istio-validation"}, {Name: "istio-proxy"}},
+				Containers:     []v1.Container{{Name: "app"}},
+			},
+		}
+	}
+
+	Context("Matches", func() {
+		It("should evaluate to true when native sidecars are enabled and the pod has a regular sidecar", func() {
+			predicate := NewNativeSidecarRestartPredicate(true)
+			Expect(predicate.Matches(regularSidecarPod(nil))).To(BeTrue())
+		})
+
+		It("should evaluate to false when native sidecars are enabled and the pod has a native sidecar", func() {
+			predicate := NewNativeSidecarRestartPredicate(true)
+			Expect(predicate.Matches(nativeSidecarPod(nil))).To(BeFalse())
+		})
+
+		It("should evaluate to true when native sidecars are disabled and the pod has a native sidecar", func() {
+			predicate := NewNativeSidecarRestartPredicate(false)
+			Expect(predicate.Matches(nativeSidecarPod(nil))).To(BeTrue())
+		})
+
+		It("should evaluate to false when native sidecars are disabled and the pod has a regular sidecar", func() {
+			predicate := NewNativeSidecarRestartPredicate(false)
+			Expect(predicate.Matches(regularSidecarPod(nil))).To(BeFalse())
+		})
+
+		It("should evaluate to false when the sidecar placement matches the nativeSidecar annotation of the pod", func() {
+			predicate := NewNativeSidecarRestartPredicate(true)
+			Expect(predicate.Matches(regularSidecarPod(map[string]string{"sidecar.istio.io/nativeSidecar": "false"}))).To(BeFalse())
+		})
+
+		It("should evaluate to true when the sidecar placement does not match the nativeSidecar annotation of the pod", func() {
+			predicate := NewNativeSidecarRestartPredicate(false)
+			Expect(predicate.Matches(regularSidecarPod(map[string]string{"sidecar.istio.io/nativeSidecar": "true"}))).To(BeTrue())
+		})
+
+		It("should evaluate to false when the pod has no sidecar", func() {
+			predicate := NewNativeSidecarRestartPredicate(true)
+			Expect(predicate.Matches(v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "app"}}}})).To(BeFalse())
+		})
+	})
+})
@@ -17,6 +17,6 @@ type IngressGatewayPredicate interface {
 
 type IngressGatewayRestartEvaluator interface {
 	// The RequiresIngressGatewayRestart method does not evaluate the restart per pod,
-	// as there is only one Ingress Gateway deployment under Istio module control.
+	// as the Ingress Gateway deployments under Istio module control are always restarted together.
 	RequiresIngressGatewayRestart() bool
 }
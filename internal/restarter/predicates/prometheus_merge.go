@@ -2,15 +2,18 @@ package predicates
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
 
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
 	"istio.io/istio/pkg/config/mesh"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/webhooks"
 )
 
 const defaultStatusPort int32 = 15020
@@ -55,31 +58,48 @@ func (p PrometheusMergeRestartPredicate) MustMatch() bool {
 // Gets statusPort directly from already merged IstioOperator CR, for now it is 15020 by default and not configurable,
 // but once it is configurable, it will fetch the configured statusPort from the CR directly.
 func getStatusPort(ctx context.Context, client client.Client) int32 {
+	meshConfig, err := getMeshConfig(ctx, client)
+	if err != nil {
+		return defaultStatusPort
+	}
+
+	if meshConfig.GetDefaultConfig().GetStatusPort() == 0 {
+		return defaultStatusPort
+	}
+
+	return meshConfig.GetDefaultConfig().GetStatusPort()
+}
+
+// getMeshConfig reads the mesh configuration applied by Istio from the istio ConfigMap and fills in Istio's defaults.
+func getMeshConfig(ctx context.Context, client client.Client) (*meshv1alpha1.MeshConfig, error) {
 	istioConfigMap := &v1.ConfigMap{}
 
-	var err = client.Get(ctx, types.NamespacedName{Namespace: "istio-system", Name: "istio"}, istioConfigMap)
+	revision, err := webhooks.GetDefaultRevision(ctx, client)
 	if err != nil {
-		return defaultStatusPort
+		return nil, err
+	}
+
+	err = client.Get(ctx, types.NamespacedName{Namespace: "istio-system", Name: meshConfigMapName(revision)}, istioConfigMap)
+	if err != nil {
+		return nil, err
 	}
 
 	meshConfigYAML, hasMesh := istioConfigMap.Data["mesh"]
 	if !hasMesh {
-		return defaultStatusPort
+		return nil, errors.New("istio ConfigMap does not contain mesh configuration")
 	}
 
 	// Clean up the YAML string - remove any leading indicators like "|-"
 	meshConfigYAML = strings.TrimPrefix(meshConfigYAML, "|-")
 	meshConfigYAML = strings.TrimSpace(meshConfigYAML)
 
-	meshConfig, err := mesh.ApplyMeshConfigDefaults(meshConfigYAML)
-
-	if err != nil {
-		return defaultStatusPort
-	}
+	return mesh.ApplyMeshConfigDefaults(meshConfigYAML)
+}
 
-	if meshConfig.GetDefaultConfig().GetStatusPort() == 0 {
-		return defaultStatusPort
+// meshConfigMapName returns the name of the mesh ConfigMap of the revision, which Istio suffixes with the revision for non-default revisions.
+func meshConfigMapName(revision string) string {
+	if revision == "" || revision == v1alpha2.DefaultRevision {
+		return "istio"
 	}
-
-	return meshConfig.GetDefaultConfig().GetStatusPort()
+	return "istio-" + revision
 }
@@ -5,6 +5,7 @@ import (
 	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -166,6 +167,7 @@ var _ = Describe("Prometheus Merge Predicate", func() {
 })
 
 func makeClientWithObjects(objects ...client.Object) client.Client {
+	Expect(networkingv1beta1.AddToScheme(scheme.Scheme)).To(Succeed())
 
 	return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
 }
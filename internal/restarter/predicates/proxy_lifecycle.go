@@ -0,0 +1,68 @@
+package predicates
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pkg/config/mesh"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+// ProxyLifecycleRestartPredicate restarts pods whose injected proxy configuration does not match the proxy lifecycle
+// settings of the mesh configuration, such as holdApplicationUntilProxyStarts, terminationDrainDuration, concurrency
+// and the EXIT_ON_ZERO_ACTIVE_CONNECTIONS proxy metadata.
+type ProxyLifecycleRestartPredicate struct {
+	meshProxyConfig meshProxyConfig
+}
+
+func NewProxyLifecycleRestartPredicate(ctx context.Context, client client.Client) *ProxyLifecycleRestartPredicate {
+	return &ProxyLifecycleRestartPredicate{meshProxyConfig: newMeshProxyConfig(ctx, client)}
+}
+
+func (p ProxyLifecycleRestartPredicate) Matches(pod v1.Pod) bool {
+	injected, expected, found := p.meshProxyConfig.proxyConfigs(pod)
+	if !found {
+		return false
+	}
+
+	return !hasSameProxyLifecycleConfig(injected, expected)
+}
+
+func (p ProxyLifecycleRestartPredicate) MustMatch() bool {
+	return false
+}
+
+func hasSameProxyLifecycleConfig(injected, expected *meshv1alpha1.ProxyConfig) bool {
+	return newProxyLifecycleConfig(injected) == newProxyLifecycleConfig(expected)
+}
+
+// proxyLifecycleConfig holds the proxy lifecycle settings of a proxy configuration normalized to the defaults of Istio,
+// so that a field omitted or defaulted by the injector is equal to the same field set explicitly in the mesh configuration.
+type proxyLifecycleConfig struct {
+	holdApplicationUntilProxyStarts bool
+	terminationDrainDuration        time.Duration
+	concurrency                     int32
+	exitOnZeroActiveConnections     bool
+}
+
+func newProxyLifecycleConfig(proxyConfig *meshv1alpha1.ProxyConfig) proxyLifecycleConfig {
+	terminationDrainDuration := mesh.DefaultProxyConfig().GetTerminationDrainDuration().AsDuration()
+	if proxyConfig.GetTerminationDrainDuration() != nil {
+		terminationDrainDuration = proxyConfig.GetTerminationDrainDuration().AsDuration()
+	}
+
+	// An invalid value is treated like the unset default, because the proxy does not enable the feature for it either.
+	exitOnZeroActiveConnections, _ := strconv.ParseBool(proxyConfig.GetProxyMetadata()[v1alpha2.ProxyMetadataExitOnZeroActiveConnections])
+
+	return proxyLifecycleConfig{
+		holdApplicationUntilProxyStarts: proxyConfig.GetHoldApplicationUntilProxyStarts().GetValue(),
+		terminationDrainDuration:        terminationDrainDuration,
+		concurrency:                     proxyConfig.GetConcurrency().GetValue(),
+		exitOnZeroActiveConnections:     exitOnZeroActiveConnections,
+	}
+}
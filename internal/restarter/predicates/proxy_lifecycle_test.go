@@ -0,0 +1,196 @@
+package predicates
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Proxy Lifecycle Predicate", func() {
+	const meshConfig = `defaultConfig:
+  holdApplicationUntilProxyStarts: true
+  terminationDrainDuration: 30s
+  concurrency: 4
+  proxyMetadata:
+    EXIT_ON_ZERO_ACTIVE_CONNECTIONS: "true"
+`
+
+	It("should return false when the injected proxy config matches the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"30s","concurrency":4,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the injected proxy config carries fields defaulted by the injector", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap("defaultConfig:\n  holdApplicationUntilProxyStarts: false\n"))
+		pod := podWithProxyConfig(`{"configPath":"./etc/istio/proxy","binaryPath":"/usr/local/bin/envoy","serviceCluster":"istio-proxy",`+
+			`"drainDuration":"45s","discoveryAddress":"istiod.istio-system.svc:15012","statNameLength":189,"statusPort":15020,`+
+			`"holdApplicationUntilProxyStarts":false,"terminationDrainDuration":"5.000s",`+
+			`"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"false","ISTIO_META_DNS_CAPTURE":"true"}}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the injected proxy config omits fields that have the default value in the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap("defaultConfig:\n  terminationDrainDuration: 5s\n  proxyMetadata:\n    EXIT_ON_ZERO_ACTIVE_CONNECTIONS: \"false\"\n"))
+		pod := podWithProxyConfig(`{"discoveryAddress":"istiod.istio-system.svc:15012"}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return true when the injected terminationDrainDuration differs from the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"5s","concurrency":4,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return true when the injected concurrency differs from the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"30s","concurrency":2,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return true when the injected proxy config is missing EXIT_ON_ZERO_ACTIVE_CONNECTIONS", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"30s","concurrency":4}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return true when holdApplicationUntilProxyStarts was disabled in the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap("defaultConfig:\n  holdApplicationUntilProxyStarts: false\n"))
+		pod := podWithProxyConfig(`{"holdApplicationUntilProxyStarts":true}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return false when the difference is caused by the proxy config annotation of the pod", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"60s","concurrency":4,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`,
+			map[string]string{"proxy.istio.io/config": "terminationDrainDuration: 60s"})
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the pod has no injected proxy config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "app"}}}}
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the istio ConfigMap does not exist", func() {
+		// given
+		c := makeClientWithObjects()
+		pod := podWithProxyConfig(`{"terminationDrainDuration":"60s"}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the difference is caused by a ProxyConfig resource in the root namespace", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig), proxyConfigResource("mesh-wide", "istio-system", nil, 2))
+		pod := podInNamespace("app", nil,
+			`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"30s","concurrency":2,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the difference is caused by a ProxyConfig resource in the namespace of the pod", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig), proxyConfigResource("mesh-wide", "istio-system", nil, 2), proxyConfigResource("namespace-wide", "app", nil, 3))
+		pod := podInNamespace("app", nil,
+			`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"30s","concurrency":3,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the difference is caused by a ProxyConfig resource selecting the pod, which takes precedence over the annotation", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig), proxyConfigResource("workload", "app", map[string]string{"app": "httpbin"}, 1))
+		pod := podInNamespace("app", map[string]string{"app": "httpbin"},
+			`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"30s","concurrency":1,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`,
+			map[string]string{"proxy.istio.io/config": "concurrency: 3"})
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return true when the injected proxy config differs from a ProxyConfig resource that does not select the pod", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig), proxyConfigResource("workload", "app", map[string]string{"app": "other"}, 2))
+		pod := podInNamespace("app", map[string]string{"app": "httpbin"},
+			`{"holdApplicationUntilProxyStarts":true,"terminationDrainDuration":"30s","concurrency":2,"proxyMetadata":{"EXIT_ON_ZERO_ACTIVE_CONNECTIONS":"true"}}`, nil)
+
+		// when
+		predicate := NewProxyLifecycleRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+})
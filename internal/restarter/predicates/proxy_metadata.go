@@ -0,0 +1,228 @@
+package predicates
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"istio.io/api/annotation"
+	meshv1alpha1 "istio.io/api/mesh/v1alpha1"
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	"istio.io/istio/pkg/config/mesh"
+	"istio.io/istio/pkg/util/protomarshal"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+)
+
+const proxyConfigEnvName = "PROXY_CONFIG"
+
+//nolint:gochecknoglobals // list of the proxy metadata keys that configure DNS proxying
+var dnsProxyMetadataKeys = []string{v1alpha2.ProxyMetadataDNSCapture, v1alpha2.ProxyMetadataDNSAutoAllocate}
+
+//nolint:gochecknoglobals // list of the proxy metadata keys that configure the private key of the workload certificates
+var workloadCertificateKeyMetadataKeys = []string{
+	v1alpha2.ProxyMetadataECCSignatureAlgorithm,
+	v1alpha2.ProxyMetadataECCCurve,
+	v1alpha2.ProxyMetadataRSAKeySize,
+}
+
+// ProxyMetadataRestartPredicate restarts pods whose injected proxy configuration does not match the values of the given proxy metadata
+// keys in the mesh configuration. The values are normalized before they are compared, so that values with the same effect on the proxy
+// do not restart a pod.
+type ProxyMetadataRestartPredicate struct {
+	meshProxyConfig meshProxyConfig
+	keys            []string
+	normalize       func(string) string
+}
+
+// NewDNSProxyRestartPredicate returns a predicate for the DNS proxying settings (ISTIO_META_DNS_CAPTURE and ISTIO_META_DNS_AUTO_ALLOCATE).
+func NewDNSProxyRestartPredicate(ctx context.Context, client client.Client) *ProxyMetadataRestartPredicate {
+	return &ProxyMetadataRestartPredicate{
+		meshProxyConfig: newMeshProxyConfig(ctx, client),
+		keys:            dnsProxyMetadataKeys,
+		normalize:       normalizeBool,
+	}
+}
+
+// NewWorkloadCertificatesRestartPredicate returns a predicate for the private key settings of the workload certificates
+// (ECC_SIGNATURE_ALGORITHM, ECC_CURVE and WORKLOAD_RSA_KEY_SIZE).
+func NewWorkloadCertificatesRestartPredicate(ctx context.Context, client client.Client) *ProxyMetadataRestartPredicate {
+	return &ProxyMetadataRestartPredicate{
+		meshProxyConfig: newMeshProxyConfig(ctx, client),
+		keys:            workloadCertificateKeyMetadataKeys,
+		normalize:       func(value string) string { return value },
+	}
+}
+
+func (p ProxyMetadataRestartPredicate) Matches(pod v1.Pod) bool {
+	injected, expected, found := p.meshProxyConfig.proxyConfigs(pod)
+	if !found {
+		return false
+	}
+
+	for _, key := range p.keys {
+		if p.normalize(injected.GetProxyMetadata()[key]) != p.normalize(expected.GetProxyMetadata()[key]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p ProxyMetadataRestartPredicate) MustMatch() bool {
+	return false
+}
+
+// normalizeBool normalizes a boolean proxy metadata value. An unset or invalid value is treated like false, because the proxy does not
+// enable the feature for it either.
+func normalizeBool(value string) string {
+	enabled, _ := strconv.ParseBool(value)
+	return strconv.FormatBool(enabled)
+}
+
+// meshProxyConfig holds the default proxy configuration of the mesh configuration and the ProxyConfig resources, which the injector
+// merges into the proxy configuration of a pod. Without them it is not possible to determine the expected proxy configuration of a pod,
+// so no pods are restarted because of it.
+type meshProxyConfig struct {
+	defaultConfig *meshv1alpha1.ProxyConfig
+	rootNamespace string
+	resources     []*networkingv1beta1.ProxyConfig
+}
+
+func newMeshProxyConfig(ctx context.Context, client client.Client) meshProxyConfig {
+	meshConfig, err := getMeshConfig(ctx, client)
+	if err != nil {
+		return meshProxyConfig{}
+	}
+
+	proxyConfigList := &networkingv1beta1.ProxyConfigList{}
+	if err = client.List(ctx, proxyConfigList); err != nil {
+		return meshProxyConfig{}
+	}
+	// The injector uses the oldest ProxyConfig resource if several of them match a pod.
+	slices.SortStableFunc(proxyConfigList.Items, func(a, b *networkingv1beta1.ProxyConfig) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return meshProxyConfig{
+		defaultConfig: meshConfig.GetDefaultConfig(),
+		rootNamespace: meshConfig.GetRootNamespace(),
+		resources:     proxyConfigList.Items,
+	}
+}
+
+// proxyConfigs returns the proxy configuration that was injected into the pod and the proxy configuration that is expected for the pod.
+// The expected proxy configuration is merged the same way the injector does it. In the order of increasing precedence, these are the
+// default proxy configuration of the mesh, the ProxyConfig resource in the root namespace, the ProxyConfig resource in the namespace of
+// the pod, the proxy config annotation of the pod and the ProxyConfig resource selecting the pod.
+func (m meshProxyConfig) proxyConfigs(pod v1.Pod) (injected, expected *meshv1alpha1.ProxyConfig, found bool) {
+	if m.defaultConfig == nil {
+		return nil, nil, false
+	}
+
+	injected, found = injectedProxyConfig(pod)
+	if !found {
+		return nil, nil, false
+	}
+
+	expected = protomarshal.Clone(m.defaultConfig)
+	overrides := []*meshv1alpha1.ProxyConfig{m.namespaceProxyConfig(m.rootNamespace)}
+	if pod.Namespace != m.rootNamespace {
+		overrides = append(overrides, m.namespaceProxyConfig(pod.Namespace))
+	}
+	for _, override := range overrides {
+		if expected, found = mergeProxyConfig(expected, override); !found {
+			return nil, nil, false
+		}
+	}
+
+	expected, err := mesh.MergeProxyConfig(pod.Annotations[annotation.ProxyConfig.Name], expected)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	if expected, found = mergeProxyConfig(expected, m.workloadProxyConfig(pod)); !found {
+		return nil, nil, false
+	}
+
+	return injected, expected, true
+}
+
+// namespaceProxyConfig returns the proxy configuration of the ProxyConfig resource without a selector in the namespace.
+func (m meshProxyConfig) namespaceProxyConfig(namespace string) *meshv1alpha1.ProxyConfig {
+	for _, proxyConfig := range m.resources {
+		if proxyConfig.Namespace == namespace && len(proxyConfig.Spec.GetSelector().GetMatchLabels()) == 0 {
+			return toMeshProxyConfig(proxyConfig)
+		}
+	}
+	return nil
+}
+
+// workloadProxyConfig returns the proxy configuration of the ProxyConfig resource with a selector matching the labels of the pod.
+func (m meshProxyConfig) workloadProxyConfig(pod v1.Pod) *meshv1alpha1.ProxyConfig {
+	for _, proxyConfig := range m.resources {
+		matchLabels := proxyConfig.Spec.GetSelector().GetMatchLabels()
+		if proxyConfig.Namespace != pod.Namespace || len(matchLabels) == 0 {
+			continue
+		}
+		if labels.SelectorFromSet(matchLabels).Matches(labels.Set(pod.Labels)) {
+			return toMeshProxyConfig(proxyConfig)
+		}
+	}
+	return nil
+}
+
+// toMeshProxyConfig converts the ProxyConfig resource to the proxy configuration fields it sets. The environment variables of the
+// resource are set as proxy metadata.
+func toMeshProxyConfig(proxyConfig *networkingv1beta1.ProxyConfig) *meshv1alpha1.ProxyConfig {
+	return &meshv1alpha1.ProxyConfig{
+		Concurrency:   proxyConfig.Spec.GetConcurrency(),
+		ProxyMetadata: proxyConfig.Spec.GetEnvironmentVariables(),
+		Image:         proxyConfig.Spec.GetImage(),
+	}
+}
+
+// mergeProxyConfig merges the override into the proxy configuration. A nil override leaves the proxy configuration unchanged.
+func mergeProxyConfig(proxyConfig, override *meshv1alpha1.ProxyConfig) (*meshv1alpha1.ProxyConfig, bool) {
+	if override == nil {
+		return proxyConfig, true
+	}
+	overrideYAML, err := protomarshal.ToYAML(override)
+	if err != nil {
+		return nil, false
+	}
+	merged, err := mesh.MergeProxyConfig(overrideYAML, proxyConfig)
+	if err != nil {
+		return nil, false
+	}
+	return merged, true
+}
+
+// injectedProxyConfig returns the proxy configuration that was injected into the istio-proxy container of the pod.
+func injectedProxyConfig(pod v1.Pod) (*meshv1alpha1.ProxyConfig, bool) {
+	c := pod.Spec.Containers
+	c = append(c, pod.Spec.InitContainers...)
+	for _, container := range c {
+		if !isContainerIstioSidecar(container) {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name != proxyConfigEnvName {
+				continue
+			}
+			proxyConfig := &meshv1alpha1.ProxyConfig{}
+			if err := protomarshal.UnmarshalAllowUnknown([]byte(env.Value), proxyConfig); err != nil {
+				return nil, false
+			}
+			return proxyConfig, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,228 @@
+package predicates
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	typev1beta1 "istio.io/api/type/v1beta1"
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DNS Proxy Predicate", func() {
+	const meshConfig = `defaultConfig:
+  proxyMetadata:
+    ISTIO_META_DNS_CAPTURE: "true"
+    ISTIO_META_DNS_AUTO_ALLOCATE: "true"
+`
+
+	It("should return false when the injected DNS proxy metadata matches the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"ISTIO_META_DNS_CAPTURE":"true","ISTIO_META_DNS_AUTO_ALLOCATE":"true"}}`, nil)
+
+		// when
+		predicate := NewDNSProxyRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return true when the pod was injected without DNS proxying", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{}`, nil)
+
+		// when
+		predicate := NewDNSProxyRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return true when DNS proxying was removed from the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(`defaultConfig: {}`))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"ISTIO_META_DNS_CAPTURE":"true","ISTIO_META_DNS_AUTO_ALLOCATE":"false"}}`, nil)
+
+		// when
+		predicate := NewDNSProxyRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return false when the pod overrides the DNS proxy metadata with the proxy config annotation", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"ISTIO_META_DNS_CAPTURE":"false","ISTIO_META_DNS_AUTO_ALLOCATE":"true"}}`,
+			map[string]string{"proxy.istio.io/config": `{"proxyMetadata":{"ISTIO_META_DNS_CAPTURE":"false"}}`})
+
+		// when
+		predicate := NewDNSProxyRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the mesh config disables DNS proxying that was not set for the pod", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(`defaultConfig:
+  proxyMetadata:
+    ISTIO_META_DNS_CAPTURE: "false"
+    ISTIO_META_DNS_AUTO_ALLOCATE: "false"
+`))
+		pod := podWithProxyConfig(`{}`, nil)
+
+		// when
+		predicate := NewDNSProxyRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when disabled DNS proxying was removed from the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(`defaultConfig: {}`))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"ISTIO_META_DNS_CAPTURE":"false","ISTIO_META_DNS_AUTO_ALLOCATE":"false"}}`, nil)
+
+		// when
+		predicate := NewDNSProxyRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the mesh config is not available", func() {
+		// given
+		c := makeClientWithObjects()
+		pod := podWithProxyConfig(`{}`, nil)
+
+		// when
+		predicate := NewDNSProxyRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+})
+
+var _ = Describe("Workload Certificates Predicate", func() {
+	const meshConfig = `defaultConfig:
+  proxyMetadata:
+    SECRET_TTL: "12h0m0s"
+    ECC_SIGNATURE_ALGORITHM: "ECDSA"
+    ECC_CURVE: "P384"
+`
+
+	It("should return false when the injected key settings match the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"SECRET_TTL":"12h0m0s","ECC_SIGNATURE_ALGORITHM":"ECDSA","ECC_CURVE":"P384"}}`, nil)
+
+		// when
+		predicate := NewWorkloadCertificatesRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return true when the pod was injected with a different key algorithm", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"SECRET_TTL":"12h0m0s","WORKLOAD_RSA_KEY_SIZE":"4096"}}`, nil)
+
+		// when
+		predicate := NewWorkloadCertificatesRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return true when the key settings were removed from the mesh config", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(`defaultConfig: {}`))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"ECC_SIGNATURE_ALGORITHM":"ECDSA"}}`, nil)
+
+		// when
+		predicate := NewWorkloadCertificatesRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeTrue())
+	})
+
+	It("should return false when only the certificate TTL differs", func() {
+		// given
+		c := makeClientWithObjects(meshConfigMap(meshConfig))
+		pod := podWithProxyConfig(`{"proxyMetadata":{"SECRET_TTL":"24h0m0s","ECC_SIGNATURE_ALGORITHM":"ECDSA","ECC_CURVE":"P384"}}`, nil)
+
+		// when
+		predicate := NewWorkloadCertificatesRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+
+	It("should return false when the mesh config is not available", func() {
+		// given
+		c := makeClientWithObjects()
+		pod := podWithProxyConfig(`{}`, nil)
+
+		// when
+		predicate := NewWorkloadCertificatesRestartPredicate(context.Background(), c)
+
+		// then
+		Expect(predicate.Matches(pod)).To(BeFalse())
+	})
+})
+
+func meshConfigMap(mesh string) *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "istio-system",
+			Name:      "istio",
+		},
+		Data: map[string]string{
+			"mesh": mesh,
+		},
+	}
+}
+
+func podWithProxyConfig(proxyConfig string, annotations map[string]string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: annotations,
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "app"},
+				{
+					Name: "istio-proxy",
+					Env: []v1.EnvVar{
+						{Name: "PROXY_CONFIG", Value: proxyConfig},
+					},
+				},
+			},
+		},
+	}
+}
+
+func proxyConfigResource(name, namespace string, matchLabels map[string]string, concurrency int32) *networkingv1beta1.ProxyConfig {
+	proxyConfig := &networkingv1beta1.ProxyConfig{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+	}
+	proxyConfig.Spec.Concurrency = wrapperspb.Int32(concurrency)
+	if matchLabels != nil {
+		proxyConfig.Spec.Selector = &typev1beta1.WorkloadSelector{MatchLabels: matchLabels}
+	}
+	return proxyConfig
+}
+
+func podInNamespace(namespace string, labels map[string]string, proxyConfig string, annotations map[string]string) v1.Pod {
+	pod := podWithProxyConfig(proxyConfig, annotations)
+	pod.Namespace = namespace
+	pod.Labels = labels
+	return pod
+}
@@ -0,0 +1,131 @@
+package restarter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/pods"
+)
+
+const (
+	rootCARotationErrorDescription = "Error occurred during root CA rotation"
+	rootCARotationPodsToRestart    = 30
+	rootCARotationPodsToList       = 100
+)
+
+// RootCARotationRestarter progresses the root CA rotation that is started when the plug-in CA Secret contains a new root CA.
+// In each phase of the rotation all Istio proxies created before the phase started are restarted. Once no such proxy is left,
+// the cacerts Secret is updated to the next phase.
+type RootCARotationRestarter struct {
+	client         client.Client
+	podsLister     pods.Getter
+	proxyRestarter sidecars.ProxyRestarter
+	statusHandler  status.Status
+}
+
+func NewRootCARotationRestarter(client client.Client, podsLister pods.Getter, proxyRestarter sidecars.ProxyRestarter, statusHandler status.Status) *RootCARotationRestarter {
+	return &RootCARotationRestarter{
+		client:         client,
+		podsLister:     podsLister,
+		proxyRestarter: proxyRestarter,
+		statusHandler:  statusHandler,
+	}
+}
+
+func (r *RootCARotationRestarter) Restart(ctx context.Context, istioCR *v1alpha2.Istio) (describederrors.DescribedError, bool) {
+	if !istioCR.Spec.Config.IsRootCARotationEnabled() {
+		return nil, false
+	}
+
+	secret := corev1.Secret{}
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: gatherer.IstioNamespace, Name: cacerts.SecretName}, &secret)
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, false
+		}
+		return r.failed(istioCR, err)
+	}
+
+	phase := cacerts.RotationPhase(secret.Annotations[cacerts.RotationPhaseAnnotation])
+	if phase == "" {
+		return nil, false
+	}
+	started, err := time.Parse(time.RFC3339, secret.Annotations[cacerts.RotationPhaseStartedAnnotation])
+	if err != nil {
+		return r.failed(istioCR, fmt.Errorf("invalid start time of root CA rotation phase %s: %w", phase, err))
+	}
+
+	warnings, restarted, err := restartProxiesCreatedBefore(ctx, r.podsLister, r.proxyRestarter, started)
+	if err != nil {
+		return r.failed(istioCR, err)
+	}
+	if len(warnings) > 0 {
+		logger := ctrl.Log.WithName("root-ca-rotation")
+		message := fmt.Sprintf("Root CA rotation is in phase %s and waits for the manual restart of workloads. %s",
+			phase, sidecars.BuildWarningMessage(warnings, &logger))
+		r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonRootCARotationInProgress, message))
+		return describederrors.NewDescribedError(fmt.Errorf("could not restart one or more Istio-injected Pods"),
+			"Some Pods with Istio sidecar injection must be restarted manually to continue the root CA rotation").SetWarning(), false
+	}
+	if restarted {
+		ctrl.Log.Info("Restarted Istio proxies for root CA rotation", "phase", phase)
+		r.setInProgress(istioCR, phase)
+		return nil, true
+	}
+
+	next := phase.Next()
+	if next == "" {
+		delete(secret.Annotations, cacerts.RotationPhaseAnnotation)
+		delete(secret.Annotations, cacerts.RotationPhaseStartedAnnotation)
+		if err := r.client.Update(ctx, &secret); err != nil {
+			return r.failed(istioCR, err)
+		}
+		ctrl.Log.Info("Root CA rotation finished")
+		r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonRootCARotationSucceeded))
+		return nil, false
+	}
+
+	secretRef := istioCR.Spec.Config.PluginCASecret()
+	source := corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: secretRef.Namespace, Name: secretRef.Name}, &source); err != nil {
+		return r.failed(istioCR, err)
+	}
+	data, err := cacerts.RotationData(next, secret.Data, source.Data)
+	if err != nil {
+		return r.failed(istioCR, err)
+	}
+
+	secret.Data = data
+	secret.Annotations[cacerts.RotationPhaseAnnotation] = string(next)
+	secret.Annotations[cacerts.RotationPhaseStartedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if err := r.client.Update(ctx, &secret); err != nil {
+		return r.failed(istioCR, err)
+	}
+	ctrl.Log.Info("Root CA rotation moved to the next phase", "phase", next)
+	r.setInProgress(istioCR, next)
+
+	// The proxies are restarted in the next reconciliation, which gives istiod time to load the updated cacerts Secret.
+	return nil, true
+}
+
+func (r *RootCARotationRestarter) setInProgress(istioCR *v1alpha2.Istio, phase cacerts.RotationPhase) {
+	r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonRootCARotationInProgress,
+		fmt.Sprintf("Root CA rotation is in phase %s", phase)))
+}
+
+func (r *RootCARotationRestarter) failed(istioCR *v1alpha2.Istio, err error) (describederrors.DescribedError, bool) {
+	r.statusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonRootCARotationFailed))
+	return describederrors.NewDescribedError(err, rootCARotationErrorDescription), false
+}
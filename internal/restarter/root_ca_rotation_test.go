@@ -0,0 +1,282 @@
+package restarter_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/cacerts"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/restarter"
+	"github.com/kyma-project/istio/operator/internal/restarter/predicates"
+	"github.com/kyma-project/istio/operator/internal/status"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/pods"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/restart"
+)
+
+var _ = Describe("RootCARotationRestarter", func() {
+	phaseStarted := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	newIstioCR := func(automaticRotation bool) *operatorv1alpha2.Istio {
+		return &operatorv1alpha2.Istio{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", ResourceVersion: "1"},
+			Spec: operatorv1alpha2.IstioSpec{Config: operatorv1alpha2.Config{
+				CertificateAuthority: &operatorv1alpha2.CertificateAuthority{
+					Secret: &operatorv1alpha2.CASecretReference{Name: "my-ca", Namespace: "my-namespace"},
+				},
+				RootCA: &operatorv1alpha2.RootCA{AutomaticRotation: automaticRotation},
+			}},
+		}
+	}
+
+	oldRootPEM, oldCAPEM, oldKeyPEM := createRotationTestCA()
+	newRootPEM, newCAPEM, newKeyPEM := createRotationTestCA()
+
+	sourceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-ca", Namespace: "my-namespace"},
+		Data: map[string][]byte{
+			cacerts.CACertKey:    newCAPEM,
+			cacerts.CAKeyKey:     newKeyPEM,
+			cacerts.RootCertKey:  newRootPEM,
+			cacerts.CertChainKey: append(append([]byte{}, newCAPEM...), newRootPEM...),
+		},
+	}
+
+	cacertsSecret := func(phase cacerts.RotationPhase) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cacerts.SecretName,
+				Namespace: "istio-system",
+				Annotations: map[string]string{
+					cacerts.RotationPhaseAnnotation:        string(phase),
+					cacerts.RotationPhaseStartedAnnotation: phaseStarted,
+				},
+			},
+			Data: map[string][]byte{
+				cacerts.CACertKey:    oldCAPEM,
+				cacerts.CAKeyKey:     oldKeyPEM,
+				cacerts.RootCertKey:  append(append([]byte{}, oldRootPEM...), newRootPEM...),
+				cacerts.CertChainKey: append(append([]byte{}, oldCAPEM...), oldRootPEM...),
+			},
+		}
+	}
+
+	getCACerts := func(c client.Client) corev1.Secret {
+		secret := corev1.Secret{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: "istio-system", Name: cacerts.SecretName}, &secret)).To(Succeed())
+		return secret
+	}
+
+	rotationCondition := func(istioCR *operatorv1alpha2.Istio) *metav1.Condition {
+		Expect(istioCR.Status.Conditions).ToNot(BeNil())
+		return meta.FindStatusCondition(*istioCR.Status.Conditions, string(operatorv1alpha2.ConditionTypeRootCARotation))
+	}
+
+	It("should do nothing when the automatic rotation is disabled", func() {
+		// given
+		istioCR := newIstioCR(false)
+		c := createFakeClient(istioCR, sourceSecret, cacertsSecret(cacerts.RotationPhaseAddRoot))
+		proxyRestarter := &proxyRestarterMock{}
+		podsLister := &podsGetterMock{pods: []corev1.Pod{{}}}
+		rotationRestarter := restarter.NewRootCARotationRestarter(c, podsLister, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := rotationRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect(proxyRestarter.restartCalled).To(BeFalse())
+		Expect(istioCR.Status.Conditions).To(BeNil())
+	})
+
+	It("should do nothing when no rotation is in progress", func() {
+		// given
+		istioCR := newIstioCR(true)
+		secret := cacertsSecret(cacerts.RotationPhaseAddRoot)
+		secret.Annotations = nil
+		c := createFakeClient(istioCR, sourceSecret, secret)
+		proxyRestarter := &proxyRestarterMock{}
+		rotationRestarter := restarter.NewRootCARotationRestarter(c, &podsGetterMock{}, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := rotationRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect(proxyRestarter.restartCalled).To(BeFalse())
+	})
+
+	It("should restart the proxies created before the phase started", func() {
+		// given
+		istioCR := newIstioCR(true)
+		c := createFakeClient(istioCR, sourceSecret, cacertsSecret(cacerts.RotationPhaseAddRoot))
+		proxyRestarter := &proxyRestarterMock{}
+		podsLister := &podsGetterMock{pods: []corev1.Pod{{}}}
+		rotationRestarter := restarter.NewRootCARotationRestarter(c, podsLister, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := rotationRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+		Expect(proxyRestarter.restartCalled).To(BeTrue())
+		Expect(podsLister.preds).To(HaveLen(1))
+		Expect(podsLister.preds[0]).To(BeAssignableToTypeOf(&predicates.CreatedBeforeRestartPredicate{}))
+		Expect(rotationCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonRootCARotationInProgress)))
+		Expect(rotationCondition(istioCR).Message).To(Equal("Root CA rotation is in phase AddRoot"))
+		Expect(rotationCondition(istioCR).Status).To(Equal(metav1.ConditionFalse))
+		Expect(getCACerts(c).Annotations).To(HaveKeyWithValue(cacerts.RotationPhaseAnnotation, "AddRoot"))
+	})
+
+	It("should return a warning when proxies must be restarted manually", func() {
+		// given
+		istioCR := newIstioCR(true)
+		c := createFakeClient(istioCR, sourceSecret, cacertsSecret(cacerts.RotationPhaseAddRoot))
+		proxyRestarter := &proxyRestarterMock{restartWarnings: []restart.Warning{{Name: "pod1", Namespace: "ns1", Kind: "Pod", Message: "pod is not part of a workload"}}}
+		rotationRestarter := restarter.NewRootCARotationRestarter(c, &podsGetterMock{pods: []corev1.Pod{{}}}, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := rotationRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(requeue).To(BeFalse())
+		Expect(rotationCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonRootCARotationInProgress)))
+		Expect(rotationCondition(istioCR).Message).To(ContainSubstring("ns1/pod1"))
+	})
+
+	It("should switch to the new CA when all proxies trust the new root", func() {
+		// given
+		istioCR := newIstioCR(true)
+		c := createFakeClient(istioCR, sourceSecret, cacertsSecret(cacerts.RotationPhaseAddRoot))
+		proxyRestarter := &proxyRestarterMock{}
+		rotationRestarter := restarter.NewRootCARotationRestarter(c, &podsGetterMock{}, proxyRestarter, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := rotationRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeTrue())
+		Expect(proxyRestarter.restartCalled).To(BeFalse())
+
+		secret := getCACerts(c)
+		Expect(secret.Annotations).To(HaveKeyWithValue(cacerts.RotationPhaseAnnotation, "SwitchCA"))
+		Expect(secret.Annotations[cacerts.RotationPhaseStartedAnnotation]).ToNot(Equal(phaseStarted))
+		Expect(secret.Data[cacerts.CACertKey]).To(Equal(newCAPEM))
+		Expect(secret.Data[cacerts.CAKeyKey]).To(Equal(newKeyPEM))
+		roots, parseErr := cacerts.ParseCertificates(secret.Data[cacerts.RootCertKey])
+		Expect(parseErr).ToNot(HaveOccurred())
+		Expect(roots).To(HaveLen(2))
+		Expect(rotationCondition(istioCR).Message).To(Equal("Root CA rotation is in phase SwitchCA"))
+	})
+
+	It("should finish the rotation when all proxies are restarted after the old root is removed", func() {
+		// given
+		istioCR := newIstioCR(true)
+		secret := cacertsSecret(cacerts.RotationPhaseRemoveRoot)
+		secret.Data = sourceSecret.Data
+		c := createFakeClient(istioCR, sourceSecret, secret)
+		rotationRestarter := restarter.NewRootCARotationRestarter(c, &podsGetterMock{}, &proxyRestarterMock{}, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := rotationRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect(getCACerts(c).Annotations).ToNot(HaveKey(cacerts.RotationPhaseAnnotation))
+		Expect(getCACerts(c).Annotations).ToNot(HaveKey(cacerts.RotationPhaseStartedAnnotation))
+		Expect(rotationCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonRootCARotationSucceeded)))
+		Expect(rotationCondition(istioCR).Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should fail when the start time of the phase is invalid", func() {
+		// given
+		istioCR := newIstioCR(true)
+		secret := cacertsSecret(cacerts.RotationPhaseAddRoot)
+		secret.Annotations[cacerts.RotationPhaseStartedAnnotation] = "yesterday"
+		c := createFakeClient(istioCR, sourceSecret, secret)
+		rotationRestarter := restarter.NewRootCARotationRestarter(c, &podsGetterMock{}, &proxyRestarterMock{}, status.NewStatusHandler(c))
+
+		// when
+		err, requeue := rotationRestarter.Restart(context.Background(), istioCR)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Error))
+		Expect(requeue).To(BeFalse())
+		Expect(rotationCondition(istioCR).Reason).To(Equal(string(operatorv1alpha2.ConditionReasonRootCARotationFailed)))
+		Expect(rotationCondition(istioCR).Status).To(Equal(metav1.ConditionUnknown))
+	})
+})
+
+type podsGetterMock struct {
+	pods  []corev1.Pod
+	preds []predicates.SidecarProxyPredicate
+}
+
+func (p *podsGetterMock) GetPodsToRestart(_ context.Context, preds []predicates.SidecarProxyPredicate, _ *pods.RestartLimits) (*corev1.PodList, error) {
+	p.preds = preds
+	return &corev1.PodList{Items: p.pods}, nil
+}
+
+func (p *podsGetterMock) GetAllInjectedPods(_ context.Context) (*corev1.PodList, error) {
+	return &corev1.PodList{Items: p.pods}, nil
+}
+
+// createRotationTestCA returns the PEM encoded root certificate, intermediate certificate and intermediate key of a plug-in CA.
+func createRotationTestCA() (rootPEM, caPEM, caKeyPEM []byte) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	root := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, root, root, &rootKey.PublicKey, rootKey)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ShouldNot(HaveOccurred())
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, root, &caKey.PublicKey, rootKey)
+	Expect(err).ShouldNot(HaveOccurred())
+	caKeyDER, err := x509.MarshalECPrivateKey(caKey)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: caKeyDER})
+}
@@ -1,7 +1,10 @@
 package restarter
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/kyma-project/istio/operator/internal/restarter/predicates"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -18,9 +22,12 @@ import (
 	"github.com/kyma-project/istio/operator/internal/status"
 	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
 	"github.com/kyma-project/istio/operator/pkg/lib/sidecars"
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/restart"
 )
 
-const errorDescription = "Error occurred during reconciliation of Istio Sidecars"
+const (
+	errorDescription = "Error occurred during reconciliation of Istio Sidecars"
+)
 
 type SidecarRestarter struct {
 	Log            logr.Logger
@@ -80,7 +87,13 @@ func (s *SidecarRestarter) Restart(ctx context.Context, istioCR *v1alpha2.Istio)
 	expectedImage := predicates.NewSidecarImage(iop.Spec.Hub, tag)
 	s.Log.Info("Running proxy sidecar reset", "expected image", expectedImage)
 
-	err = gatherer.VerifyIstioPodsVersion(ctx, s.Client, istioImageVersion.Version())
+	targetRevision, err := gatherer.GetTargetIstioRevision(ctx, s.Client, istioImageVersion.Revision())
+	if err != nil {
+		s.StatusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonProxySidecarRestartFailed))
+		return describederrors.NewDescribedError(err, "Could not determine Istio revision"), false
+	}
+
+	err = gatherer.VerifyIstioPodsVersion(ctx, s.Client, istioImageVersion.Version(), targetRevision)
 	if err != nil {
 		s.StatusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonProxySidecarRestartFailed))
 		return describederrors.NewDescribedError(err, "Verifying Pod versions in istio-system namespace failed"), false
@@ -93,18 +106,32 @@ func (s *SidecarRestarter) Restart(ctx context.Context, istioCR *v1alpha2.Istio)
 		return describederrors.NewDescribedError(err, errorDescription), false
 	}
 
-	warnings, hasMorePods, err := s.ProxyRestarter.RestartProxies(ctx, expectedImage, expectedResources, istioCR)
+	expectedNativeSidecar, err := istioCR.IsNativeSidecarEnabled(iop)
+	if err != nil {
+		s.Log.Error(err, "Failed to get Istio Proxy sidecar type")
+		s.StatusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonProxySidecarRestartFailed))
+		return describederrors.NewDescribedError(err, errorDescription), false
+	}
+
+	warnings, restartedPods, hasMorePods, err := s.ProxyRestarter.RestartProxies(ctx, expectedImage, expectedResources, expectedNativeSidecar, targetRevision, istioCR)
 	if err != nil {
 		s.Log.Error(err, "Failed to reset proxy")
 		s.StatusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonProxySidecarRestartFailed))
+		s.StatusHandler.RecordEvent(istioCR, corev1.EventTypeWarning, v1alpha2.ConditionReasonProxySidecarRestartFailed,
+			fmt.Sprintf("%s: %s", v1alpha2.ConditionReasonProxySidecarRestartFailedMessage, err))
 		return describederrors.NewDescribedError(err, errorDescription), false
 	}
 
+	s.recordRestartedPods(istioCR, restartedPods, hasMorePods)
+
+	istioCR.Status.ManualRestartWorkloads = manualRestartWorkloads(warnings)
+
 	warningMessage := sidecars.BuildWarningMessage(warnings, &s.Log)
 	if warningMessage != "" {
-		warningErr := describederrors.NewDescribedError(errors.New("could not restart one or more Istio-injected Pods"), "Some Pods with Istio sidecar injection failed to restart. To learn more about the warning, see kyma-system/istio-controller-manager logs").
+		warningErr := describederrors.NewDescribedError(errors.New("could not restart one or more Istio-injected Pods"), "Some Pods with Istio sidecar injection failed to restart. To learn more about the warning, see status.manualRestartWorkloads").
 			SetWarning()
 		s.StatusHandler.SetCondition(istioCR, v1alpha2.NewReasonWithMessage(v1alpha2.ConditionReasonProxySidecarManualRestartRequired, warningMessage))
+		s.StatusHandler.RecordEvent(istioCR, corev1.EventTypeWarning, v1alpha2.ConditionReasonProxySidecarManualRestartRequired, warningMessage)
 		s.Log.Info(warningMessage)
 		return warningErr, false
 	}
@@ -117,3 +144,41 @@ func (s *SidecarRestarter) Restart(ctx context.Context, istioCR *v1alpha2.Istio)
 
 	return nil, hasMorePods
 }
+
+// recordRestartedPods emits an Event if Pods with an outdated Istio sidecar were restarted, so that every restart is visible on the
+// Istio CR, no matter whether it took one or several batches.
+func (s *SidecarRestarter) recordRestartedPods(istioCR *v1alpha2.Istio, restartedPods int, hasMorePods bool) {
+	if restartedPods == 0 {
+		return
+	}
+	if hasMorePods {
+		s.StatusHandler.RecordEvent(istioCR, corev1.EventTypeNormal, v1alpha2.ConditionReasonProxySidecarRestartPartiallySucceeded,
+			fmt.Sprintf("Restarted %d Pods with an outdated Istio sidecar, the remaining Pods are restarted in the next reconciliation", restartedPods))
+		return
+	}
+	s.StatusHandler.RecordEvent(istioCR, corev1.EventTypeNormal, v1alpha2.ConditionReasonProxySidecarRestartSucceeded,
+		fmt.Sprintf("Restarted %d Pods with an outdated Istio sidecar", restartedPods))
+}
+
+// manualRestartWorkloads returns the workloads that could not be restarted sorted by namespace, kind and name,
+// so that the status of the Istio CR only changes if the workloads change.
+func manualRestartWorkloads(warnings []restart.Warning) []v1alpha2.ManualRestartWorkload {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	workloads := make([]v1alpha2.ManualRestartWorkload, 0, len(warnings))
+	for _, w := range warnings {
+		workloads = append(workloads, v1alpha2.ManualRestartWorkload{
+			Name:      w.Name,
+			Namespace: w.Namespace,
+			Kind:      w.Kind,
+			Reason:    w.Reason,
+			Message:   w.Message,
+		})
+	}
+	slices.SortFunc(workloads, func(a, b v1alpha2.ManualRestartWorkload) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name))
+	})
+	return workloads
+}
@@ -2,6 +2,7 @@ package restarter_test
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 
@@ -13,6 +14,7 @@ import (
 	iopv1alpha1 "istio.io/istio/operator/pkg/apis"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -145,6 +147,91 @@ var _ = Describe("SidecarsRestarter reconciliation", func() {
 		Expect((*istioCr.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
 	})
 
+	It("should list every workload that could not be restarted in the status", func() {
+		// given
+		istioCr := createIstioCR()
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1", "kyma-project.io/module=istio")
+		var restartWarnings []restart.Warning
+		for i := 7; i > 0; i-- {
+			restartWarnings = append(restartWarnings, restart.Warning{
+				Name:      fmt.Sprintf("job%d", i),
+				Namespace: "ns",
+				Kind:      "Job",
+				Reason:    restart.ReasonOwnedByJob,
+				Message:   "pod sidecar could not be updated because it is owned by a Job.",
+			})
+		}
+		restartWarnings = append(restartWarnings, restart.Warning{Name: "pod", Namespace: "a-ns", Kind: "Pod", Reason: restart.ReasonOwnerNotFound})
+		proxyRestarter := &proxyRestarterMock{restartWarnings: restartWarnings}
+		fakeClient := createFakeClient(istioCr, istiod)
+		statusHandler := status.NewStatusHandler(fakeClient)
+		sidecarsRestarter := restarter.NewSidecarsRestarter(logr.Discard(), createFakeClient(istioCr, istiod),
+			&MergerMock{"1.16.1-distroless"}, proxyRestarter, statusHandler)
+
+		// when
+		err, _ := sidecarsRestarter.Restart(context.Background(), istioCr)
+
+		// then
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(istioCr.Status.ManualRestartWorkloads).To(HaveLen(8))
+		Expect(istioCr.Status.ManualRestartWorkloads[0]).To(Equal(operatorv1alpha2.ManualRestartWorkload{
+			Name: "pod", Namespace: "a-ns", Kind: "Pod", Reason: "OwnerNotFound",
+		}))
+		Expect(istioCr.Status.ManualRestartWorkloads[1]).To(Equal(operatorv1alpha2.ManualRestartWorkload{
+			Name: "job1", Namespace: "ns", Kind: "Job", Reason: "OwnedByJob", Message: "pod sidecar could not be updated because it is owned by a Job.",
+		}))
+		Expect(istioCr.Status.ManualRestartWorkloads[7].Name).To(Equal("job7"))
+	})
+
+	It("should list every workload that could not be restarted in the status", func() {
+		// given
+		istioCr := createIstioCR()
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1", "kyma-project.io/module=istio")
+		var restartWarnings []restart.Warning
+		for i := 35; i > 0; i-- {
+			restartWarnings = append(restartWarnings, restart.Warning{
+				Name:      fmt.Sprintf("pod%02d", i),
+				Namespace: "ns",
+				Kind:      "Pod",
+				Reason:    restart.ReasonOwnerNotFound,
+			})
+		}
+		proxyRestarter := &proxyRestarterMock{restartWarnings: restartWarnings}
+		fakeClient := createFakeClient(istioCr, istiod)
+		statusHandler := status.NewStatusHandler(fakeClient)
+		sidecarsRestarter := restarter.NewSidecarsRestarter(logr.Discard(), createFakeClient(istioCr, istiod),
+			&MergerMock{"1.16.1-distroless"}, proxyRestarter, statusHandler)
+
+		// when
+		err, _ := sidecarsRestarter.Restart(context.Background(), istioCr)
+
+		// then
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Level()).To(Equal(describederrors.Warning))
+		Expect(istioCr.Status.ManualRestartWorkloads).To(HaveLen(35))
+		Expect(istioCr.Status.ManualRestartWorkloads[0].Name).To(Equal("pod01"))
+		Expect(istioCr.Status.ManualRestartWorkloads[34].Name).To(Equal("pod35"))
+	})
+
+	It("should clear the workloads that could not be restarted when all proxies are reset", func() {
+		// given
+		istioCr := createIstioCR()
+		istioCr.Status.ManualRestartWorkloads = []operatorv1alpha2.ManualRestartWorkload{{Name: "job", Namespace: "ns", Kind: "Job", Reason: "OwnedByJob"}}
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1", "kyma-project.io/module=istio")
+		fakeClient := createFakeClient(istioCr, istiod)
+		statusHandler := status.NewStatusHandler(fakeClient)
+		sidecarsRestarter := restarter.NewSidecarsRestarter(logr.Discard(), createFakeClient(istioCr, istiod),
+			&MergerMock{"1.16.1-distroless"}, &proxyRestarterMock{}, statusHandler)
+
+		// when
+		err, _ := sidecarsRestarter.Restart(context.Background(), istioCr)
+
+		// then
+		Expect(err).Should(Not(HaveOccurred()))
+		Expect(istioCr.Status.ManualRestartWorkloads).To(BeEmpty())
+	})
+
 	It("should succeed proxy reset when there is no warning or errors", func() {
 		// given
 		istioCr := createIstioCR()
@@ -196,10 +283,12 @@ var _ = Describe("SidecarsRestarter reconciliation", func() {
 		istioCr := createIstioCR()
 		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1", "kyma-project.io/module=istio")
 		proxyRestarter := &proxyRestarterMock{
-			hasMorePods: true,
+			restartedPods: 30,
+			hasMorePods:   true,
 		}
 		fakeClient := createFakeClient(istioCr, istiod)
-		statusHandler := status.NewStatusHandler(fakeClient)
+		recorder := record.NewFakeRecorder(1)
+		statusHandler := status.NewEventRecordingStatusHandler(fakeClient, recorder)
 		sidecarsRestarter := restarter.NewSidecarsRestarter(logr.Discard(), createFakeClient(istioCr, istiod),
 			&MergerMock{"1.16.1-distroless"}, proxyRestarter, statusHandler)
 
@@ -213,6 +302,74 @@ var _ = Describe("SidecarsRestarter reconciliation", func() {
 		Expect((*istioCr.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonProxySidecarRestartPartiallySucceeded)))
 		Expect((*istioCr.Status.Conditions)[0].Message).To(Equal(operatorv1alpha2.ConditionReasonProxySidecarRestartPartiallySucceededMessage))
 		Expect((*istioCr.Status.Conditions)[0].Status).To(Equal(metav1.ConditionFalse))
+		Expect(recorder.Events).To(Receive(Equal("Normal ProxySidecarRestartPartiallySucceeded Restarted 30 Pods with an outdated Istio sidecar, " +
+			"the remaining Pods are restarted in the next reconciliation")))
+	})
+
+	It("should record the restart of the last batch of proxies", func() {
+		// given
+		istioCr := createIstioCR()
+		istioCr.Status.Conditions = &[]metav1.Condition{{
+			Type:   string(operatorv1alpha2.ConditionTypeProxySidecarRestartSucceeded),
+			Status: metav1.ConditionFalse,
+			Reason: string(operatorv1alpha2.ConditionReasonProxySidecarRestartPartiallySucceeded),
+		}}
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1", "kyma-project.io/module=istio")
+		proxyRestarter := &proxyRestarterMock{restartedPods: 5}
+		fakeClient := createFakeClient(istioCr, istiod)
+		recorder := record.NewFakeRecorder(1)
+		statusHandler := status.NewEventRecordingStatusHandler(fakeClient, recorder)
+		sidecarsRestarter := restarter.NewSidecarsRestarter(logr.Discard(), createFakeClient(istioCr, istiod),
+			&MergerMock{"1.16.1-distroless"}, proxyRestarter, statusHandler)
+
+		// when
+		err, requeue := sidecarsRestarter.Restart(context.Background(), istioCr)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect((*istioCr.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonProxySidecarRestartSucceeded)))
+		Expect(recorder.Events).To(Receive(Equal("Normal ProxySidecarRestartSucceeded Restarted 5 Pods with an outdated Istio sidecar")))
+	})
+
+	It("should record the restart of proxies that finishes in a single batch", func() {
+		// given
+		istioCr := createIstioCR()
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1", "kyma-project.io/module=istio")
+		proxyRestarter := &proxyRestarterMock{restartedPods: 3}
+		fakeClient := createFakeClient(istioCr, istiod)
+		recorder := record.NewFakeRecorder(1)
+		statusHandler := status.NewEventRecordingStatusHandler(fakeClient, recorder)
+		sidecarsRestarter := restarter.NewSidecarsRestarter(logr.Discard(), createFakeClient(istioCr, istiod),
+			&MergerMock{"1.16.1-distroless"}, proxyRestarter, statusHandler)
+
+		// when
+		err, requeue := sidecarsRestarter.Restart(context.Background(), istioCr)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue).To(BeFalse())
+		Expect((*istioCr.Status.Conditions)[0].Reason).To(Equal(string(operatorv1alpha2.ConditionReasonProxySidecarRestartSucceeded)))
+		Expect(recorder.Events).To(Receive(Equal("Normal ProxySidecarRestartSucceeded Restarted 3 Pods with an outdated Istio sidecar")))
+	})
+
+	It("should not record an Event when no proxy had to be restarted", func() {
+		// given
+		istioCr := createIstioCR()
+		istiod := createPod("istiod", gatherer.IstioNamespace, "discovery", "1.16.1", "kyma-project.io/module=istio")
+		proxyRestarter := &proxyRestarterMock{}
+		fakeClient := createFakeClient(istioCr, istiod)
+		recorder := record.NewFakeRecorder(1)
+		statusHandler := status.NewEventRecordingStatusHandler(fakeClient, recorder)
+		sidecarsRestarter := restarter.NewSidecarsRestarter(logr.Discard(), createFakeClient(istioCr, istiod),
+			&MergerMock{"1.16.1-distroless"}, proxyRestarter, statusHandler)
+
+		// when
+		err, _ := sidecarsRestarter.Restart(context.Background(), istioCr)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recorder.Events).To(BeEmpty())
 	})
 })
 
@@ -277,7 +434,7 @@ type MergerMock struct {
 	tag string
 }
 
-func (m MergerMock) Merge(_ clusterconfig.ClusterSize, _ *operatorv1alpha2.Istio, _ clusterconfig.ClusterConfiguration, _ string) (string, error) {
+func (m MergerMock) Merge(_ clusterconfig.ClusterSize, _ *operatorv1alpha2.Istio, _ clusterconfig.ClusterConfiguration, _ string, _ string) (string, error) {
 	return "mocked istio operator merge result", nil
 }
 
@@ -299,14 +456,17 @@ func (m MergerMock) SetIstioInstallFlavor(_ clusterconfig.ClusterSize) {}
 
 type proxyRestarterMock struct {
 	restartWarnings []restart.Warning
+	restartedPods   int
 	hasMorePods     bool
 	err             error
+	restartCalled   bool
 }
 
-func (p *proxyRestarterMock) RestartProxies(_ context.Context, _ predicates.SidecarImage, _ corev1.ResourceRequirements, _ *operatorv1alpha2.Istio) ([]restart.Warning, bool, error) {
-	return p.restartWarnings, p.hasMorePods, p.err
+func (p *proxyRestarterMock) RestartProxies(_ context.Context, _ predicates.SidecarImage, _ corev1.ResourceRequirements, _ bool, _ string, _ *operatorv1alpha2.Istio) ([]restart.Warning, int, bool, error) {
+	return p.restartWarnings, p.restartedPods, p.hasMorePods, p.err
 }
 
 func (p *proxyRestarterMock) RestartWithPredicates(_ context.Context, preds []predicates.SidecarProxyPredicate, _ *pods.RestartLimits, _ bool) ([]restart.Warning, bool, error) {
+	p.restartCalled = true
 	return p.restartWarnings, p.hasMorePods, p.err
 }
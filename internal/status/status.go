@@ -8,6 +8,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,10 +24,13 @@ type Status interface {
 	UpdateToError(ctx context.Context, istioCR *operatorv1alpha2.Istio, err describederrors.DescribedError,
 		requeueAfter ...time.Duration) error
 	SetCondition(istioCR *operatorv1alpha2.Istio, reason operatorv1alpha2.ReasonWithMessage)
+	RemoveCondition(istioCR *operatorv1alpha2.Istio, conditionType operatorv1alpha2.ConditionType)
+	RecordEvent(istioCR *operatorv1alpha2.Istio, eventType string, reason operatorv1alpha2.ConditionReason, message string)
 }
 
 type Handler struct {
-	client client.Client
+	client   client.Client
+	recorder record.EventRecorder
 }
 
 func NewStatusHandler(client client.Client) Handler {
@@ -35,6 +39,14 @@ func NewStatusHandler(client client.Client) Handler {
 	}
 }
 
+// NewEventRecordingStatusHandler returns a status handler that also emits Kubernetes Events on the Istio CR for reconciliation milestones.
+func NewEventRecordingStatusHandler(client client.Client, recorder record.EventRecorder) Handler {
+	return Handler{
+		client:   client,
+		recorder: recorder,
+	}
+}
+
 func (d Handler) update(ctx context.Context, istioCR *operatorv1alpha2.Istio) error {
 	newStatus := istioCR.Status
 	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -95,3 +107,19 @@ func (d Handler) SetCondition(istioCR *operatorv1alpha2.Istio, reason operatorv1
 		ctrl.Log.Error(errors.New("condition not found"), "Unable to find condition from reason", "reason", reason)
 	}
 }
+
+func (d Handler) RemoveCondition(istioCR *operatorv1alpha2.Istio, conditionType operatorv1alpha2.ConditionType) {
+	if istioCR.Status.Conditions == nil {
+		return
+	}
+	meta.RemoveStatusCondition(istioCR.Status.Conditions, string(conditionType))
+}
+
+// RecordEvent emits an Event on the Istio CR, so that the reconciliation milestones are visible with kubectl describe. The reason of the
+// Event is the reason of the condition that reflects the milestone. No Event is emitted if the handler has no event recorder.
+func (d Handler) RecordEvent(istioCR *operatorv1alpha2.Istio, eventType string, reason operatorv1alpha2.ConditionReason, message string) {
+	if d.recorder == nil {
+		return
+	}
+	d.recorder.Event(istioCR, eventType, string(reason), message)
+}
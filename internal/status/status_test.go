@@ -14,9 +14,11 @@ import (
 	networkingv1 "istio.io/client-go/pkg/apis/networking/v1"
 	securityv1 "istio.io/client-go/pkg/apis/security/v1"
 	"k8s.io/api/apps/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	types2 "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -281,6 +283,63 @@ var _ = Describe("status", func() {
 			Expect((*cr.Status.Conditions)[1].Status).To(Equal(metav1.ConditionFalse))
 		})
 	})
+
+	Describe("RemoveCondition", func() {
+		It("should remove only the condition of the given type", func() {
+			// given
+			cr := operatorv1alpha2.Istio{}
+			handler := NewStatusHandler(createFakeClient())
+			handler.SetCondition(&cr, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonReconcileSucceeded))
+			handler.SetCondition(&cr, operatorv1alpha2.NewReasonWithMessage(operatorv1alpha2.ConditionReasonServiceEntriesNotFound))
+
+			// when
+			handler.RemoveCondition(&cr, operatorv1alpha2.ConditionTypeOutboundTrafficBlocked)
+
+			// then
+			Expect((*cr.Status.Conditions)).To(HaveLen(1))
+			Expect((*cr.Status.Conditions)[0].Type).To(Equal(string(operatorv1alpha2.ConditionTypeReady)))
+		})
+
+		It("should not fail if there are no conditions", func() {
+			// given
+			cr := operatorv1alpha2.Istio{}
+			handler := NewStatusHandler(createFakeClient())
+
+			// when
+			handler.RemoveCondition(&cr, operatorv1alpha2.ConditionTypeOutboundTrafficBlocked)
+
+			// then
+			Expect(cr.Status.Conditions).To(BeNil())
+		})
+	})
+
+	Describe("RecordEvent", func() {
+		It("should emit an Event on the Istio CR", func() {
+			// given
+			cr := operatorv1alpha2.Istio{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			}
+			recorder := record.NewFakeRecorder(1)
+			handler := NewEventRecordingStatusHandler(createFakeClient(&cr), recorder)
+
+			// when
+			handler.RecordEvent(&cr, corev1.EventTypeNormal, operatorv1alpha2.ConditionReasonIstioInstallSucceeded, "Istio installation succeeded")
+
+			// then
+			Expect(recorder.Events).To(Receive(Equal("Normal IstioInstallSucceeded Istio installation succeeded")))
+		})
+
+		It("should not fail if there is no event recorder", func() {
+			// given
+			cr := operatorv1alpha2.Istio{}
+			handler := NewStatusHandler(createFakeClient())
+
+			// when & then
+			Expect(func() {
+				handler.RecordEvent(&cr, corev1.EventTypeNormal, operatorv1alpha2.ConditionReasonIstioInstallSucceeded, "Istio installation succeeded")
+			}).NotTo(Panic())
+		})
+	})
 })
 
 func createFakeClient(objects ...client.Object) client.Client {
@@ -0,0 +1,97 @@
+package storagemigration
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/avast/retry-go"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	retriesCount        = 10
+	delayBetweenRetries = 10 * time.Second
+)
+
+// Migrator rewrites all custom resources of a CRD in the storage version of the CRD and then removes the other versions
+// from the stored versions in the status of the CRD. Afterwards, versions that are no longer stored can be removed from the CRD.
+// The migrator is not started yet, because v1alpha2 stays the storage version of the Istio CRD until a rollback to a release
+// without v1beta1 is no longer supported.
+type Migrator struct {
+	client  client.Client
+	crdName string
+}
+
+func NewMigrator(k8sClient client.Client, crdName string) *Migrator {
+	return &Migrator{client: k8sClient, crdName: crdName}
+}
+
+// Start runs the migration once the manager is started. A failed migration is retried and does not stop the manager,
+// because the custom resources remain readable in all served versions.
+func (m *Migrator) Start(ctx context.Context) error {
+	logger := ctrl.Log.WithName("storage-migration").WithValues("crd", m.crdName)
+
+	err := retry.Do(func() error {
+		return m.Migrate(ctx)
+	}, retry.Context(ctx), retry.Attempts(retriesCount), retry.Delay(delayBetweenRetries), retry.DelayType(retry.FixedDelay))
+	if err != nil {
+		logger.Error(err, "Failed to migrate the stored custom resources to the storage version")
+		return nil
+	}
+
+	logger.Info("Stored custom resources are migrated to the storage version")
+	return nil
+}
+
+// NeedLeaderElection returns true, because the migration must be run by a single replica of the manager.
+func (m *Migrator) NeedLeaderElection() bool {
+	return true
+}
+
+// Migrate rewrites all custom resources of the CRD in its storage version and sets the storage version as the only stored version.
+func (m *Migrator) Migrate(ctx context.Context) error {
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	if err := m.client.Get(ctx, types.NamespacedName{Name: m.crdName}, &crd); err != nil {
+		return err
+	}
+
+	storageVersion, err := getStorageVersion(crd)
+	if err != nil {
+		return err
+	}
+	if slices.Equal(crd.Status.StoredVersions, []string{storageVersion}) {
+		return nil
+	}
+
+	list := unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{Group: crd.Spec.Group, Version: storageVersion, Kind: crd.Spec.Names.ListKind})
+	if err = m.client.List(ctx, &list); err != nil {
+		return err
+	}
+
+	// An update without changes is enough, because the API server writes the custom resource in the storage version.
+	for _, item := range list.Items {
+		if err = m.client.Update(ctx, &item); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	return m.client.Status().Update(ctx, &crd)
+}
+
+func getStorageVersion(crd apiextensionsv1.CustomResourceDefinition) (string, error) {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name, nil
+		}
+	}
+	return "", errors.New("the CRD has no storage version")
+}
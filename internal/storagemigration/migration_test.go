@@ -0,0 +1,101 @@
+package storagemigration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1beta1 "github.com/kyma-project/istio/operator/api/v1beta1"
+	"github.com/kyma-project/istio/operator/internal/storagemigration"
+)
+
+const crdName = "istios.operator.kyma-project.io"
+
+var _ = Describe("Migrate", func() {
+	istioCRD := func(storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: crdName},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: "operator.kyma-project.io",
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "Istio", ListKind: "IstioList"},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha2", Served: true},
+					{Name: "v1beta1", Served: true, Storage: true},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+		}
+	}
+	istioCR := &operatorv1beta1.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system"}}
+
+	getCRD := func(c client.Client) apiextensionsv1.CustomResourceDefinition {
+		crd := apiextensionsv1.CustomResourceDefinition{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: crdName}, &crd)).To(Succeed())
+		return crd
+	}
+	getIstioCR := func(c client.Client) operatorv1beta1.Istio {
+		istio := operatorv1beta1.Istio{}
+		Expect(c.Get(context.Background(), client.ObjectKeyFromObject(istioCR), &istio)).To(Succeed())
+		return istio
+	}
+
+	It("should rewrite the Istio CRs and set the storage version as the only stored version", func() {
+		// given
+		c := createFakeClient(istioCRD("v1alpha2", "v1beta1"), istioCR.DeepCopy())
+		resourceVersion := getIstioCR(c).ResourceVersion
+
+		// when
+		err := storagemigration.NewMigrator(c, crdName).Migrate(context.Background())
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getIstioCR(c).ResourceVersion).NotTo(Equal(resourceVersion))
+		Expect(getCRD(c).Status.StoredVersions).To(Equal([]string{"v1beta1"}))
+	})
+
+	It("should not rewrite the Istio CRs if only the storage version is stored", func() {
+		// given
+		c := createFakeClient(istioCRD("v1beta1"), istioCR.DeepCopy())
+		resourceVersion := getIstioCR(c).ResourceVersion
+
+		// when
+		err := storagemigration.NewMigrator(c, crdName).Migrate(context.Background())
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getIstioCR(c).ResourceVersion).To(Equal(resourceVersion))
+		Expect(getCRD(c).Status.StoredVersions).To(Equal([]string{"v1beta1"}))
+	})
+
+	It("should return an error if the CRD has no storage version", func() {
+		// given
+		crd := istioCRD("v1alpha2")
+		crd.Spec.Versions[1].Storage = false
+		c := createFakeClient(crd)
+
+		// when
+		err := storagemigration.NewMigrator(c, crdName).Migrate(context.Background())
+
+		// then
+		Expect(err).To(MatchError("the CRD has no storage version"))
+	})
+})
+
+func createFakeClient(objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+	Expect(operatorv1beta1.AddToScheme(scheme)).To(Succeed())
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&apiextensionsv1.CustomResourceDefinition{}).
+		Build()
+}
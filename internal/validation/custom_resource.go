@@ -0,0 +1,24 @@
+package validation
+
+import (
+	"fmt"
+
+	istioCR "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+)
+
+// IstioCRNamespace is the only namespace in which an Istio CR reconciles the module.
+const IstioCRNamespace = "kyma-system"
+
+func ValidateNamespace(i istioCR.Istio) describederrors.DescribedError {
+	if i.GetNamespace() != IstioCRNamespace {
+		return describederrors.NewDescribedError(fmt.Errorf("istio CR is not in %s namespace", IstioCRNamespace), "Stopped Istio CR reconciliation")
+	}
+	return nil
+}
+
+// NewOlderCRExistsError returns the error of an Istio CR that does not reconcile the module, because the oldest Istio CR does.
+func NewOlderCRExistsError(oldest istioCR.Istio) describederrors.DescribedError {
+	err := fmt.Errorf("only Istio CR %s in %s reconciles the module", oldest.GetName(), oldest.GetNamespace())
+	return describederrors.NewDescribedError(err, "Stopped Istio CR reconciliation").SetWarning()
+}
@@ -0,0 +1,20 @@
+//go:build !experimental
+
+package validation
+
+import (
+	"errors"
+
+	istioCR "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+)
+
+// ValidateExperimental rejects experimental features, because they are only applied by the experimental flavour of the Istio module.
+func ValidateExperimental(i istioCR.Istio) describederrors.DescribedError {
+	if i.Spec.Experimental != nil {
+		return describederrors.NewDescribedError(errors.New("istio CR contains experimental feature"), "Experimental features are not supported in this image flavour").
+			SetWarning().
+			SetCondition(false)
+	}
+	return nil
+}
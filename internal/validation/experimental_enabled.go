@@ -0,0 +1,13 @@
+//go:build experimental
+
+package validation
+
+import (
+	istioCR "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+)
+
+// ValidateExperimental accepts all experimental features, because they are applied by the experimental flavour of the Istio module.
+func ValidateExperimental(_ istioCR.Istio) describederrors.DescribedError {
+	return nil
+}
@@ -0,0 +1,22 @@
+package validation
+
+import (
+	istioCR "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
+)
+
+// SpecValidations are the validations of the Istio CR spec in the order in which they are run. The reconciliation stops at the first
+// failed validation, and the validating webhook rejects the Istio CR with the same error.
+//
+//nolint:gochecknoglobals // the list is shared by the reconciliation and the validating webhook
+var SpecValidations = []func(istioCR.Istio) describederrors.DescribedError{
+	ValidateExperimental,
+	ValidateAuthorizers,
+	ValidateExtensionProviders,
+	ValidateMTLS,
+	ValidateIngressGateways,
+	ValidateIngressGatewayService,
+	ValidateLocalityLoadBalancing,
+	ValidateRootCA,
+	ValidateWorkloadCertificates,
+}
@@ -1,12 +1,19 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
+	"net"
+	"regexp"
+	"strings"
 
 	istioCR "github.com/kyma-project/istio/operator/api/v1alpha2"
 	"github.com/kyma-project/istio/operator/internal/describederrors"
+	"github.com/kyma-project/istio/operator/internal/istiooperator"
 )
 
+const meshRootNamespace = "istio-system"
+
 func ValidateAuthorizers(i istioCR.Istio) describederrors.DescribedError {
 	authorizersNameSet := make(map[string]bool)
 	for _, authorizer := range i.Spec.Config.Authorizers {
@@ -15,6 +22,375 @@ func ValidateAuthorizers(i istioCR.Istio) describederrors.DescribedError {
 			return describederrors.NewDescribedError(fmt.Errorf("%s is duplicated", authorizer.Name), "Authorizer name needs to be unique").SetWarning()
 		}
 		authorizersNameSet[authorizer.Name] = true
+
+		if err := validateAuthorizerProtocolSettings(authorizer); err != nil {
+			return describederrors.NewDescribedError(err, "Authorizer configuration does not apply to its protocol").SetWarning()
+		}
+
+		if err := validateAuthorizerFailureSettings(authorizer); err != nil {
+			return describederrors.NewDescribedError(err, "Authorizer failure settings are conflicting").SetWarning()
+		}
+	}
+	return nil
+}
+
+// ValidateExtensionProviders checks that the authorizers and tracing providers, which are all registered as extension providers in the
+// mesh config, have unique names and do not use the name of an extension provider of the module. Otherwise, a provider would silently
+// replace another provider with the same name.
+func ValidateExtensionProviders(i istioCR.Istio) describederrors.DescribedError {
+	moduleProviderNames, err := istiooperator.ModuleExtensionProviderNames()
+	if err != nil {
+		return describederrors.NewDescribedError(err, "Unable to get the extension providers of the module")
+	}
+	reservedNameSet := map[string]bool{
+		istioCR.DefaultAccessLogProviderName:     true,
+		istioCR.DefaultOtelAccessLogProviderName: true,
+	}
+	for _, name := range moduleProviderNames {
+		reservedNameSet[name] = true
+	}
+
+	providerNameSet := make(map[string]bool)
+	for _, authorizer := range i.Spec.Config.Authorizers {
+		if authorizer == nil {
+			continue
+		}
+		if reservedNameSet[authorizer.Name] {
+			return describederrors.NewDescribedError(fmt.Errorf("%s is reserved", authorizer.Name),
+				"Authorizer name must not be the name of an extension provider of the module").SetWarning()
+		}
+		providerNameSet[authorizer.Name] = true
+	}
+
+	tracing := i.Spec.Config.Telemetry.Tracing
+	if tracing == nil {
+		return nil
+	}
+	for _, provider := range tracing.Providers {
+		if provider == nil {
+			continue
+		}
+		if reservedNameSet[provider.Name] {
+			return describederrors.NewDescribedError(fmt.Errorf("%s is reserved", provider.Name),
+				"Tracing provider name must not be the name of an extension provider of the module").SetWarning()
+		}
+		if providerNameSet[provider.Name] {
+			return describederrors.NewDescribedError(fmt.Errorf("%s is duplicated", provider.Name),
+				"Extension provider name needs to be unique across authorizers and tracing providers").SetWarning()
+		}
+		providerNameSet[provider.Name] = true
+	}
+	return nil
+}
+
+func validateAuthorizerProtocolSettings(authorizer *istioCR.Authorizer) error {
+	if !authorizer.IsGRPC() {
+		return nil
+	}
+	if authorizer.PathPrefix != nil {
+		return fmt.Errorf("%s: pathPrefix is not supported for %s authorizers", authorizer.Name, istioCR.AuthorizerProtocolGRPC)
+	}
+	if authorizer.Headers != nil {
+		return fmt.Errorf("%s: headers are not supported for %s authorizers", authorizer.Name, istioCR.AuthorizerProtocolGRPC)
+	}
+	return nil
+}
+
+func validateAuthorizerFailureSettings(authorizer *istioCR.Authorizer) error {
+	if authorizer.FailOpen != nil && *authorizer.FailOpen && authorizer.StatusOnError != nil {
+		return fmt.Errorf("%s: statusOnError cannot be set when failOpen is enabled", authorizer.Name)
+	}
+	return nil
+}
+
+// ValidateMTLS checks that every namespace is listed at most once in the mTLS namespace exceptions and that the exceptions
+// do not target the istio-system namespace, which holds the mesh-wide PeerAuthentication.
+func ValidateMTLS(i istioCR.Istio) describederrors.DescribedError {
+	if i.Spec.Config.MTLS == nil {
+		return nil
+	}
+
+	namespaceSet := make(map[string]bool)
+	for _, exception := range i.Spec.Config.MTLS.NamespaceExceptions {
+		if exception.Namespace == meshRootNamespace {
+			return describederrors.NewDescribedError(fmt.Errorf("mTLS exception cannot target the %s namespace", meshRootNamespace),
+				"Use the mesh-wide mTLS mode to configure the mesh root namespace").SetWarning()
+		}
+		if namespaceSet[exception.Namespace] {
+			return describederrors.NewDescribedError(fmt.Errorf("mTLS exception for namespace %s is duplicated", exception.Namespace),
+				"mTLS exception namespace needs to be unique").SetWarning()
+		}
+		namespaceSet[exception.Namespace] = true
+	}
+	return nil
+}
+
+// ValidateIngressGateways checks that the names of the additional ingress gateways are unique and do not collide with the gateways
+// installed by the module, because the configuration of the additional gateway would replace the module gateway.
+func ValidateIngressGateways(i istioCR.Istio) describederrors.DescribedError {
+	if i.Spec.Components == nil {
+		return nil
+	}
+
+	gatewayNameSet := map[string]bool{
+		istioCR.DefaultIngressGatewayName: true,
+		istioCR.DefaultEgressGatewayName:  true,
+	}
+	for _, gateway := range i.Spec.Components.AdditionalIngressGateways {
+		if gatewayNameSet[gateway.Name] {
+			return describederrors.NewDescribedError(fmt.Errorf("ingress gateway %s is duplicated", gateway.Name),
+				"Additional ingress gateway name needs to be unique").SetWarning()
+		}
+		gatewayNameSet[gateway.Name] = true
+	}
+	return nil
+}
+
+// ValidateIngressGatewayService checks that the Service configuration of the ingress gateway applies to the configured Service type
+// and that the additional ports do not collide with each other or with the default ports.
+func ValidateIngressGatewayService(i istioCR.Istio) describederrors.DescribedError {
+	if i.Spec.Components == nil || i.Spec.Components.IngressGateway == nil || i.Spec.Components.IngressGateway.Service == nil {
+		return nil
+	}
+	service := i.Spec.Components.IngressGateway.Service
+
+	if err := validateIngressGatewayServiceType(service, i.Spec.Config.GatewayExternalTrafficPolicy); err != nil {
+		return describederrors.NewDescribedError(err, "Ingress gateway Service configuration does not apply to its type").SetWarning()
+	}
+
+	for _, sourceRange := range service.LoadBalancerSourceRanges {
+		if _, _, err := net.ParseCIDR(sourceRange); err != nil {
+			return describederrors.NewDescribedError(fmt.Errorf("loadBalancerSourceRanges entry %s is not a valid CIDR", sourceRange),
+				"Ingress gateway Service configuration is invalid").SetWarning()
+		}
+	}
+
+	portNameSet := make(map[string]bool)
+	portSet := make(map[string]bool)
+	for _, port := range append(istioCR.DefaultIngressGatewayServicePorts(), service.Ports...) {
+		protocol := "TCP"
+		if port.Protocol != nil {
+			protocol = *port.Protocol
+		}
+		portKey := fmt.Sprintf("%d/%s", port.Port, protocol)
+		if portNameSet[port.Name] || portSet[portKey] {
+			return describederrors.NewDescribedError(fmt.Errorf("ingress gateway Service port %s (%s) is duplicated", port.Name, portKey),
+				"Ingress gateway Service port name and port need to be unique").SetWarning()
+		}
+		portNameSet[port.Name] = true
+		portSet[portKey] = true
+	}
+	return nil
+}
+
+func validateIngressGatewayServiceType(service *istioCR.IngressGatewayService, externalTrafficPolicy *string) error {
+	serviceType := service.ServiceType()
+	if serviceType == istioCR.IngressGatewayServiceTypeLoadBalancer {
+		return nil
+	}
+	if len(service.LoadBalancerSourceRanges) > 0 {
+		return fmt.Errorf("loadBalancerSourceRanges are not supported for Service type %s", serviceType)
+	}
+	if service.LoadBalancerClass != nil {
+		return fmt.Errorf("loadBalancerClass is not supported for Service type %s", serviceType)
+	}
+	if serviceType != istioCR.IngressGatewayServiceTypeClusterIP {
+		return nil
+	}
+	if externalTrafficPolicy != nil {
+		return fmt.Errorf("gatewayExternalTrafficPolicy is not supported for Service type %s", serviceType)
+	}
+	for _, port := range service.Ports {
+		if port.NodePort != nil {
+			return fmt.Errorf("nodePort of port %s is not supported for Service type %s", port.Name, serviceType)
+		}
+	}
+	return nil
+}
+
+// ValidateAccessLog checks whether the access log configuration is applied as defined. The returned error is a warning,
+// because in both cases the configuration is still applied, either with the default labels or without the ignored keys.
+func ValidateAccessLog(i istioCR.Istio) describederrors.DescribedError {
+	accessLog := i.Spec.Config.AccessLog
+	if accessLog == nil {
+		return nil
+	}
+
+	if len(accessLog.Labels) == 0 {
+		return describederrors.NewDescribedError(errors.New("accessLog does not define any labels"), "Default access log format is applied").SetWarning()
+	}
+
+	emptyKeys := accessLog.EmptyLabelKeys()
+	if len(emptyKeys) > 0 {
+		return describederrors.NewDescribedError(fmt.Errorf("accessLog labels with empty values are ignored: %s", strings.Join(emptyKeys, ", ")),
+			"Access log format is applied partially").SetWarning()
+	}
+	return nil
+}
+
+// ValidateLocalityLoadBalancing checks the syntax of the localities used in the locality load balancing configuration. A locality has the
+// format "region/zone/subzone", where zone and subzone are optional. Failover is defined between regions, and the traffic distribution of
+// a locality must add up to 100 percent.
+func ValidateLocalityLoadBalancing(i istioCR.Istio) describederrors.DescribedError {
+	localityLoadBalancing := i.Spec.Config.LocalityLoadBalancing
+	if localityLoadBalancing == nil {
+		return nil
+	}
+
+	if err := validateLocalityLoadBalancing(localityLoadBalancing); err != nil {
+		return describederrors.NewDescribedError(err, "Locality load balancing configuration is invalid").SetWarning()
+	}
+	return nil
+}
+
+func validateLocalityLoadBalancing(localityLoadBalancing *istioCR.LocalityLoadBalancing) error {
+	if len(localityLoadBalancing.Failover) > 0 && len(localityLoadBalancing.Distribute) > 0 {
+		return errors.New("failover and distribute cannot be set at the same time")
+	}
+
+	failoverRegions := make(map[string]bool)
+	for _, failover := range localityLoadBalancing.Failover {
+		if err := validateRegion(failover.From); err != nil {
+			return fmt.Errorf("failover from %s: %w", failover.From, err)
+		}
+		if err := validateRegion(failover.To); err != nil {
+			return fmt.Errorf("failover to %s: %w", failover.To, err)
+		}
+		if failover.From == failover.To {
+			return fmt.Errorf("failover from region %s must be different from the region it fails over to", failover.From)
+		}
+		if failoverRegions[failover.From] {
+			return fmt.Errorf("failover from region %s is duplicated", failover.From)
+		}
+		failoverRegions[failover.From] = true
+	}
+
+	distributeLocalities := make(map[string]bool)
+	for _, distribute := range localityLoadBalancing.Distribute {
+		if err := validateLocality(distribute.From); err != nil {
+			return fmt.Errorf("distribute from %s: %w", distribute.From, err)
+		}
+		if distributeLocalities[distribute.From] {
+			return fmt.Errorf("distribute from locality %s is duplicated", distribute.From)
+		}
+		distributeLocalities[distribute.From] = true
+
+		var totalWeight uint32
+		for locality, weight := range distribute.To {
+			if err := validateLocality(locality); err != nil {
+				return fmt.Errorf("distribute from %s to %s: %w", distribute.From, locality, err)
+			}
+			if weight == 0 || weight > maxLocalityWeight {
+				return fmt.Errorf("distribute from %s to %s: weight %d must be between 1 and %d", distribute.From, locality, weight, maxLocalityWeight)
+			}
+			totalWeight += weight
+		}
+		if totalWeight != maxLocalityWeight {
+			return fmt.Errorf("distribute from %s: weights add up to %d instead of %d", distribute.From, totalWeight, maxLocalityWeight)
+		}
+	}
+	return nil
+}
+
+const (
+	maxLocalityWeight   = 100
+	maxLocalitySegments = 3
+	localityWildcard    = "*"
+)
+
+//nolint:gochecknoglobals // compiled once, a locality segment has the syntax of a Kubernetes label value
+var localitySegmentRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+
+// validateLocality checks that the locality has the format "region/zone/subzone", where zone and subzone are optional.
+// The wildcard "*" is only allowed as the last segment.
+func validateLocality(locality string) error {
+	segments := strings.Split(locality, "/")
+	if len(segments) > maxLocalitySegments {
+		return errors.New("locality must have the format region/zone/subzone")
+	}
+	for index, segment := range segments {
+		if segment == localityWildcard {
+			if index != len(segments)-1 {
+				return errors.New("wildcard is only allowed as the last segment of the locality")
+			}
+			continue
+		}
+		if !localitySegmentRegexp.MatchString(segment) {
+			return fmt.Errorf("locality segment %q is not valid", segment)
+		}
+	}
+	return nil
+}
+
+func validateRegion(region string) error {
+	if strings.Contains(region, "/") {
+		return errors.New("failover must be defined between regions")
+	}
+	if !localitySegmentRegexp.MatchString(region) {
+		return fmt.Errorf("region %q is not valid", region)
+	}
+	return nil
+}
+
+func ValidateRootCA(i istioCR.Istio) describederrors.DescribedError {
+	rootCA := i.Spec.Config.RootCA
+	if rootCA == nil {
+		return nil
+	}
+
+	if err := validateRootCA(i.Spec.Config); err != nil {
+		return describederrors.NewDescribedError(err, "Root CA configuration is invalid").SetWarning()
+	}
+	return nil
+}
+
+func validateRootCA(config istioCR.Config) error {
+	warningThreshold, errorThreshold := config.RootCAExpiryThresholds()
+	if warningThreshold <= 0 || errorThreshold <= 0 {
+		return errors.New("expiry thresholds must be greater than 0")
+	}
+	if errorThreshold >= warningThreshold {
+		return fmt.Errorf("expiryErrorThreshold %s must be lower than expiryWarningThreshold %s", errorThreshold, warningThreshold)
+	}
+	if config.RootCA.AutomaticRotation && config.PluginCASecret() == nil {
+		return errors.New("automaticRotation requires a plug-in CA configured in certificateAuthority.secret")
+	}
+	return nil
+}
+
+func ValidateWorkloadCertificates(i istioCR.Istio) describederrors.DescribedError {
+	workloadCertificates := i.Spec.Config.WorkloadCertificates
+	if workloadCertificates == nil {
+		return nil
+	}
+
+	if err := validateWorkloadCertificates(workloadCertificates); err != nil {
+		return describederrors.NewDescribedError(err, "Workload certificates configuration is invalid").SetWarning()
+	}
+	return nil
+}
+
+func validateWorkloadCertificates(w *istioCR.WorkloadCertificates) error {
+	defaultTTL, maxTTL := w.TTLs()
+	if defaultTTL <= 0 || maxTTL <= 0 {
+		return errors.New("defaultTTL and maxTTL must be greater than 0")
+	}
+	if defaultTTL > maxTTL {
+		return fmt.Errorf("defaultTTL %s must not exceed maxTTL %s", defaultTTL, maxTTL)
+	}
+
+	if w.KeySize == nil {
+		return nil
+	}
+	switch w.GetKeyAlgorithm() {
+	case istioCR.KeyAlgorithmECDSA:
+		if _, ok := istioCR.ECCCurve(*w.KeySize); !ok {
+			return fmt.Errorf("keySize %d is not supported for ECDSA, supported sizes are 256 and 384", *w.KeySize)
+		}
+	case istioCR.KeyAlgorithmRSA:
+		if *w.KeySize != 2048 && *w.KeySize != 3072 && *w.KeySize != 4096 {
+			return fmt.Errorf("keySize %d is not supported for RSA, supported sizes are 2048, 3072, and 4096", *w.KeySize)
+		}
 	}
 	return nil
 }
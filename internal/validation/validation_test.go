@@ -2,11 +2,14 @@ package validation_test
 
 import (
 	istioCR "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/describederrors"
 	"github.com/kyma-project/istio/operator/internal/tests"
 	"github.com/kyma-project/istio/operator/internal/validation"
 	"github.com/onsi/ginkgo/v2/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -84,4 +87,756 @@ var _ = Describe("Validation", func() {
 		Expect(err.Error()).To(Equal("test-authorizer is duplicated"))
 	})
 
+	It("should successfully validate gRPC authorizer with gRPC specific settings", func() {
+		//given
+		istioCr := istioCR.Istio{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: istioCR.IstioSpec{
+				Config: istioCR.Config{
+					Authorizers: []*istioCR.Authorizer{
+						{
+							Name:     "test-authorizer",
+							Service:  "test",
+							Port:     9191,
+							Protocol: istioCR.AuthorizerProtocolGRPC,
+							FailOpen: ptr.To(true),
+							IncludeRequestBodyInCheck: &istioCR.RequestBody{
+								MaxRequestBytes: 1024,
+							},
+						},
+					},
+				},
+			},
+		}
+		//when
+		err := validation.ValidateAuthorizers(istioCr)
+
+		//then
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should fail to validate gRPC authorizer with pathPrefix", func() {
+		//given
+		istioCr := istioCR.Istio{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: istioCR.IstioSpec{
+				Config: istioCR.Config{
+					Authorizers: []*istioCR.Authorizer{
+						{
+							Name:       "test-authorizer",
+							Service:    "test",
+							Port:       9191,
+							Protocol:   istioCR.AuthorizerProtocolGRPC,
+							PathPrefix: ptr.To("/auth"),
+						},
+					},
+				},
+			},
+		}
+		//when
+		err := validation.ValidateAuthorizers(istioCr)
+
+		//then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("test-authorizer: pathPrefix is not supported for GRPC authorizers"))
+	})
+
+	It("should fail to validate gRPC authorizer with headers", func() {
+		//given
+		istioCr := istioCR.Istio{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: istioCR.IstioSpec{
+				Config: istioCR.Config{
+					Authorizers: []*istioCR.Authorizer{
+						{
+							Name:     "test-authorizer",
+							Service:  "test",
+							Port:     9191,
+							Protocol: istioCR.AuthorizerProtocolGRPC,
+							Headers: &istioCR.Headers{
+								InCheck: &istioCR.InCheck{Include: []string{"authorization"}},
+							},
+						},
+					},
+				},
+			},
+		}
+		//when
+		err := validation.ValidateAuthorizers(istioCr)
+
+		//then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("test-authorizer: headers are not supported for GRPC authorizers"))
+	})
+
+	It("should successfully validate HTTP authorizer with failure and request body settings", func() {
+		//given
+		istioCr := istioCR.Istio{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: istioCR.IstioSpec{
+				Config: istioCR.Config{
+					Authorizers: []*istioCR.Authorizer{
+						{
+							Name:          "test-authorizer",
+							Service:       "test",
+							Port:          2318,
+							FailOpen:      ptr.To(false),
+							StatusOnError: ptr.To("503"),
+							IncludeRequestBodyInCheck: &istioCR.RequestBody{
+								MaxRequestBytes: 1024,
+							},
+						},
+					},
+				},
+			},
+		}
+		//when
+		err := validation.ValidateAuthorizers(istioCr)
+
+		//then
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should fail to validate authorizer with statusOnError when failOpen is enabled", func() {
+		//given
+		istioCr := istioCR.Istio{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: istioCR.IstioSpec{
+				Config: istioCR.Config{
+					Authorizers: []*istioCR.Authorizer{
+						{
+							Name:          "test-authorizer",
+							Service:       "test",
+							Port:          2318,
+							FailOpen:      ptr.To(true),
+							StatusOnError: ptr.To("503"),
+						},
+					},
+				},
+			},
+		}
+		//when
+		err := validation.ValidateAuthorizers(istioCr)
+
+		//then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("test-authorizer: statusOnError cannot be set when failOpen is enabled"))
+	})
+
+	Context("mTLS", func() {
+		It("should successfully validate unique namespace exceptions", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						MTLS: &istioCR.MTLS{
+							Mode: istioCR.MTLSModeStrict,
+							NamespaceExceptions: []istioCR.MTLSNamespaceException{
+								{Namespace: "legacy", Mode: istioCR.MTLSModePermissive},
+								{Namespace: "other", Mode: istioCR.MTLSModePermissive},
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateMTLS(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail to validate if a namespace exception is duplicated", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						MTLS: &istioCR.MTLS{
+							NamespaceExceptions: []istioCR.MTLSNamespaceException{
+								{Namespace: "legacy", Mode: istioCR.MTLSModePermissive},
+								{Namespace: "legacy", Mode: istioCR.MTLSModeStrict},
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateMTLS(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Level()).To(Equal(describederrors.Warning))
+			Expect(err.Error()).To(Equal("mTLS exception for namespace legacy is duplicated"))
+		})
+
+		It("should fail to validate if a namespace exception targets istio-system", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						MTLS: &istioCR.MTLS{
+							NamespaceExceptions: []istioCR.MTLSNamespaceException{
+								{Namespace: "istio-system", Mode: istioCR.MTLSModePermissive},
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateMTLS(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("mTLS exception cannot target the istio-system namespace"))
+		})
+	})
+
+	Context("Additional ingress gateways", func() {
+		It("should successfully validate additional ingress gateways with unique names", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Components: &istioCR.Components{
+						AdditionalIngressGateways: []istioCR.AdditionalIngressGateway{
+							{Name: "internal-ingressgateway"},
+							{Name: "partner-ingressgateway"},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGateways(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail to validate if an additional ingress gateway name is duplicated", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Components: &istioCR.Components{
+						AdditionalIngressGateways: []istioCR.AdditionalIngressGateway{
+							{Name: "internal-ingressgateway"},
+							{Name: "internal-ingressgateway"},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGateways(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Level()).To(Equal(describederrors.Warning))
+			Expect(err.Error()).To(Equal("ingress gateway internal-ingressgateway is duplicated"))
+		})
+
+		It("should fail to validate if an additional ingress gateway uses the name of the default ingress gateway", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Components: &istioCR.Components{
+						AdditionalIngressGateways: []istioCR.AdditionalIngressGateway{
+							{Name: "istio-ingressgateway"},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGateways(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("ingress gateway istio-ingressgateway is duplicated"))
+		})
+	})
+
+	Context("Ingress gateway Service", func() {
+		It("should successfully validate LoadBalancer Service with additional ports and source ranges", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Components: &istioCR.Components{
+						IngressGateway: &istioCR.IngressGateway{
+							Service: &istioCR.IngressGatewayService{
+								Ports:                    []istioCR.IngressGatewayServicePort{{Name: "tcp-mqtt", Port: 8883}},
+								LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGatewayService(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail to validate loadBalancerSourceRanges for ClusterIP Service", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Components: &istioCR.Components{
+						IngressGateway: &istioCR.IngressGateway{
+							Service: &istioCR.IngressGatewayService{
+								Type:                     istioCR.IngressGatewayServiceTypeClusterIP,
+								LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGatewayService(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Level()).To(Equal(describederrors.Warning))
+			Expect(err.Error()).To(Equal("loadBalancerSourceRanges are not supported for Service type ClusterIP"))
+		})
+
+		It("should fail to validate gatewayExternalTrafficPolicy for ClusterIP Service", func() {
+			//given
+			externalTrafficPolicy := "Local"
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						GatewayExternalTrafficPolicy: &externalTrafficPolicy,
+					},
+					Components: &istioCR.Components{
+						IngressGateway: &istioCR.IngressGateway{
+							Service: &istioCR.IngressGatewayService{
+								Type: istioCR.IngressGatewayServiceTypeClusterIP,
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGatewayService(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("gatewayExternalTrafficPolicy is not supported for Service type ClusterIP"))
+		})
+
+		It("should fail to validate invalid loadBalancerSourceRanges entry", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Components: &istioCR.Components{
+						IngressGateway: &istioCR.IngressGateway{
+							Service: &istioCR.IngressGatewayService{
+								LoadBalancerSourceRanges: []string{"10.0.0.1"},
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGatewayService(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("loadBalancerSourceRanges entry 10.0.0.1 is not a valid CIDR"))
+		})
+
+		It("should fail to validate additional port colliding with a default port", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Components: &istioCR.Components{
+						IngressGateway: &istioCR.IngressGateway{
+							Service: &istioCR.IngressGatewayService{
+								Ports: []istioCR.IngressGatewayServicePort{{Name: "http", Port: 80}},
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateIngressGatewayService(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("ingress gateway Service port http (80/TCP) is duplicated"))
+		})
+	})
+
+	Context("Access log", func() {
+		It("should successfully validate if access log is not configured", func() {
+			//given
+			istioCr := istioCR.Istio{}
+
+			//when
+			err := validation.ValidateAccessLog(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should successfully validate access log with labels", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						AccessLog: &istioCR.AccessLog{
+							Labels: map[string]string{"tenant": "%REQ(X-TENANT)%"},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateAccessLog(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return a warning if access log does not define labels", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						AccessLog: &istioCR.AccessLog{
+							Strategy: istioCR.AccessLogStrategyReplace,
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateAccessLog(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Level()).To(Equal(describederrors.Warning))
+			Expect(err.Error()).To(Equal("accessLog does not define any labels"))
+		})
+
+		It("should return a warning listing labels with empty values", func() {
+			//given
+			istioCr := istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						AccessLog: &istioCR.AccessLog{
+							Labels: map[string]string{
+								"tenant": "%REQ(X-TENANT)%",
+								"b":      "",
+								"a":      "",
+							},
+						},
+					},
+				},
+			}
+
+			//when
+			err := validation.ValidateAccessLog(istioCr)
+
+			//then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Level()).To(Equal(describederrors.Warning))
+			Expect(err.Error()).To(Equal("accessLog labels with empty values are ignored: a, b"))
+		})
+	})
+
+	Context("Extension providers", func() {
+		istioWithProviders := func(authorizerNames []string, tracingProviderNames []string) istioCR.Istio {
+			istio := istioCR.Istio{Spec: istioCR.IstioSpec{Config: istioCR.Config{
+				Telemetry: istioCR.Telemetry{Tracing: &istioCR.Tracing{}},
+			}}}
+			for _, name := range authorizerNames {
+				istio.Spec.Config.Authorizers = append(istio.Spec.Config.Authorizers, &istioCR.Authorizer{Name: name, Service: "authz", Port: 8080})
+			}
+			for _, name := range tracingProviderNames {
+				istio.Spec.Config.Telemetry.Tracing.Providers = append(istio.Spec.Config.Telemetry.Tracing.Providers,
+					&istioCR.TracingProvider{Name: name, Type: istioCR.TracingProviderOpenTelemetry, Service: "collector", Port: 4317})
+			}
+			return istio
+		}
+
+		It("should successfully validate unique provider names", func() {
+			//when
+			err := validation.ValidateExtensionProviders(istioWithProviders([]string{"authz"}, []string{"otel", "zipkin"}))
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should fail validation of duplicated or reserved provider names",
+			func(authorizerNames []string, tracingProviderNames []string, expectedError string) {
+				//when
+				err := validation.ValidateExtensionProviders(istioWithProviders(authorizerNames, tracingProviderNames))
+
+				//then
+				Expect(err).To(HaveOccurred())
+				Expect(err.Level()).To(Equal(describederrors.Warning))
+				Expect(err.Error()).To(Equal(expectedError))
+			},
+			Entry("tracing provider named like an authorizer", []string{"authz"}, []string{"authz"}, "authz is duplicated"),
+			Entry("duplicated tracing providers", nil, []string{"otel", "otel"}, "otel is duplicated"),
+			Entry("tracing provider named like the default access log provider", nil, []string{istioCR.DefaultAccessLogProviderName},
+				istioCR.DefaultAccessLogProviderName+" is reserved"),
+			Entry("tracing provider named like a module tracing provider", nil, []string{"kyma-traces"}, "kyma-traces is reserved"),
+			Entry("authorizer named like the default access log provider", []string{istioCR.DefaultOtelAccessLogProviderName}, nil,
+				istioCR.DefaultOtelAccessLogProviderName+" is reserved"),
+			Entry("authorizer named like a module access log provider", []string{"envoy"}, nil, "envoy is reserved"),
+			Entry("authorizer named like the module otel access log provider", []string{"kyma-logs"}, nil, "kyma-logs is reserved"),
+		)
+	})
+
+	Context("Locality load balancing", func() {
+		istioWithLocalityLoadBalancing := func(localityLoadBalancing istioCR.LocalityLoadBalancing) istioCR.Istio {
+			return istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						LocalityLoadBalancing: &localityLoadBalancing,
+					},
+				},
+			}
+		}
+
+		It("should successfully validate if locality load balancing is not configured", func() {
+			//given
+			istioCr := istioCR.Istio{}
+
+			//when
+			err := validation.ValidateLocalityLoadBalancing(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should successfully validate",
+			func(localityLoadBalancing istioCR.LocalityLoadBalancing) {
+				//when
+				err := validation.ValidateLocalityLoadBalancing(istioWithLocalityLoadBalancing(localityLoadBalancing))
+
+				//then
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry("failover between regions", istioCR.LocalityLoadBalancing{
+				Enabled:  true,
+				Failover: []istioCR.LocalityFailover{{From: "eu-central-1", To: "eu-west-1"}, {From: "eu-west-1", To: "eu-central-1"}},
+			}),
+			Entry("distribution across zones", istioCR.LocalityLoadBalancing{
+				Enabled: true,
+				Distribute: []istioCR.LocalityDistribute{
+					{From: "eu-central-1/eu-central-1a/*", To: map[string]uint32{"eu-central-1/eu-central-1a/*": 80, "eu-central-1/eu-central-1b/*": 20}},
+					{From: "eu-central-1/*", To: map[string]uint32{"eu-central-1/*": 100}},
+				},
+			}),
+			Entry("enabled without rules", istioCR.LocalityLoadBalancing{Enabled: true}),
+		)
+
+		DescribeTable("should fail to validate",
+			func(localityLoadBalancing istioCR.LocalityLoadBalancing, expectedError string) {
+				//when
+				err := validation.ValidateLocalityLoadBalancing(istioWithLocalityLoadBalancing(localityLoadBalancing))
+
+				//then
+				Expect(err).To(HaveOccurred())
+				Expect(err.Level()).To(Equal(describederrors.Warning))
+				Expect(err.Description()).To(ContainSubstring("Locality load balancing configuration is invalid"))
+				Expect(err.Error()).To(Equal(expectedError))
+			},
+			Entry("failover and distribute at the same time", istioCR.LocalityLoadBalancing{
+				Failover:   []istioCR.LocalityFailover{{From: "eu-central-1", To: "eu-west-1"}},
+				Distribute: []istioCR.LocalityDistribute{{From: "eu-central-1/*", To: map[string]uint32{"eu-central-1/*": 100}}},
+			}, "failover and distribute cannot be set at the same time"),
+			Entry("failover from a zone", istioCR.LocalityLoadBalancing{
+				Failover: []istioCR.LocalityFailover{{From: "eu-central-1/eu-central-1a", To: "eu-west-1"}},
+			}, "failover from eu-central-1/eu-central-1a: failover must be defined between regions"),
+			Entry("failover to an invalid region", istioCR.LocalityLoadBalancing{
+				Failover: []istioCR.LocalityFailover{{From: "eu-central-1", To: "eu west"}},
+			}, `failover to eu west: region "eu west" is not valid`),
+			Entry("failover to the same region", istioCR.LocalityLoadBalancing{
+				Failover: []istioCR.LocalityFailover{{From: "eu-central-1", To: "eu-central-1"}},
+			}, "failover from region eu-central-1 must be different from the region it fails over to"),
+			Entry("duplicated failover region", istioCR.LocalityLoadBalancing{
+				Failover: []istioCR.LocalityFailover{{From: "eu-central-1", To: "eu-west-1"}, {From: "eu-central-1", To: "us-east-1"}},
+			}, "failover from region eu-central-1 is duplicated"),
+			Entry("locality with too many segments", istioCR.LocalityLoadBalancing{
+				Distribute: []istioCR.LocalityDistribute{{From: "a/b/c/d", To: map[string]uint32{"a/*": 100}}},
+			}, "distribute from a/b/c/d: locality must have the format region/zone/subzone"),
+			Entry("wildcard that is not the last segment", istioCR.LocalityLoadBalancing{
+				Distribute: []istioCR.LocalityDistribute{{From: "a/*/c", To: map[string]uint32{"a/*": 100}}},
+			}, "distribute from a/*/c: wildcard is only allowed as the last segment of the locality"),
+			Entry("empty locality segment", istioCR.LocalityLoadBalancing{
+				Distribute: []istioCR.LocalityDistribute{{From: "a//c", To: map[string]uint32{"a/*": 100}}},
+			}, `distribute from a//c: locality segment "" is not valid`),
+			Entry("invalid target locality", istioCR.LocalityLoadBalancing{
+				Distribute: []istioCR.LocalityDistribute{{From: "a/*", To: map[string]uint32{"a/zone 1": 100}}},
+			}, `distribute from a/* to a/zone 1: locality segment "zone 1" is not valid`),
+			Entry("weights not adding up to 100", istioCR.LocalityLoadBalancing{
+				Distribute: []istioCR.LocalityDistribute{{From: "a/*", To: map[string]uint32{"a/b": 50, "a/c": 40}}},
+			}, "distribute from a/*: weights add up to 90 instead of 100"),
+			Entry("zero weight", istioCR.LocalityLoadBalancing{
+				Distribute: []istioCR.LocalityDistribute{{From: "a/*", To: map[string]uint32{"a/b": 100, "a/c": 0}}},
+			}, "distribute from a/* to a/c: weight 0 must be between 1 and 100"),
+			Entry("duplicated distribute locality", istioCR.LocalityLoadBalancing{
+				Distribute: []istioCR.LocalityDistribute{
+					{From: "a/*", To: map[string]uint32{"a/*": 100}},
+					{From: "a/*", To: map[string]uint32{"b/*": 100}},
+				},
+			}, "distribute from locality a/* is duplicated"),
+		)
+	})
+
+	Context("Root CA", func() {
+		istioWithRootCA := func(rootCA istioCR.RootCA, certificateAuthority *istioCR.CertificateAuthority) istioCR.Istio {
+			return istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						RootCA:               &rootCA,
+						CertificateAuthority: certificateAuthority,
+					},
+				},
+			}
+		}
+		pluginCA := &istioCR.CertificateAuthority{Secret: &istioCR.CASecretReference{Name: "my-ca", Namespace: "my-namespace"}}
+
+		It("should successfully validate if root CA is not configured", func() {
+			//given
+			istioCr := istioCR.Istio{}
+
+			//when
+			err := validation.ValidateRootCA(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should successfully validate",
+			func(rootCA istioCR.RootCA, certificateAuthority *istioCR.CertificateAuthority) {
+				//when
+				err := validation.ValidateRootCA(istioWithRootCA(rootCA, certificateAuthority))
+
+				//then
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry("default thresholds", istioCR.RootCA{}, nil),
+			Entry("custom thresholds", istioCR.RootCA{
+				ExpiryWarningThreshold: &metav1.Duration{Duration: 1440 * time.Hour},
+				ExpiryErrorThreshold:   &metav1.Duration{Duration: 336 * time.Hour},
+			}, nil),
+			Entry("automatic rotation with plug-in CA", istioCR.RootCA{AutomaticRotation: true}, pluginCA),
+		)
+
+		DescribeTable("should fail to validate",
+			func(rootCA istioCR.RootCA, certificateAuthority *istioCR.CertificateAuthority, expectedError string) {
+				//when
+				err := validation.ValidateRootCA(istioWithRootCA(rootCA, certificateAuthority))
+
+				//then
+				Expect(err).To(HaveOccurred())
+				Expect(err.Level()).To(Equal(describederrors.Warning))
+				Expect(err.Description()).To(ContainSubstring("Root CA configuration is invalid"))
+				Expect(err.Error()).To(Equal(expectedError))
+			},
+			Entry("error threshold above the warning threshold", istioCR.RootCA{
+				ExpiryErrorThreshold: &metav1.Duration{Duration: 1000 * time.Hour},
+			}, nil, "expiryErrorThreshold 1000h0m0s must be lower than expiryWarningThreshold 720h0m0s"),
+			Entry("zero threshold", istioCR.RootCA{
+				ExpiryErrorThreshold: &metav1.Duration{},
+			}, nil, "expiry thresholds must be greater than 0"),
+			Entry("automatic rotation without plug-in CA", istioCR.RootCA{AutomaticRotation: true},
+				&istioCR.CertificateAuthority{CAAddress: ptr.To("istio-csr.cert-manager.svc:443")},
+				"automaticRotation requires a plug-in CA configured in certificateAuthority.secret"),
+		)
+	})
+
+	Context("Workload certificates", func() {
+		istioWithWorkloadCertificates := func(workloadCertificates istioCR.WorkloadCertificates) istioCR.Istio {
+			return istioCR.Istio{
+				Spec: istioCR.IstioSpec{
+					Config: istioCR.Config{
+						WorkloadCertificates: &workloadCertificates,
+					},
+				},
+			}
+		}
+		rsa := ptr.To(istioCR.KeyAlgorithmRSA)
+		ecdsa := ptr.To(istioCR.KeyAlgorithmECDSA)
+
+		It("should successfully validate if workload certificates are not configured", func() {
+			//given
+			istioCr := istioCR.Istio{}
+
+			//when
+			err := validation.ValidateWorkloadCertificates(istioCr)
+
+			//then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should successfully validate",
+			func(workloadCertificates istioCR.WorkloadCertificates) {
+				//when
+				err := validation.ValidateWorkloadCertificates(istioWithWorkloadCertificates(workloadCertificates))
+
+				//then
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry("empty configuration", istioCR.WorkloadCertificates{}),
+			Entry("custom TTLs", istioCR.WorkloadCertificates{
+				DefaultTTL: &metav1.Duration{Duration: 12 * time.Hour},
+				MaxTTL:     &metav1.Duration{Duration: 48 * time.Hour},
+			}),
+			Entry("RSA key size", istioCR.WorkloadCertificates{KeyAlgorithm: rsa, KeySize: ptr.To(int32(4096))}),
+			Entry("ECDSA key size", istioCR.WorkloadCertificates{KeyAlgorithm: ecdsa, KeySize: ptr.To(int32(384))}),
+			Entry("key size without algorithm", istioCR.WorkloadCertificates{KeySize: ptr.To(int32(3072))}),
+		)
+
+		DescribeTable("should fail to validate",
+			func(workloadCertificates istioCR.WorkloadCertificates, expectedError string) {
+				//when
+				err := validation.ValidateWorkloadCertificates(istioWithWorkloadCertificates(workloadCertificates))
+
+				//then
+				Expect(err).To(HaveOccurred())
+				Expect(err.Level()).To(Equal(describederrors.Warning))
+				Expect(err.Description()).To(ContainSubstring("Workload certificates configuration is invalid"))
+				Expect(err.Error()).To(Equal(expectedError))
+			},
+			Entry("zero TTL", istioCR.WorkloadCertificates{
+				DefaultTTL: &metav1.Duration{},
+			}, "defaultTTL and maxTTL must be greater than 0"),
+			Entry("default TTL above the max TTL", istioCR.WorkloadCertificates{
+				DefaultTTL: &metav1.Duration{Duration: 48 * time.Hour},
+				MaxTTL:     &metav1.Duration{Duration: 12 * time.Hour},
+			}, "defaultTTL 48h0m0s must not exceed maxTTL 12h0m0s"),
+			Entry("max TTL below the Istio default TTL", istioCR.WorkloadCertificates{
+				MaxTTL: &metav1.Duration{Duration: 12 * time.Hour},
+			}, "defaultTTL 24h0m0s must not exceed maxTTL 12h0m0s"),
+			Entry("ECDSA key size for RSA", istioCR.WorkloadCertificates{KeyAlgorithm: rsa, KeySize: ptr.To(int32(256))},
+				"keySize 256 is not supported for RSA, supported sizes are 2048, 3072, and 4096"),
+			Entry("RSA key size for ECDSA", istioCR.WorkloadCertificates{KeyAlgorithm: ecdsa, KeySize: ptr.To(int32(2048))},
+				"keySize 2048 is not supported for ECDSA, supported sizes are 256 and 384"),
+		)
+	})
 })
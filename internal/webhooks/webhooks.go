@@ -8,6 +8,7 @@ import (
 	"github.com/thoas/go-funk"
 	"istio.io/api/label"
 	v1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/avast/retry-go"
@@ -18,6 +19,7 @@ const (
 	retriesCount        = 5
 	delayBetweenRetries = 5 * time.Second
 	IstioTagLabel       = "istio.io/tag"
+	IstioInjectionLabel = "istio-injection"
 )
 
 func GetDeactivatedLabel() map[string]string {
@@ -59,6 +61,58 @@ func DeleteConflictedDefaultTag(ctx context.Context, kubeClient client.Client) e
 	return nil
 }
 
+// GetRevisionTags returns the revision each revision tag points to. Tags are the istio.io/tag labels of the tag webhooks.
+func GetRevisionTags(ctx context.Context, kubeClient client.Client) (map[string]string, error) {
+	var webhooks v1.MutatingWebhookConfigurationList
+	err := kubeClient.List(ctx, &webhooks, client.HasLabels{IstioTagLabel})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	for _, wh := range webhooks.Items {
+		tags[wh.Labels[IstioTagLabel]] = wh.Labels[label.IoIstioRev.Name]
+	}
+	return tags, nil
+}
+
+// GetDefaultRevision returns the revision the default revision tag points to. Namespaces labeled with istio-injection=enabled
+// are injected by this revision. If there is no default revision tag, the default revision is returned.
+func GetDefaultRevision(ctx context.Context, kubeClient client.Client) (string, error) {
+	webhooks, err := getWebhooksWithTag(ctx, kubeClient, tag.DefaultRevisionName)
+	if err != nil {
+		return "", err
+	}
+
+	for _, wh := range webhooks {
+		if revision, ok := wh.Labels[label.IoIstioRev.Name]; ok && revision != "" {
+			return revision, nil
+		}
+	}
+	return tag.DefaultRevisionName, nil
+}
+
+// InjectionRevision returns the revision that injects sidecar proxies into Pods of the namespace. The istio-injection label takes
+// precedence over the istio.io/rev label, which can point to a revision tag. Namespaces without these labels are handled by the
+// default revision tag for Pods requesting injection themselves.
+func InjectionRevision(ns corev1.Namespace, tags map[string]string) string {
+	defaultRevision, ok := tags[tag.DefaultRevisionName]
+	if !ok {
+		defaultRevision = tag.DefaultRevisionName
+	}
+
+	if ns.Labels[IstioInjectionLabel] == "enabled" {
+		return defaultRevision
+	}
+	if rev, ok := ns.Labels[label.IoIstioRev.Name]; ok {
+		if tagRevision, isTag := tags[rev]; isTag {
+			return tagRevision
+		}
+		return rev
+	}
+	return defaultRevision
+}
+
 // getWebhooksWithTag returns webhooks tagged with istio.io/tag=<tag>.
 // This implementation is the same as in istioctl/pkg/tag/util package, but migrated to controller runtime client.
 func getWebhooksWithTag(ctx context.Context, kubeClient client.Client, tag string) ([]v1.MutatingWebhookConfiguration, error) {
@@ -150,3 +150,70 @@ var _ = Describe("DeleteConflictedDefaultTag", func() {
 		Expect(err).NotTo(HaveOccurred())
 	})
 })
+
+var _ = Describe("GetDefaultRevision", func() {
+	It("should return the revision of the default revision tag", func() {
+		// given
+		taggedMwcObj := createMutatingWebhookWithSelector(taggedWhName, map[string]string{tagLabelKey: tag.DefaultRevisionName, revLabelKey: "1-27-1"}, validSelector)
+		kubeclient := createFakeClient(taggedMwcObj)
+
+		// when
+		revision, err := GetDefaultRevision(context.Background(), kubeclient)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revision).To(Equal("1-27-1"))
+	})
+
+	It("should return the default revision if there is no default revision tag", func() {
+		// given
+		defaultMwcObj := createMutatingWebhookWithSelector(defaultWhName, map[string]string{revLabelKey: tag.DefaultRevisionName}, validSelector)
+		kubeclient := createFakeClient(defaultMwcObj)
+
+		// when
+		revision, err := GetDefaultRevision(context.Background(), kubeclient)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revision).To(Equal(tag.DefaultRevisionName))
+	})
+})
+
+var _ = Describe("GetRevisionTags", func() {
+	It("should return the revision of every revision tag", func() {
+		// given
+		defaultMwcObj := createMutatingWebhookWithSelector(defaultWhName, map[string]string{revLabelKey: tag.DefaultRevisionName}, validSelector)
+		taggedMwcObj := createMutatingWebhookWithSelector(taggedWhName, map[string]string{tagLabelKey: tag.DefaultRevisionName, revLabelKey: tag.DefaultRevisionName}, validSelector)
+		stableMwcObj := createMutatingWebhookWithSelector("istio-revision-tag-stable", map[string]string{tagLabelKey: "stable", revLabelKey: "1-27-1"}, validSelector)
+		kubeclient := createFakeClient(defaultMwcObj, taggedMwcObj, stableMwcObj)
+
+		// when
+		tags, err := GetRevisionTags(context.Background(), kubeclient)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tags).To(Equal(map[string]string{tag.DefaultRevisionName: tag.DefaultRevisionName, "stable": "1-27-1"}))
+	})
+})
+
+var _ = Describe("InjectionRevision", func() {
+	tags := map[string]string{tag.DefaultRevisionName: "1-26-3", "stable": "1-27-1"}
+
+	DescribeTable("should return the revision injecting the namespace",
+		func(labels map[string]string, expectedRevision string) {
+			// given
+			ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: labels}}
+
+			// when
+			revision := InjectionRevision(ns, tags)
+
+			// then
+			Expect(revision).To(Equal(expectedRevision))
+		},
+		Entry("istio-injection label", map[string]string{"istio-injection": "enabled"}, "1-26-3"),
+		Entry("istio-injection label taking precedence over istio.io/rev label", map[string]string{"istio-injection": "enabled", revLabelKey: "1-27-1"}, "1-26-3"),
+		Entry("istio.io/rev label with a revision", map[string]string{revLabelKey: "1-27-1"}, "1-27-1"),
+		Entry("istio.io/rev label with a revision tag", map[string]string{revLabelKey: "stable"}, "1-27-1"),
+		Entry("no injection labels", map[string]string{}, "1-26-3"),
+	)
+})
@@ -0,0 +1,64 @@
+package webhookserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/pkg/labels"
+)
+
+// MutatingWebhookConfigurationName is the name of the MutatingWebhookConfiguration of the Istio CR.
+const MutatingWebhookConfigurationName = "istio-mutating-webhook-configuration"
+
+//+kubebuilder:webhook:path=/mutate-operator-kyma-project-io-v1alpha2-istio,mutating=true,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=istios,verbs=create;update,versions=v1alpha2,name=mistio.operator.kyma-project.io,admissionReviewVersions=v1,matchPolicy=Equivalent
+
+// VersionChangeApprover records the user who set the allow-version-change annotation of the Istio CR in the version-change-approved-by
+// annotation. The approver annotation is always derived from the admission request, so it cannot be set by the user directly.
+type VersionChangeApprover struct{}
+
+func (a *VersionChangeApprover) Default(ctx context.Context, obj runtime.Object) error {
+	istioCR, ok := obj.(*operatorv1alpha2.Istio)
+	if !ok {
+		return fmt.Errorf("expected an Istio CR but got %T", obj)
+	}
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	oldIstioCR := operatorv1alpha2.Istio{}
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 {
+		if err := json.Unmarshal(req.OldObject.Raw, &oldIstioCR); err != nil {
+			return err
+		}
+	}
+
+	setVersionChangeApprover(istioCR, &oldIstioCR, req.UserInfo.Username)
+	return nil
+}
+
+// setVersionChangeApprover sets the approver to username if the allow-version-change annotation was added or changed. Otherwise, the
+// approver of the old Istio CR is kept, or removed together with the allow-version-change annotation.
+func setVersionChangeApprover(istioCR, oldIstioCR *operatorv1alpha2.Istio, username string) {
+	approvedVersion, found := istioCR.Annotations[labels.AllowVersionChange]
+	if !found {
+		delete(istioCR.Annotations, labels.VersionChangeApprovedBy)
+		return
+	}
+
+	approver := oldIstioCR.Annotations[labels.VersionChangeApprovedBy]
+	if oldApprovedVersion, oldFound := oldIstioCR.Annotations[labels.AllowVersionChange]; !oldFound || oldApprovedVersion != approvedVersion {
+		approver = username
+	}
+	if approver == "" {
+		delete(istioCR.Annotations, labels.VersionChangeApprovedBy)
+		return
+	}
+	istioCR.Annotations[labels.VersionChangeApprovedBy] = approver
+}
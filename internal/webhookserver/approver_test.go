@@ -0,0 +1,103 @@
+package webhookserver_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/webhookserver"
+)
+
+var _ = Describe("VersionChangeApprover", func() {
+	const (
+		allowVersionChange      = "operator.kyma-project.io/allow-version-change"
+		versionChangeApprovedBy = "operator.kyma-project.io/version-change-approved-by"
+	)
+
+	istioCR := func(annotations map[string]string) *operatorv1alpha2.Istio {
+		return &operatorv1alpha2.Istio{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "kyma-system", Annotations: annotations}}
+	}
+	admissionContext := func(operation admissionv1.Operation, username string, oldIstioCR *operatorv1alpha2.Istio) context.Context {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			UserInfo:  authenticationv1.UserInfo{Username: username},
+		}}
+		if oldIstioCR != nil {
+			raw, err := json.Marshal(oldIstioCR)
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return admission.NewContextWithRequest(context.Background(), req)
+	}
+
+	It("should record the user who added the allow-version-change annotation", func() {
+		// given
+		cr := istioCR(map[string]string{allowVersionChange: "1.16.1"})
+
+		// when
+		err := (&webhookserver.VersionChangeApprover{}).Default(admissionContext(admissionv1.Update, "admin@example.com", istioCR(nil)), cr)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Annotations).To(HaveKeyWithValue(versionChangeApprovedBy, "admin@example.com"))
+	})
+
+	It("should keep the approver if the allow-version-change annotation is not changed", func() {
+		// given
+		annotations := map[string]string{allowVersionChange: "1.16.1", versionChangeApprovedBy: "admin@example.com"}
+		cr := istioCR(map[string]string{allowVersionChange: "1.16.1", versionChangeApprovedBy: "admin@example.com"})
+
+		// when
+		err := (&webhookserver.VersionChangeApprover{}).Default(admissionContext(admissionv1.Update, "system:serviceaccount:kyma-system:istio-controller-manager", istioCR(annotations)), cr)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Annotations).To(HaveKeyWithValue(versionChangeApprovedBy, "admin@example.com"))
+	})
+
+	It("should record the user who changed the approved version", func() {
+		// given
+		annotations := map[string]string{allowVersionChange: "1.16.1", versionChangeApprovedBy: "admin@example.com"}
+		cr := istioCR(map[string]string{allowVersionChange: "1.15.0", versionChangeApprovedBy: "admin@example.com"})
+
+		// when
+		err := (&webhookserver.VersionChangeApprover{}).Default(admissionContext(admissionv1.Update, "operator@example.com", istioCR(annotations)), cr)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Annotations).To(HaveKeyWithValue(versionChangeApprovedBy, "operator@example.com"))
+	})
+
+	It("should not accept an approver set by the user", func() {
+		// given
+		cr := istioCR(map[string]string{allowVersionChange: "1.16.1", versionChangeApprovedBy: "someone-else"})
+
+		// when
+		err := (&webhookserver.VersionChangeApprover{}).Default(admissionContext(admissionv1.Create, "admin@example.com", nil), cr)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Annotations).To(HaveKeyWithValue(versionChangeApprovedBy, "admin@example.com"))
+	})
+
+	It("should remove the approver together with the allow-version-change annotation", func() {
+		// given
+		annotations := map[string]string{allowVersionChange: "1.16.1", versionChangeApprovedBy: "admin@example.com"}
+		cr := istioCR(map[string]string{versionChangeApprovedBy: "admin@example.com"})
+
+		// when
+		err := (&webhookserver.VersionChangeApprover{}).Default(admissionContext(admissionv1.Update, "admin@example.com", istioCR(annotations)), cr)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cr.Annotations).ToNot(HaveKey(versionChangeApprovedBy))
+	})
+})
@@ -0,0 +1,215 @@
+package webhookserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/pkg/labels"
+)
+
+const (
+	// Namespace is the namespace of the Istio module manager, its webhook Service and the certificate Secret.
+	Namespace = "kyma-system"
+	// ServiceName is the name of the Service that exposes the webhook server of the Istio module manager.
+	ServiceName = "istio-webhook-service"
+	// CertificateSecretName is the name of the Secret in which the serving certificate of the webhook server is stored.
+	CertificateSecretName = "istio-webhook-cert"
+	// CRDName is the name of the Istio CRD, whose conversion webhook is served by the webhook server.
+	CRDName = "istios.operator.kyma-project.io"
+
+	certificateValidity         = 365 * 24 * time.Hour
+	certificateRenewalThreshold = 30 * 24 * time.Hour
+)
+
+// EnsureCertificate makes sure that the webhook server has a valid self-signed serving certificate. The certificate is stored
+// in the certificate Secret, so that it survives restarts of the manager, and it is renewed if it expires within 30 days.
+// The certificate is written to certDir, from which the webhook server loads it, and set as the CA bundle
+// of the conversion webhook of the Istio CRD and of the validating and mutating webhooks of the Istio CR.
+func EnsureCertificate(ctx context.Context, k8sClient client.Client, certDir string, now time.Time) error {
+	secret := corev1.Secret{}
+	err := k8sClient.Get(ctx, types.NamespacedName{Namespace: Namespace, Name: CertificateSecretName}, &secret)
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	secretExists := err == nil
+
+	if !secretExists || !isCertificateValid(secret.Data[corev1.TLSCertKey], now) {
+		certPEM, keyPEM, genErr := generateCertificate(now)
+		if genErr != nil {
+			return fmt.Errorf("failed to generate webhook certificate: %w", genErr)
+		}
+		secret.Data = map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM}
+		if secretExists {
+			err = k8sClient.Update(ctx, &secret)
+		} else {
+			secret.ObjectMeta = metav1.ObjectMeta{Namespace: Namespace, Name: CertificateSecretName, Labels: labels.SetModuleLabels(nil)}
+			secret.Type = corev1.SecretTypeTLS
+			err = k8sClient.Create(ctx, &secret)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = writeCertificate(certDir, secret.Data); err != nil {
+		return err
+	}
+
+	if err = setConversionCABundle(ctx, k8sClient, secret.Data[corev1.TLSCertKey]); err != nil {
+		return err
+	}
+
+	if err = setValidatingCABundle(ctx, k8sClient, secret.Data[corev1.TLSCertKey]); err != nil {
+		return err
+	}
+
+	return setMutatingCABundle(ctx, k8sClient, secret.Data[corev1.TLSCertKey])
+}
+
+// isCertificateValid returns true if the certificate is issued for the webhook Service and does not expire within the renewal threshold.
+func isCertificateValid(certPEM []byte, now time.Time) bool {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	return now.Add(certificateRenewalThreshold).Before(cert.NotAfter) && slices.Equal(cert.DNSNames, dnsNames())
+}
+
+func dnsNames() []string {
+	return []string{
+		ServiceName,
+		fmt.Sprintf("%s.%s", ServiceName, Namespace),
+		fmt.Sprintf("%s.%s.svc", ServiceName, Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", ServiceName, Namespace),
+	}
+}
+
+func generateCertificate(now time.Time) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s.%s.svc", ServiceName, Namespace)},
+		DNSNames:              dnsNames(),
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certificateValidity),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+func writeCertificate(certDir string, data map[string][]byte) error {
+	if err := os.MkdirAll(certDir, 0o700); err != nil {
+		return err
+	}
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if err := os.WriteFile(filepath.Join(certDir, key), data[key], 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setConversionCABundle sets the CA bundle of the conversion webhook of the Istio CRD, so that the API server trusts the webhook server.
+func setConversionCABundle(ctx context.Context, k8sClient client.Client, caBundle []byte) error {
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: CRDName}, &crd); err != nil {
+		return err
+	}
+
+	conversion := crd.Spec.Conversion
+	if conversion == nil || conversion.Strategy != apiextensionsv1.WebhookConverter || conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil {
+		return errors.New("the Istio CRD has no conversion webhook configured")
+	}
+	if slices.Equal(conversion.Webhook.ClientConfig.CABundle, caBundle) {
+		return nil
+	}
+
+	patch := client.MergeFrom(crd.DeepCopy())
+	conversion.Webhook.ClientConfig.CABundle = caBundle
+	return k8sClient.Patch(ctx, &crd, patch)
+}
+
+// setValidatingCABundle sets the CA bundle of the validating webhooks of the Istio CR, so that the API server trusts the webhook server.
+func setValidatingCABundle(ctx context.Context, k8sClient client.Client, caBundle []byte) error {
+	webhookConfiguration := admissionregistrationv1.ValidatingWebhookConfiguration{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: ValidatingWebhookConfigurationName}, &webhookConfiguration); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(webhookConfiguration.DeepCopy())
+	changed := false
+	for i := range webhookConfiguration.Webhooks {
+		if !slices.Equal(webhookConfiguration.Webhooks[i].ClientConfig.CABundle, caBundle) {
+			webhookConfiguration.Webhooks[i].ClientConfig.CABundle = caBundle
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return k8sClient.Patch(ctx, &webhookConfiguration, patch)
+}
+
+// setMutatingCABundle sets the CA bundle of the mutating webhooks of the Istio CR, so that the API server trusts the webhook server.
+func setMutatingCABundle(ctx context.Context, k8sClient client.Client, caBundle []byte) error {
+	webhookConfiguration := admissionregistrationv1.MutatingWebhookConfiguration{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: MutatingWebhookConfigurationName}, &webhookConfiguration); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(webhookConfiguration.DeepCopy())
+	changed := false
+	for i := range webhookConfiguration.Webhooks {
+		if !slices.Equal(webhookConfiguration.Webhooks[i].ClientConfig.CABundle, caBundle) {
+			webhookConfiguration.Webhooks[i].ClientConfig.CABundle = caBundle
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return k8sClient.Patch(ctx, &webhookConfiguration, patch)
+}
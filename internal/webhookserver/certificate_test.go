@@ -0,0 +1,164 @@
+package webhookserver_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/istio/operator/internal/webhookserver"
+)
+
+var _ = Describe("EnsureCertificate", func() {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	istioCRD := func(conversion *apiextensionsv1.CustomResourceConversion) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: webhookserver.CRDName},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Conversion: conversion},
+		}
+	}
+	webhookConversion := func() *apiextensionsv1.CustomResourceConversion {
+		return &apiextensionsv1.CustomResourceConversion{
+			Strategy: apiextensionsv1.WebhookConverter,
+			Webhook: &apiextensionsv1.WebhookConversion{
+				ClientConfig: &apiextensionsv1.WebhookClientConfig{
+					Service: &apiextensionsv1.ServiceReference{Namespace: webhookserver.Namespace, Name: webhookserver.ServiceName},
+				},
+			},
+		}
+	}
+	validatingWebhookConfiguration := func() *admissionregistrationv1.ValidatingWebhookConfiguration {
+		return &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: webhookserver.ValidatingWebhookConfigurationName},
+			Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "vistio.operator.kyma-project.io"}},
+		}
+	}
+	mutatingWebhookConfiguration := func() *admissionregistrationv1.MutatingWebhookConfiguration {
+		return &admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: webhookserver.MutatingWebhookConfigurationName},
+			Webhooks:   []admissionregistrationv1.MutatingWebhook{{Name: "mistio.operator.kyma-project.io"}},
+		}
+	}
+	getSecret := func(c client.Client) corev1.Secret {
+		secret := corev1.Secret{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Namespace: webhookserver.Namespace, Name: webhookserver.CertificateSecretName}, &secret)).To(Succeed())
+		return secret
+	}
+	getCABundle := func(c client.Client) []byte {
+		crd := apiextensionsv1.CustomResourceDefinition{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: webhookserver.CRDName}, &crd)).To(Succeed())
+		return crd.Spec.Conversion.Webhook.ClientConfig.CABundle
+	}
+	getValidatingCABundle := func(c client.Client) []byte {
+		webhookConfiguration := admissionregistrationv1.ValidatingWebhookConfiguration{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: webhookserver.ValidatingWebhookConfigurationName}, &webhookConfiguration)).To(Succeed())
+		return webhookConfiguration.Webhooks[0].ClientConfig.CABundle
+	}
+	getMutatingCABundle := func(c client.Client) []byte {
+		webhookConfiguration := admissionregistrationv1.MutatingWebhookConfiguration{}
+		Expect(c.Get(context.Background(), types.NamespacedName{Name: webhookserver.MutatingWebhookConfigurationName}, &webhookConfiguration)).To(Succeed())
+		return webhookConfiguration.Webhooks[0].ClientConfig.CABundle
+	}
+	parseCertificate := func(certPEM []byte) *x509.Certificate {
+		block, _ := pem.Decode(certPEM)
+		Expect(block).NotTo(BeNil())
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).NotTo(HaveOccurred())
+		return cert
+	}
+
+	It("should create the certificate Secret, write the certificate and set the CA bundle of the conversion, validating and mutating webhooks", func() {
+		// given
+		c := createFakeClient(istioCRD(webhookConversion()), validatingWebhookConfiguration(), mutatingWebhookConfiguration())
+		certDir := GinkgoT().TempDir()
+
+		// when
+		err := webhookserver.EnsureCertificate(context.Background(), c, certDir, now)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		secret := getSecret(c)
+		cert := parseCertificate(secret.Data[corev1.TLSCertKey])
+		Expect(cert.DNSNames).To(ContainElement("istio-webhook-service.kyma-system.svc"))
+		Expect(cert.NotAfter).To(Equal(now.Add(365 * 24 * time.Hour)))
+
+		writtenCert, err := os.ReadFile(filepath.Join(certDir, corev1.TLSCertKey))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writtenCert).To(Equal(secret.Data[corev1.TLSCertKey]))
+		writtenKey, err := os.ReadFile(filepath.Join(certDir, corev1.TLSPrivateKeyKey))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writtenKey).To(Equal(secret.Data[corev1.TLSPrivateKeyKey]))
+
+		Expect(getCABundle(c)).To(Equal(secret.Data[corev1.TLSCertKey]))
+		Expect(getValidatingCABundle(c)).To(Equal(secret.Data[corev1.TLSCertKey]))
+		Expect(getMutatingCABundle(c)).To(Equal(secret.Data[corev1.TLSCertKey]))
+	})
+
+	It("should keep a valid certificate", func() {
+		// given
+		c := createFakeClient(istioCRD(webhookConversion()), validatingWebhookConfiguration(), mutatingWebhookConfiguration())
+		Expect(webhookserver.EnsureCertificate(context.Background(), c, GinkgoT().TempDir(), now)).To(Succeed())
+		existing := getSecret(c)
+
+		// when
+		err := webhookserver.EnsureCertificate(context.Background(), c, GinkgoT().TempDir(), now.Add(300*24*time.Hour))
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getSecret(c).Data).To(Equal(existing.Data))
+	})
+
+	It("should renew a certificate that expires within 30 days", func() {
+		// given
+		c := createFakeClient(istioCRD(webhookConversion()), validatingWebhookConfiguration(), mutatingWebhookConfiguration())
+		Expect(webhookserver.EnsureCertificate(context.Background(), c, GinkgoT().TempDir(), now)).To(Succeed())
+		existing := getSecret(c)
+		renewalTime := now.Add(340 * 24 * time.Hour)
+
+		// when
+		err := webhookserver.EnsureCertificate(context.Background(), c, GinkgoT().TempDir(), renewalTime)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		renewed := getSecret(c)
+		Expect(renewed.Data[corev1.TLSCertKey]).NotTo(Equal(existing.Data[corev1.TLSCertKey]))
+		Expect(parseCertificate(renewed.Data[corev1.TLSCertKey]).NotAfter).To(Equal(renewalTime.Add(365 * 24 * time.Hour)))
+		Expect(getCABundle(c)).To(Equal(renewed.Data[corev1.TLSCertKey]))
+		Expect(getValidatingCABundle(c)).To(Equal(renewed.Data[corev1.TLSCertKey]))
+		Expect(getMutatingCABundle(c)).To(Equal(renewed.Data[corev1.TLSCertKey]))
+	})
+
+	It("should return an error if the Istio CRD has no conversion webhook", func() {
+		// given
+		c := createFakeClient(istioCRD(&apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter}))
+
+		// when
+		err := webhookserver.EnsureCertificate(context.Background(), c, GinkgoT().TempDir(), now)
+
+		// then
+		Expect(err).To(MatchError("the Istio CRD has no conversion webhook configured"))
+	})
+})
+
+func createFakeClient(objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	Expect(apiextensionsv1.AddToScheme(scheme)).To(Succeed())
+	Expect(admissionregistrationv1.AddToScheme(scheme)).To(Succeed())
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
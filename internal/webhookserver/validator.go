@@ -0,0 +1,118 @@
+package webhookserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/clusterconfig"
+	"github.com/kyma-project/istio/operator/internal/istiooperator"
+	"github.com/kyma-project/istio/operator/internal/validation"
+)
+
+// ValidatingWebhookConfigurationName is the name of the ValidatingWebhookConfiguration of the Istio CR.
+const ValidatingWebhookConfigurationName = "istio-validating-webhook-configuration"
+
+//+kubebuilder:webhook:path=/validate-operator-kyma-project-io-v1alpha2-istio,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=istios,verbs=create;update,versions=v1alpha2,name=vistio.operator.kyma-project.io,admissionReviewVersions=v1,matchPolicy=Equivalent
+
+// IstioValidator rejects Istio CRs at admission that the reconciliation would stop with a validation error, so that the user gets
+// the same message immediately instead of from the status of the Istio CR.
+type IstioValidator struct {
+	Client client.Client
+	Merger istiooperator.Merger
+}
+
+func (v *IstioValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	istioCR, ok := obj.(*operatorv1alpha2.Istio)
+	if !ok {
+		return nil, fmt.Errorf("expected an Istio CR but got %T", obj)
+	}
+
+	if err := validation.ValidateNamespace(*istioCR); err != nil {
+		return nil, errors.New(err.Description())
+	}
+	if err := v.validateOldestCR(ctx, *istioCR); err != nil {
+		return nil, err
+	}
+	return v.validateSpec(ctx, *istioCR)
+}
+
+// ValidateUpdate only validates changes of the spec, because the manager must always be able to update the metadata of an Istio CR,
+// for example to remove its finalizer. For the same reason an Istio CR that is being deleted is not validated.
+func (v *IstioValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldIstioCR, ok := oldObj.(*operatorv1alpha2.Istio)
+	if !ok {
+		return nil, fmt.Errorf("expected an Istio CR but got %T", oldObj)
+	}
+	istioCR, ok := newObj.(*operatorv1alpha2.Istio)
+	if !ok {
+		return nil, fmt.Errorf("expected an Istio CR but got %T", newObj)
+	}
+
+	if !istioCR.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(oldIstioCR.Spec, istioCR.Spec) {
+		return nil, nil
+	}
+	return v.validateSpec(ctx, *istioCR)
+}
+
+func (v *IstioValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateOldestCR rejects a new Istio CR if another Istio CR already exists, because only the oldest Istio CR reconciles the module.
+func (v *IstioValidator) validateOldestCR(ctx context.Context, istioCR operatorv1alpha2.Istio) error {
+	istioCRs := operatorv1alpha2.IstioList{}
+	if err := v.Client.List(ctx, &istioCRs, client.InNamespace(validation.IstioCRNamespace)); err != nil {
+		return err
+	}
+
+	var oldest *operatorv1alpha2.Istio
+	for i := range istioCRs.Items {
+		existing := &istioCRs.Items[i]
+		if existing.GetUID() == istioCR.GetUID() {
+			continue
+		}
+		if oldest == nil || existing.CreationTimestamp.Before(&oldest.CreationTimestamp) {
+			oldest = existing
+		}
+	}
+	if oldest != nil {
+		return errors.New(validation.NewOlderCRExistsError(*oldest).Description())
+	}
+	return nil
+}
+
+// validateSpec runs the validations of the reconciliation and merges the Istio CR into the IstioOperator of the cluster
+// to make sure that the configuration can be rendered. Access log findings are returned as warnings, because the
+// reconciliation applies the configuration anyway.
+func (v *IstioValidator) validateSpec(ctx context.Context, istioCR operatorv1alpha2.Istio) (admission.Warnings, error) {
+	for _, validate := range validation.SpecValidations {
+		if err := validate(istioCR); err != nil {
+			return nil, errors.New(err.Description())
+		}
+	}
+
+	clusterSize, err := clusterconfig.EvaluateClusterSize(ctx, v.Client)
+	if err != nil {
+		return nil, err
+	}
+	iop, err := v.Merger.GetIstioOperator(clusterSize)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = istioCR.MergeInto(iop); err != nil {
+		return nil, fmt.Errorf("istio CR cannot be merged into the Istio installation: %w", err)
+	}
+
+	var warnings admission.Warnings
+	if err := validation.ValidateAccessLog(istioCR); err != nil {
+		warnings = append(warnings, err.Description())
+	}
+	return warnings, nil
+}
@@ -0,0 +1,163 @@
+package webhookserver_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/istiooperator"
+	"github.com/kyma-project/istio/operator/internal/webhookserver"
+)
+
+var _ = Describe("IstioValidator", func() {
+	istioCR := func(name string, uid types.UID, created time.Time) *operatorv1alpha2.Istio {
+		return &operatorv1alpha2.Istio{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "kyma-system",
+				UID:               uid,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+		}
+	}
+	newValidator := func(objects ...client.Object) *webhookserver.IstioValidator {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(operatorv1alpha2.AddToScheme(scheme)).To(Succeed())
+		merger := istiooperator.NewDefaultIstioMerger()
+		return &webhookserver.IstioValidator{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			Merger: &merger,
+		}
+	}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	Context("ValidateCreate", func() {
+		It("should accept a valid Istio CR", func() {
+			// given
+			validator := newValidator()
+
+			// when
+			warnings, err := validator.ValidateCreate(context.Background(), istioCR("default", "1", now))
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should reject an Istio CR that is not in the kyma-system namespace", func() {
+			// given
+			validator := newValidator()
+			cr := istioCR("default", "1", now)
+			cr.Namespace = "default"
+
+			// when
+			_, err := validator.ValidateCreate(context.Background(), cr)
+
+			// then
+			Expect(err).To(MatchError("Stopped Istio CR reconciliation: istio CR is not in kyma-system namespace"))
+		})
+
+		It("should reject a second Istio CR", func() {
+			// given
+			validator := newValidator(istioCR("default", "1", now))
+
+			// when
+			_, err := validator.ValidateCreate(context.Background(), istioCR("second", "2", now.Add(time.Minute)))
+
+			// then
+			Expect(err).To(MatchError("Stopped Istio CR reconciliation: only Istio CR default in kyma-system reconciles the module"))
+		})
+
+		It("should reject duplicated authorizer names", func() {
+			// given
+			validator := newValidator()
+			cr := istioCR("default", "1", now)
+			cr.Spec.Config.Authorizers = []*operatorv1alpha2.Authorizer{
+				{Name: "authz", Service: "authz.default.svc.cluster.local", Port: 8080},
+				{Name: "authz", Service: "other.default.svc.cluster.local", Port: 8080},
+			}
+
+			// when
+			_, err := validator.ValidateCreate(context.Background(), cr)
+
+			// then
+			Expect(err).To(MatchError("Authorizer name needs to be unique: authz is duplicated"))
+		})
+
+		It("should return access log findings as warnings", func() {
+			// given
+			validator := newValidator()
+			cr := istioCR("default", "1", now)
+			cr.Spec.Config.AccessLog = &operatorv1alpha2.AccessLog{}
+
+			// when
+			warnings, err := validator.ValidateCreate(context.Background(), cr)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("Default access log format is applied: accessLog does not define any labels"))
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should reject a spec change with duplicated authorizer names", func() {
+			// given
+			validator := newValidator()
+			oldCR := istioCR("default", "1", now)
+			newCR := oldCR.DeepCopy()
+			newCR.Spec.Config.Authorizers = []*operatorv1alpha2.Authorizer{
+				{Name: "authz", Service: "authz.default.svc.cluster.local", Port: 8080},
+				{Name: "authz", Service: "other.default.svc.cluster.local", Port: 8080},
+			}
+
+			// when
+			_, err := validator.ValidateUpdate(context.Background(), oldCR, newCR)
+
+			// then
+			Expect(err).To(MatchError("Authorizer name needs to be unique: authz is duplicated"))
+		})
+
+		It("should accept a metadata change of an invalid Istio CR", func() {
+			// given
+			validator := newValidator()
+			oldCR := istioCR("default", "1", now)
+			oldCR.Spec.Config.MTLS = &operatorv1alpha2.MTLS{
+				NamespaceExceptions: []operatorv1alpha2.MTLSNamespaceException{{Namespace: "istio-system"}},
+			}
+			newCR := oldCR.DeepCopy()
+			newCR.Finalizers = []string{"istios.operator.kyma-project.io/istio-installation"}
+
+			// when
+			_, err := validator.ValidateUpdate(context.Background(), oldCR, newCR)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should accept any change of an Istio CR that is being deleted", func() {
+			// given
+			validator := newValidator()
+			oldCR := istioCR("default", "1", now)
+			newCR := oldCR.DeepCopy()
+			newCR.DeletionTimestamp = ptr.To(metav1.NewTime(now))
+			newCR.Spec.Config.NumTrustedProxies = ptr.To(-1)
+
+			// when
+			_, err := validator.ValidateUpdate(context.Background(), oldCR, newCR)
+
+			// then
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package webhookserver
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	operatorv1beta1 "github.com/kyma-project/istio/operator/api/v1beta1"
+	"github.com/kyma-project/istio/operator/internal/istiooperator"
+)
+
+const certificateCheckInterval = 24 * time.Hour
+
+// SetupWithManager registers the conversion, validating and mutating webhooks of the Istio CR on the webhook server of the manager and renews
+// the serving certificate of the webhook server while the manager is running.
+// The serving certificate must already exist, see EnsureCertificate.
+func SetupWithManager(mgr ctrl.Manager, certDir string) error {
+	err := ctrl.NewWebhookManagedBy(mgr).For(&operatorv1beta1.Istio{}).Complete()
+	if err != nil {
+		return err
+	}
+
+	merger := istiooperator.NewDefaultIstioMerger()
+	err = ctrl.NewWebhookManagedBy(mgr).For(&operatorv1alpha2.Istio{}).
+		WithValidator(&IstioValidator{Client: mgr.GetClient(), Merger: &merger}).
+		WithDefaulter(&VersionChangeApprover{}).
+		Complete()
+	if err != nil {
+		return err
+	}
+
+	return mgr.Add(&certificateRenewer{client: mgr.GetClient(), certDir: certDir, interval: certificateCheckInterval})
+}
+
+// certificateRenewer checks the serving certificate periodically and renews it before it expires.
+// The webhook server reloads the certificate from the certificate directory.
+type certificateRenewer struct {
+	client   client.Client
+	certDir  string
+	interval time.Duration
+}
+
+func (r *certificateRenewer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := EnsureCertificate(ctx, r.client, r.certDir, time.Now()); err != nil {
+				ctrl.Log.WithName("webhook-server").Error(err, "Failed to renew the webhook server certificate")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false, because every replica of the manager serves the webhooks.
+func (r *certificateRenewer) NeedLeaderElection() bool {
+	return false
+}
@@ -17,19 +17,25 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
+	"path/filepath"
 	"time"
 
 	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/kyma-project/istio/operator/internal/cacerts"
 	"github.com/kyma-project/istio/operator/internal/reconciliations/istio"
+	"github.com/kyma-project/istio/operator/internal/webhookserver"
 
 	networkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -43,9 +49,11 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	operatorv1alpha2 "github.com/kyma-project/istio/operator/api/v1alpha2"
+	operatorv1beta1 "github.com/kyma-project/istio/operator/api/v1beta1"
 	"github.com/kyma-project/istio/operator/controllers"
 	//+kubebuilder:scaffold:imports
 )
@@ -74,13 +82,17 @@ type FlagVar struct {
 	rateLimiterFrequency   int
 	rateLimiterBurst       int
 	reconciliationInterval time.Duration
+	webhookCertDir         string
 }
 
 func init() { //nolint:gochecknoinits // it was scaffolded by controller-gen TODO: remove this init function when possible
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(networkingv1alpha3.AddToScheme(scheme))
 	utilruntime.Must(networkingv1.AddToScheme(scheme))
+	utilruntime.Must(networkingv1beta1.AddToScheme(scheme))
 	utilruntime.Must(operatorv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(operatorv1beta1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -116,10 +128,30 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = cacerts.RegisterMetrics(metrics.Registry); err != nil {
+		setupLog.Error(err, "Unable to register CA certificate metrics")
+		os.Exit(1)
+	}
+
 	if err = controllers.NewController(mgr, flagVar.reconciliationInterval).SetupWithManager(mgr, rateLimiter); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "Istio")
 		os.Exit(1)
 	}
+
+	// The cache of the manager is not started yet, so the serving certificate of the webhook server is set up with a direct client.
+	setupClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		setupLog.Error(err, "Unable to create setup client")
+		os.Exit(1)
+	}
+	if err = webhookserver.EnsureCertificate(context.Background(), setupClient, flagVar.webhookCertDir, time.Now()); err != nil {
+		setupLog.Error(err, "Unable to set up webhook server certificate")
+		os.Exit(1)
+	}
+	if err = webhookserver.SetupWithManager(mgr, flagVar.webhookCertDir); err != nil {
+		setupLog.Error(err, "Unable to set up webhook server")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err = mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -140,7 +172,8 @@ func main() {
 
 func createManager(flagVar *FlagVar) (manager.Manager, error) {
 	webhookServer := webhook.NewServer(webhook.Options{
-		Port: WebhookServiceDefaultPort,
+		Port:    WebhookServiceDefaultPort,
+		CertDir: flagVar.webhookCertDir,
 	})
 
 	return ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
@@ -186,5 +219,7 @@ func defineFlagVar() *FlagVar {
 		"Indicates the failure max delay.")
 	flag.DurationVar(&flagVar.reconciliationInterval, "reconciliation-interval", reconciliationIntervalDefault,
 		"Indicates the time based reconciliation interval.")
+	flag.StringVar(&flagVar.webhookCertDir, "webhook-cert-dir", filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs"),
+		"The directory to which the serving certificate of the webhook server is written.")
 	return flagVar
 }
@@ -4,6 +4,8 @@ import "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 const (
 	LastAppliedConfiguration string = "operator.kyma-project.io/lastAppliedConfiguration"
+	AllowVersionChange       string = "operator.kyma-project.io/allow-version-change"
+	VersionChangeApprovedBy  string = "operator.kyma-project.io/version-change-approved-by"
 	ModuleLabelKey           string = "kyma-project.io/module"
 	ModuleLabelValue         string = "istio"
 )
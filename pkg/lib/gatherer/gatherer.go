@@ -9,8 +9,10 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 
 	"slices"
+	"strings"
 
 	"github.com/masterminds/semver"
+	"istio.io/api/annotation"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -19,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/istio/operator/api/v1alpha2"
+	"github.com/kyma-project/istio/operator/internal/webhooks"
 )
 
 const (
@@ -27,6 +30,9 @@ const (
 	IstioNamespace    string = "istio-system"
 
 	MinNumberOfMatches = 3
+
+	podsToListLimit     = 100
+	istioComponentLabel = "operator.istio.io/component"
 )
 
 // GetIstioCR fetches the Istio CR from the cluster using client with supplied name and namespace.
@@ -117,17 +123,58 @@ func ListInstalledIstioRevisions(ctx context.Context, kubeClient client.Client)
 	return istioRevisionVersions, nil
 }
 
-func GetIstioPodsVersion(ctx context.Context, kubeClient client.Client) (string, error) {
+// GetTargetIstioRevision returns the revision the Istio version is installed with. During a canary upgrade, this is the canary
+// revision named after the version, otherwise the revision the default revision tag points to.
+func GetTargetIstioRevision(ctx context.Context, kubeClient client.Client, canaryRevision string) (string, error) {
+	revisions, err := ListInstalledIstioRevisions(ctx, kubeClient)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := revisions[canaryRevision]; ok && canaryRevision != "" {
+		return canaryRevision, nil
+	}
+	return webhooks.GetDefaultRevision(ctx, kubeClient)
+}
+
+// ListPreviousIstioRevisions lists the installed Istio revisions other than the target revision. During a canary upgrade, these are
+// the revisions that workloads are moved away from. The version of the revisions is not considered, because an approved downgrade
+// installs a lower version as the target revision.
+func ListPreviousIstioRevisions(ctx context.Context, kubeClient client.Client, targetRevision string) ([]string, error) {
+	revisions, err := ListInstalledIstioRevisions(ctx, kubeClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous []string
+	for revision := range revisions {
+		if revision != targetRevision {
+			previous = append(previous, revision)
+		}
+	}
+	slices.Sort(previous)
+	return previous, nil
+}
+
+// GetIstioPodsVersion returns the version of the Istio control plane Pods. Only the istiod Pods of the target revision are considered,
+// because the istiod Pods of previous revisions keep running until a canary upgrade is finished.
+func GetIstioPodsVersion(ctx context.Context, kubeClient client.Client, targetRevision string) (string, error) {
 	pods, err := ListIstioCPPods(ctx, kubeClient)
 	if err != nil {
 		return "", err
 	}
+	previousRevisions, err := ListPreviousIstioRevisions(ctx, kubeClient, targetRevision)
+	if err != nil {
+		return "", err
+	}
 	currentVersion := &semver.Version{}
 	containersToCheck := []string{"discovery", "istio-proxy", "install-cni"}
 	for _, pod := range pods.Items {
 		if pod.DeletionTimestamp != nil {
 			continue
 		}
+		if pod.Labels["app"] == "istiod" && slices.Contains(previousRevisions, pod.Labels[RevisionLabelName]) {
+			continue
+		}
 		for _, container := range pod.Spec.Containers {
 			if !slices.Contains(containersToCheck, container.Name) {
 				continue
@@ -150,8 +197,8 @@ func GetIstioPodsVersion(ctx context.Context, kubeClient client.Client) (string,
 	return currentVersion.String(), nil
 }
 
-func VerifyIstioPodsVersion(ctx context.Context, kubeClient client.Client, istioOperatorVersion string) error {
-	podsVersion, err := GetIstioPodsVersion(ctx, kubeClient)
+func VerifyIstioPodsVersion(ctx context.Context, kubeClient client.Client, istioOperatorVersion string, targetRevision string) error {
+	podsVersion, err := GetIstioPodsVersion(ctx, kubeClient, targetRevision)
 	if err != nil {
 		return err
 	}
@@ -176,3 +223,183 @@ func getImageVersion(image string) (*semver.Version, error) {
 	}
 	return &noPreleaseVersion, nil
 }
+
+// CountDataPlaneWorkloads counts the running Pods that have an Istio sidecar proxy and the running Pods that are part of the ambient mesh.
+// A Pod is part of the ambient mesh if it or its namespace is labeled with istio.io/dataplane-mode=ambient and it has no sidecar proxy.
+func CountDataPlaneWorkloads(ctx context.Context, kubeClient client.Client) (*v1alpha2.DataPlaneStatus, error) {
+	namespaces := v1.NamespaceList{}
+	err := kubeClient.List(ctx, &namespaces, client.MatchingLabels{v1alpha2.DataPlaneModeLabel: string(v1alpha2.DataPlaneModeAmbient)})
+	if err != nil {
+		return nil, err
+	}
+	ambientNamespaces := make(map[string]bool, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		ambientNamespaces[ns.Name] = true
+	}
+
+	status := &v1alpha2.DataPlaneStatus{}
+	pods := v1.PodList{}
+	for {
+		listOps := []client.ListOption{client.Limit(podsToListLimit)}
+		if pods.Continue != "" {
+			listOps = append(listOps, client.Continue(pods.Continue))
+		}
+		err = kubeClient.List(ctx, &pods, listOps...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp != nil || pod.Status.Phase != v1.PodRunning {
+				continue
+			}
+			if _, injected := pod.Annotations[annotation.SidecarStatus.Name]; injected {
+				status.SidecarWorkloads++
+				continue
+			}
+			if isAmbientPod(pod, ambientNamespaces) {
+				status.AmbientWorkloads++
+			}
+		}
+
+		if pods.Continue == "" {
+			return status, nil
+		}
+	}
+}
+
+func isAmbientPod(pod v1.Pod, ambientNamespaces map[string]bool) bool {
+	if pod.Spec.HostNetwork {
+		return false
+	}
+
+	mode, labeled := pod.Labels[v1alpha2.DataPlaneModeLabel]
+	if labeled {
+		return mode == string(v1alpha2.DataPlaneModeAmbient)
+	}
+
+	return ambientNamespaces[pod.Namespace]
+}
+
+// CountOutdatedProxies counts the running Pods outside the istio-system namespace that have an Istio sidecar proxy with an image version
+// other than istioVersion. Those Pods are still waiting for a restart after the last Istio version change. Proxies with an image whose
+// version cannot be parsed, for example an image referenced by digest, are not counted.
+func CountOutdatedProxies(ctx context.Context, kubeClient client.Client, istioVersion string) (int, error) {
+	expectedVersion, err := semver.NewVersion(istioVersion)
+	if err != nil {
+		return 0, err
+	}
+	noPrereleaseVersion, err := expectedVersion.SetPrerelease("")
+	if err != nil {
+		return 0, err
+	}
+
+	outdated := 0
+	pods := v1.PodList{}
+	for {
+		listOps := []client.ListOption{client.Limit(podsToListLimit)}
+		if pods.Continue != "" {
+			listOps = append(listOps, client.Continue(pods.Continue))
+		}
+		err = kubeClient.List(ctx, &pods, listOps...)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, pod := range pods.Items {
+			if isOutdatedProxy(pod, noPrereleaseVersion) {
+				outdated++
+			}
+		}
+
+		if pods.Continue == "" {
+			return outdated, nil
+		}
+	}
+}
+
+func isOutdatedProxy(pod v1.Pod, expectedVersion semver.Version) bool {
+	if pod.Namespace == IstioNamespace || pod.DeletionTimestamp != nil || pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+	if _, injected := pod.Annotations[annotation.SidecarStatus.Name]; !injected {
+		return false
+	}
+
+	proxyContainers := slices.Concat(pod.Spec.Containers, pod.Spec.InitContainers)
+	for _, container := range proxyContainers {
+		if container.Name != "istio-proxy" {
+			continue
+		}
+		version, err := getImageVersion(container.Image)
+		if err != nil {
+			return false
+		}
+		return !version.Equal(&expectedVersion)
+	}
+	return false
+}
+
+// GetComponentStatuses returns the health of the Deployments and DaemonSets that the Istio installation created in the istio-system namespace,
+// for example istiod, the gateways, Istio CNI and ztunnel. The statuses are sorted by kind and name.
+func GetComponentStatuses(ctx context.Context, kubeClient client.Client) ([]v1alpha2.ComponentStatus, error) {
+	componentSelector := client.HasLabels{istioComponentLabel}
+
+	deployments := appsv1.DeploymentList{}
+	err := kubeClient.List(ctx, &deployments, client.InNamespace(IstioNamespace), componentSelector)
+	if err != nil {
+		return nil, err
+	}
+	daemonSets := appsv1.DaemonSetList{}
+	err = kubeClient.List(ctx, &daemonSets, client.InNamespace(IstioNamespace), componentSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []v1alpha2.ComponentStatus
+	for _, daemonSet := range daemonSets.Items {
+		statuses = append(statuses, newComponentStatus(daemonSet.Name, "DaemonSet", daemonSet.Status.DesiredNumberScheduled,
+			daemonSet.Status.NumberReady, daemonSet.Spec.Template.Spec))
+	}
+	for _, deployment := range deployments.Items {
+		desiredReplicas := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desiredReplicas = *deployment.Spec.Replicas
+		}
+		statuses = append(statuses, newComponentStatus(deployment.Name, "Deployment", desiredReplicas,
+			deployment.Status.ReadyReplicas, deployment.Spec.Template.Spec))
+	}
+
+	slices.SortFunc(statuses, func(a, b v1alpha2.ComponentStatus) int {
+		if a.Kind != b.Kind {
+			return strings.Compare(a.Kind, b.Kind)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return statuses, nil
+}
+
+func newComponentStatus(name, kind string, desiredReplicas, readyReplicas int32, podSpec v1.PodSpec) v1alpha2.ComponentStatus {
+	status := v1alpha2.ComponentStatus{
+		Name:            name,
+		Kind:            kind,
+		DesiredReplicas: desiredReplicas,
+		ReadyReplicas:   readyReplicas,
+		Health:          v1alpha2.ComponentHealthy,
+	}
+	if readyReplicas < desiredReplicas {
+		status.Health = v1alpha2.ComponentDegraded
+		if readyReplicas == 0 {
+			status.Health = v1alpha2.ComponentUnavailable
+		}
+	}
+
+	// The version is informational, so an image that is not tagged with a version leaves it empty.
+	for _, container := range podSpec.Containers {
+		if version, err := getImageVersion(container.Image); err == nil {
+			status.Version = version.String()
+			break
+		}
+	}
+	return status
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/kyma-project/istio/operator/api/v1alpha2"
 	"github.com/kyma-project/istio/operator/pkg/lib/gatherer"
 	"github.com/masterminds/semver"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -222,6 +223,77 @@ var _ = Describe("Gatherer", func() {
 		})
 	})
 
+	Context("ListPreviousIstioRevisions", func() {
+		It("should list the installed revisions other than the target revision", func() {
+			istiodDefault := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod", Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "default", "operator.istio.io/version": "1.26.3",
+			}}}
+			istiodCanary := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod-1-27-1", Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "1-27-1", "operator.istio.io/version": "1.27.1",
+			}}}
+			client := createClientSet(&istiodDefault, &istiodCanary)
+
+			revisions, err := gatherer.ListPreviousIstioRevisions(context.TODO(), client, "1-27-1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revisions).To(Equal([]string{"default"}))
+		})
+
+		It("should list a revision with a higher version than the target revision during a canary downgrade", func() {
+			istiodDefault := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod", Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "default", "operator.istio.io/version": "1.28.0",
+			}}}
+			istiodCanary := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod-1-27-1", Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "1-27-1", "operator.istio.io/version": "1.27.1",
+			}}}
+			client := createClientSet(&istiodDefault, &istiodCanary)
+
+			revisions, err := gatherer.ListPreviousIstioRevisions(context.TODO(), client, "1-27-1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revisions).To(Equal([]string{"default"}))
+		})
+
+		It("should return no revisions when only one revision is installed", func() {
+			istiodDefault := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod", Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "default", "operator.istio.io/version": "1.27.1",
+			}}}
+			client := createClientSet(&istiodDefault)
+
+			revisions, err := gatherer.ListPreviousIstioRevisions(context.TODO(), client, "default")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revisions).To(BeEmpty())
+		})
+	})
+
+	Context("GetTargetIstioRevision", func() {
+		istiodDefault := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod", Labels: map[string]string{
+			"app": "istiod", "istio.io/rev": "default", "operator.istio.io/version": "1.28.0",
+		}}}
+		istiodCanary := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod-1-27-1", Labels: map[string]string{
+			"app": "istiod", "istio.io/rev": "1-27-1", "operator.istio.io/version": "1.27.1",
+		}}}
+
+		It("should return the canary revision when it is installed", func() {
+			client := createClientSet(istiodDefault.DeepCopy(), istiodCanary.DeepCopy())
+
+			revision, err := gatherer.GetTargetIstioRevision(context.TODO(), client, "1-27-1")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revision).To(Equal("1-27-1"))
+		})
+
+		It("should return the default revision when the canary revision is not installed", func() {
+			client := createClientSet(istiodDefault.DeepCopy())
+
+			revision, err := gatherer.GetTargetIstioRevision(context.TODO(), client, "1-28-0")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revision).To(Equal("default"))
+		})
+	})
+
 	Context("GetIstioPodsVersion", func() {
 		istiodPod := createPodWith("istiod", gatherer.IstioNamespace, "discovery", "istio/pilot", ImageVersion, false, "kyma-project.io/module=istio")
 		istiogwPod := createPodWith("istio-ingressgateway", gatherer.IstioNamespace, "istio-proxy", "istio/proxyv2", ImageVersion, false, "kyma-project.io/module=istio")
@@ -238,7 +310,7 @@ var _ = Describe("Gatherer", func() {
 
 			client := createClientSet(&istioSystem, istiodPod, istiogwPod, istiocniPod, appPod)
 
-			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client)
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "default")
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(version).To(Equal(ImageVersion))
@@ -253,7 +325,7 @@ var _ = Describe("Gatherer", func() {
 
 			client := createClientSet(&istioSystem, istiodPod, istiogwPod, istiocniPod, istiogwPodTerm, appPod)
 
-			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client)
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "default")
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(version).To(Equal(ImageVersion))
@@ -269,7 +341,57 @@ var _ = Describe("Gatherer", func() {
 
 			client := createClientSet(&istioSystem, istiodPod, istiogwPod, istiocniPodDistroless, appPod)
 
-			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client)
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "default")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).To(Equal(ImageVersion))
+		})
+
+		It("should not consider istiod pods of a previous revision during a canary upgrade", func() {
+			istioSystem := corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: gatherer.IstioNamespace,
+				},
+			}
+			istiodDefault := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: gatherer.IstioNamespace, Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "default", "operator.istio.io/version": "1.9.0",
+			}}}
+			istiodCanary := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod-1-10-0", Namespace: gatherer.IstioNamespace, Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "1-10-0", "operator.istio.io/version": ImageVersion,
+			}}}
+			istiodPodOld := createPodWith("istiod-old", gatherer.IstioNamespace, "discovery", "istio/pilot", "1.9.0", false,
+				"kyma-project.io/module=istio", "app=istiod", "istio.io/rev=default")
+			istiodPodCanary := createPodWith("istiod-1-10-0", gatherer.IstioNamespace, "discovery", "istio/pilot", ImageVersion, false,
+				"kyma-project.io/module=istio", "app=istiod", "istio.io/rev=1-10-0")
+
+			client := createClientSet(&istioSystem, &istiodDefault, &istiodCanary, istiodPodOld, istiodPodCanary, istiogwPod, istiocniPod)
+
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "1-10-0")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(version).To(Equal(ImageVersion))
+		})
+
+		It("should not consider istiod pods of a previous revision with a higher version during a canary downgrade", func() {
+			istioSystem := corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: gatherer.IstioNamespace,
+				},
+			}
+			istiodDefault := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: gatherer.IstioNamespace, Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "default", "operator.istio.io/version": "1.11.0",
+			}}}
+			istiodCanary := appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod-1-10-0", Namespace: gatherer.IstioNamespace, Labels: map[string]string{
+				"app": "istiod", "istio.io/rev": "1-10-0", "operator.istio.io/version": ImageVersion,
+			}}}
+			istiodPodNewer := createPodWith("istiod-newer", gatherer.IstioNamespace, "discovery", "istio/pilot", "1.11.0", false,
+				"kyma-project.io/module=istio", "app=istiod", "istio.io/rev=default")
+			istiodPodCanary := createPodWith("istiod-1-10-0", gatherer.IstioNamespace, "discovery", "istio/pilot", ImageVersion, false,
+				"kyma-project.io/module=istio", "app=istiod", "istio.io/rev=1-10-0")
+
+			client := createClientSet(&istioSystem, &istiodDefault, &istiodCanary, istiodPodNewer, istiodPodCanary, istiogwPod, istiocniPod)
+
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "1-10-0")
 
 			Expect(err).ShouldNot(HaveOccurred())
 			Expect(version).To(Equal(ImageVersion))
@@ -284,7 +406,7 @@ var _ = Describe("Gatherer", func() {
 
 			client := createClientSet(&istioSystem, appPod)
 
-			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client)
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "default")
 
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("unable to obtain installed Istio image version"))
@@ -300,7 +422,7 @@ var _ = Describe("Gatherer", func() {
 			istiocniPodOld := createPodWith("istio-cni-node", "istio-system", "install-cni", "istio/install-cni", "1.0.0", false, "kyma-project.io/module=istio")
 			client := createClientSet(&istioSystem, istiodPod, istiogwPod, istiocniPodOld, appPod)
 
-			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client)
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "default")
 
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("image version of Pod istio-ingressgateway 1.10.0 do not match other Pods version 1.0.0"))
@@ -316,7 +438,7 @@ var _ = Describe("Gatherer", func() {
 			istiocniPodWrong := createPodWith("istio-cni-node", "istio-system", "install-cni", "istio/install-cni", "wrong", false, "kyma-project.io/module=istio")
 			client := createClientSet(&istioSystem, istiodPod, istiogwPod, istiocniPodWrong, appPod)
 
-			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client)
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "default")
 
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Invalid Semantic Version"))
@@ -332,13 +454,171 @@ var _ = Describe("Gatherer", func() {
 			istiocniPodLatest := createPodWith("istio-cni-node", "istio-system", "install-cni", "istio/install-cni", "latest", false, "kyma-project.io/module=istio")
 			client := createClientSet(&istioSystem, istiodPod, istiogwPod, istiocniPodLatest, appPod)
 
-			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client)
+			version, err := gatherer.GetIstioPodsVersion(context.TODO(), client, "default")
 
 			Expect(err).Should(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Invalid Semantic Version"))
 			Expect(version).To(Equal(""))
 		})
 	})
+
+	Context("CountDataPlaneWorkloads", func() {
+		ambientPod := func(name, namespace string, labels ...string) *corev1.Pod {
+			pod := createPodWith(name, namespace, "app", "app", ImageVersion, false, labels...)
+			pod.Annotations = nil
+			return pod
+		}
+
+		It("should count sidecar and ambient workloads", func() {
+			//given
+			ambientNamespace := corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "ambient-ns",
+					Labels: map[string]string{"istio.io/dataplane-mode": "ambient"},
+				},
+			}
+			sidecarNamespace := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "sidecar-ns"}}
+
+			client := createClientSet(&ambientNamespace, &sidecarNamespace,
+				createPodWith("sidecar-1", "sidecar-ns", "istio-proxy", "istio/proxyv2", ImageVersion, false),
+				createPodWith("sidecar-2", "ambient-ns", "istio-proxy", "istio/proxyv2", ImageVersion, false),
+				ambientPod("ambient-1", "ambient-ns"),
+				ambientPod("ambient-2", "sidecar-ns", "istio.io/dataplane-mode=ambient"),
+				ambientPod("opted-out", "ambient-ns", "istio.io/dataplane-mode=none"),
+				ambientPod("not-in-mesh", "sidecar-ns"),
+			)
+
+			//when
+			status, err := gatherer.CountDataPlaneWorkloads(context.TODO(), client)
+
+			//then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.SidecarWorkloads).To(Equal(2))
+			Expect(status.AmbientWorkloads).To(Equal(2))
+		})
+
+		It("should not count terminating pods", func() {
+			//given
+			client := createClientSet(
+				createPodWith("sidecar-1", "sidecar-ns", "istio-proxy", "istio/proxyv2", ImageVersion, true),
+			)
+
+			//when
+			status, err := gatherer.CountDataPlaneWorkloads(context.TODO(), client)
+
+			//then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.SidecarWorkloads).To(Equal(0))
+			Expect(status.AmbientWorkloads).To(Equal(0))
+		})
+	})
+
+	Context("CountOutdatedProxies", func() {
+		It("should count only running proxies with a different version outside istio-system", func() {
+			//given
+			kubeClient := createClientSet(
+				createPodWith("current", "default", "istio-proxy", "istio/proxyv2", "1.16.1-distroless", false),
+				createPodWith("outdated", "default", "istio-proxy", "istio/proxyv2", "1.16.0-distroless", false),
+				createPodWith("terminating", "default", "istio-proxy", "istio/proxyv2", "1.16.0-distroless", true),
+				createPodWith("gateway", "istio-system", "istio-proxy", "istio/proxyv2", "1.16.0-distroless", false),
+			)
+
+			//when
+			outdated, err := gatherer.CountOutdatedProxies(context.Background(), kubeClient, "1.16.1")
+
+			//then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(outdated).To(Equal(1))
+		})
+
+		It("should count outdated proxies running as native sidecars", func() {
+			//given
+			pod := createPodWith("native", "default", "app", "app", "1.0.0", false)
+			pod.Spec.InitContainers = []corev1.Container{{Name: "istio-proxy", Image: "istio/proxyv2:1.15.0"}}
+			kubeClient := createClientSet(pod)
+
+			//when
+			outdated, err := gatherer.CountOutdatedProxies(context.Background(), kubeClient, "1.16.1-distroless")
+
+			//then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(outdated).To(Equal(1))
+		})
+
+		It("should not count proxies with an image version that cannot be parsed", func() {
+			//given
+			kubeClient := createClientSet(
+				createPodWith("outdated", "default", "istio-proxy", "istio/proxyv2", "1.16.0-distroless", false),
+				createPodWith("unparsable", "default", "istio-proxy", "istio/proxyv2", "latest", false),
+			)
+
+			//when
+			outdated, err := gatherer.CountOutdatedProxies(context.Background(), kubeClient, "1.16.1")
+
+			//then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(outdated).To(Equal(1))
+		})
+	})
+
+	Context("GetComponentStatuses", func() {
+		componentLabels := func(component string) map[string]string {
+			return map[string]string{"operator.istio.io/component": component}
+		}
+		podSpec := func(image string) corev1.PodTemplateSpec {
+			return corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "discovery", Image: image}}}}
+		}
+
+		It("should return the health of the Istio Deployments and DaemonSets in istio-system", func() {
+			//given
+			replicas := int32(2)
+			istiod := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system", Labels: componentLabels("Pilot")},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas, Template: podSpec("istio/pilot:1.16.1-distroless")},
+				Status:     appsv1.DeploymentStatus{ReadyReplicas: 2},
+			}
+			ingressGateway := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "istio-ingressgateway", Namespace: "istio-system", Labels: componentLabels("IngressGateways")},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas, Template: podSpec("istio/proxyv2:1.16.1-distroless")},
+				Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+			}
+			cni := &appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "istio-cni-node", Namespace: "istio-system", Labels: componentLabels("Cni")},
+				Spec:       appsv1.DaemonSetSpec{Template: podSpec("istio/install-cni:1.16.1-distroless")},
+				Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 0},
+			}
+			notInstalledByIstio := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "istio-system"},
+			}
+			otherNamespace := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "default", Labels: componentLabels("Pilot")},
+			}
+			kubeClient := createClientSet(istiod, ingressGateway, cni, notInstalledByIstio, otherNamespace)
+
+			//when
+			statuses, err := gatherer.GetComponentStatuses(context.Background(), kubeClient)
+
+			//then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statuses).To(Equal([]v1alpha2.ComponentStatus{
+				{Name: "istio-cni-node", Kind: "DaemonSet", DesiredReplicas: 3, ReadyReplicas: 0, Version: "1.16.1", Health: v1alpha2.ComponentUnavailable},
+				{Name: "istio-ingressgateway", Kind: "Deployment", DesiredReplicas: 2, ReadyReplicas: 1, Version: "1.16.1", Health: v1alpha2.ComponentDegraded},
+				{Name: "istiod", Kind: "Deployment", DesiredReplicas: 2, ReadyReplicas: 2, Version: "1.16.1", Health: v1alpha2.ComponentHealthy},
+			}))
+		})
+
+		It("should return no statuses when Istio is not installed", func() {
+			//given
+			kubeClient := createClientSet()
+
+			//when
+			statuses, err := gatherer.GetComponentStatuses(context.Background(), kubeClient)
+
+			//then
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(statuses).To(BeEmpty())
+		})
+	})
 })
 
 func createClientSet(objects ...client.Object) client.Client {
@@ -348,6 +628,8 @@ func createClientSet(objects ...client.Object) client.Client {
 	Expect(err).ShouldNot(HaveOccurred())
 	err = appsv1.AddToScheme(scheme.Scheme)
 	Expect(err).ShouldNot(HaveOccurred())
+	err = admissionregistrationv1.AddToScheme(scheme.Scheme)
+	Expect(err).ShouldNot(HaveOccurred())
 
 	return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
 }
@@ -28,8 +28,10 @@ type ProxyRestarter interface {
 		ctx context.Context,
 		expectedImage predicates.SidecarImage,
 		expectedResources v1.ResourceRequirements,
+		expectedNativeSidecar bool,
+		targetRevision string,
 		istioCR *v1alpha2.Istio,
-	) ([]restart.Warning, bool, error)
+	) ([]restart.Warning, int, bool, error)
 	RestartWithPredicates(ctx context.Context, preds []predicates.SidecarProxyPredicate, limits *pods.RestartLimits, failOnError bool) ([]restart.Warning, bool, error)
 }
 
@@ -49,35 +51,56 @@ func NewProxyRestarter(c client.Client, podsLister pods.Getter, actionRestarter
 	}
 }
 
+// RestartProxies restarts the Kyma and Customer proxies that do not match the expected configuration and returns the warnings for the
+// workloads that must be restarted manually, the number of restarted pods and whether more pods must be restarted.
 func (p *ProxyRestart) RestartProxies(
 	ctx context.Context,
 	expectedImage predicates.SidecarImage,
 	expectedResources v1.ResourceRequirements,
+	expectedNativeSidecar bool,
+	targetRevision string,
 	istioCR *v1alpha2.Istio,
-) ([]restart.Warning, bool, error) {
+) ([]restart.Warning, int, bool, error) {
 	compatibiltyPredicate, err := predicates.NewCompatibilityRestartPredicate(istioCR)
 	if err != nil {
 		p.logger.Error(err, "Failed to create restart compatibility predicate")
-		return []restart.Warning{}, false, err
+		return []restart.Warning{}, 0, false, err
 	}
 	prometheusMergePredicate, err := predicates.NewPrometheusMergeRestartPredicate(ctx, p.k8sClient, istioCR)
 	if err != nil {
 		p.logger.Error(err, "Failed to create restart prometheusMerge predicate")
-		return []restart.Warning{}, false, err
+		return []restart.Warning{}, 0, false, err
+	}
+
+	ambientNamespacePredicate, err := predicates.NewAmbientNamespaceRestartPredicate(ctx, p.k8sClient)
+	if err != nil {
+		p.logger.Error(err, "Failed to create restart ambient namespace predicate")
+		return []restart.Warning{}, 0, false, err
+	}
+	canaryUpgradePredicate, err := predicates.NewCanaryUpgradeRestartPredicate(ctx, p.k8sClient, targetRevision)
+	if err != nil {
+		p.logger.Error(err, "Failed to create restart canary upgrade predicate")
+		return []restart.Warning{}, 0, false, err
 	}
 	predicates := []predicates.SidecarProxyPredicate{
+		ambientNamespacePredicate,
+		canaryUpgradePredicate,
 		compatibiltyPredicate,
 		prometheusMergePredicate,
+		predicates.NewProxyLifecycleRestartPredicate(ctx, p.k8sClient),
+		predicates.NewDNSProxyRestartPredicate(ctx, p.k8sClient),
+		predicates.NewWorkloadCertificatesRestartPredicate(ctx, p.k8sClient),
+		predicates.NewNativeSidecarRestartPredicate(expectedNativeSidecar),
 		predicates.NewImageResourcesPredicate(expectedImage, expectedResources),
 	}
 
-	err = p.restartKymaProxies(ctx, predicates)
+	restartedKymaPods, err := p.restartKymaProxies(ctx, predicates)
 	if err != nil {
 		p.logger.Error(err, "Failed to restart Kyma proxies")
-		return []restart.Warning{}, false, err
+		return []restart.Warning{}, restartedKymaPods, false, err
 	}
 
-	warnings, hasMorePodsToRestart, err := p.restartCustomerProxies(ctx, predicates)
+	warnings, restartedCustomerPods, hasMorePodsToRestart, err := p.restartCustomerProxies(ctx, predicates)
 	if err != nil {
 		p.logger.Error(err, "failed to restart Customer proxies")
 		warnings = []restart.Warning{ // errors on Customer proxies are considered as a warning
@@ -85,12 +108,13 @@ func (p *ProxyRestart) RestartProxies(
 				Name:      "n/a",
 				Namespace: "n/a",
 				Kind:      "n/a",
+				Reason:    restart.ReasonRestartFailed,
 				Message:   "failed to restart Customer proxies",
 			},
 		}
 	}
 
-	return warnings, hasMorePodsToRestart, nil
+	return warnings, restartedKymaPods + restartedCustomerPods, hasMorePodsToRestart, nil
 }
 
 func (p *ProxyRestart) RestartWithPredicates(
@@ -99,71 +123,107 @@ func (p *ProxyRestart) RestartWithPredicates(
 	limits *pods.RestartLimits,
 	failOnError bool,
 ) ([]restart.Warning, bool, error) {
+	warnings, _, hasMorePodsToRestart, err := p.restartWithPredicates(ctx, preds, limits, failOnError)
+	return warnings, hasMorePodsToRestart, err
+}
+
+// restartWithPredicates restarts the pods matching the predicates and additionally returns the number of restarted pods.
+func (p *ProxyRestart) restartWithPredicates(
+	ctx context.Context,
+	preds []predicates.SidecarProxyPredicate,
+	limits *pods.RestartLimits,
+	failOnError bool,
+) ([]restart.Warning, int, bool, error) {
 	podsToRestart, err := p.podsLister.GetPodsToRestart(ctx, preds, limits)
 	if err != nil {
 		p.logger.Error(err, "Getting pods to restart failed")
-		return []restart.Warning{}, false, err
+		return []restart.Warning{}, 0, false, err
 	}
 
-	warnings, err := p.actionRestarter.Restart(ctx, podsToRestart, failOnError)
+	warnings, restartedPods, err := p.actionRestarter.Restart(ctx, podsToRestart, failOnError)
 	if err != nil {
 		p.logger.Error(err, "Restarting pods failed")
-		return warnings, false, err
+		return warnings, restartedPods, false, err
 	}
 
 	// if there are more pods to restart there should be a continue token in the pod list
-	return warnings, podsToRestart.Continue != "", nil
+	return warnings, restartedPods, podsToRestart.Continue != "", nil
 }
 
-func (p *ProxyRestart) restartKymaProxies(ctx context.Context, preds []predicates.SidecarProxyPredicate) error {
+func (p *ProxyRestart) restartKymaProxies(ctx context.Context, preds []predicates.SidecarProxyPredicate) (int, error) {
 	preds = append(preds, predicates.NewKymaWorkloadRestartPredicate())
 	limits := pods.NewPodsRestartLimits(math.MaxInt, math.MaxInt)
 
-	warnings, _, err := p.RestartWithPredicates(ctx, preds, limits, true)
+	warnings, restartedPods, _, err := p.restartWithPredicates(ctx, preds, limits, true)
 	if err != nil {
 		p.logger.Error(err, "Failed to restart Kyma proxies")
-		return err
+		return restartedPods, err
 	}
 	warningMessage := BuildWarningMessage(warnings, p.logger)
 	if warningMessage != "" {
 		err = errors.New(warningMessage)
 		p.logger.Error(err, "Failed to restart Kyma proxies")
-		return err
+		return restartedPods, err
 	}
 
 	p.logger.Info("Kyma proxy restart completed")
-	return nil
+	return restartedPods, nil
 }
 
 func BuildWarningMessage(warnings []restart.Warning, logger *logr.Logger) string {
-	warningMessage := ""
-	warningsCount := len(warnings)
-	if warningsCount > 0 {
-		podsLimit := 5
-		pods := []string{}
-		for _, w := range warnings {
-			if podsLimit--; podsLimit >= 0 {
-				pods = append(pods, fmt.Sprintf("%s/%s", w.Namespace, w.Name))
-			}
-			logger.Info("Proxy reset failed:", "name", w.Name, "namespace", w.Namespace, "kind", w.Kind, "message", w.Message)
+	workloadWarnings := []restart.Warning{}
+	cronJobWarnings := []restart.Warning{}
+	for _, w := range warnings {
+		logger.Info("Proxy reset failed:", "name", w.Name, "namespace", w.Namespace, "kind", w.Kind, "message", w.Message)
+		if w.Kind == restart.CronJobKind {
+			cronJobWarnings = append(cronJobWarnings, w)
+		} else {
+			workloadWarnings = append(workloadWarnings, w)
 		}
-		warningMessage = fmt.Sprintf("The sidecars of the following workloads could not be restarted: %s",
-			strings.Join(pods, ", "))
-		if warningsCount-len(pods) > 0 {
-			warningMessage += fmt.Sprintf(" and %d additional workload(s)", warningsCount-len(pods))
+	}
+
+	messages := []string{}
+	if len(workloadWarnings) > 0 {
+		messages = append(messages, "The sidecars of the following workloads could not be restarted: "+listWarningWorkloads(workloadWarnings))
+	}
+	// Jobs created by a CronJob are reported separately, because their sidecars are updated with the next scheduled Job.
+	if len(cronJobWarnings) > 0 {
+		messages = append(messages, "The sidecars of Jobs created by the following CronJobs are updated with the next scheduled Job: "+
+			listWarningWorkloads(cronJobWarnings))
+	}
+	return strings.Join(messages, ". ")
+}
+
+func listWarningWorkloads(warnings []restart.Warning) string {
+	podsLimit := 5
+	pods := []string{}
+	for _, w := range warnings {
+		if podsLimit--; podsLimit >= 0 {
+			pods = append(pods, fmt.Sprintf("%s/%s", w.Namespace, w.Name))
 		}
 	}
-	return warningMessage
+	list := strings.Join(pods, ", ")
+	if len(warnings)-len(pods) > 0 {
+		list += fmt.Sprintf(" and %d additional workload(s)", len(warnings)-len(pods))
+	}
+	return list
 }
 
-func (p *ProxyRestart) restartCustomerProxies(ctx context.Context, preds []predicates.SidecarProxyPredicate) ([]restart.Warning, bool, error) {
+func (p *ProxyRestart) restartCustomerProxies(ctx context.Context, preds []predicates.SidecarProxyPredicate) ([]restart.Warning, int, bool, error) {
 	preds = append(preds, predicates.NewCustomerWorkloadRestartPredicate())
-	limits := pods.NewPodsRestartLimits(podsToRestartLimit, podsToListLimit)
 
-	warnings, hasMorePodsToRestart, err := p.RestartWithPredicates(ctx, preds, limits, false)
+	// All pods are listed, so that the warnings cover every workload that must be restarted manually, but only a batch of the pods
+	// is restarted.
+	podsToRestart, err := p.podsLister.GetPodsToRestart(ctx, preds, pods.NewPodsRestartLimits(math.MaxInt, podsToListLimit))
+	if err != nil {
+		p.logger.Error(err, "Failed to restart Customer proxies")
+		return []restart.Warning{}, 0, false, err
+	}
+
+	warnings, restartedPods, hasMorePodsToRestart, err := p.actionRestarter.RestartWithLimit(ctx, podsToRestart, podsToRestartLimit, false)
 	if err != nil {
 		p.logger.Error(err, "Failed to restart Customer proxies")
-		return warnings, false, err
+		return warnings, restartedPods, false, err
 	}
 
 	if !hasMorePodsToRestart {
@@ -172,5 +232,5 @@ func (p *ProxyRestart) restartCustomerProxies(ctx context.Context, preds []predi
 		p.logger.Info("Customer proxy restart only partially completed")
 	}
 
-	return warnings, hasMorePodsToRestart, nil
+	return warnings, restartedPods, hasMorePodsToRestart, nil
 }
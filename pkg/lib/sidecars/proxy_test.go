@@ -3,6 +3,7 @@ package sidecars_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"testing"
 
@@ -53,11 +54,12 @@ var _ = Describe("RestartProxies", func() {
 		istioCR := helpers.GetIstioCR(expectedImage.Tag)
 		actionRestarter := restart.NewActionRestarter(c, &logger)
 		proxyRestarter := sidecars.NewProxyRestarter(c, podsLister, actionRestarter, &logger)
-		warnings, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, &istioCR)
+		warnings, restartedPods, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
 		Expect(warnings).To(BeEmpty())
+		Expect(restartedPods).To(Equal(1))
 		Expect(hasMorePods).To(BeFalse())
 
 		err = c.Get(ctx, client.ObjectKey{Name: rsOwnerRS.Name, Namespace: rsOwnerRS.Namespace}, rsOwnerRS)
@@ -77,7 +79,7 @@ var _ = Describe("RestartProxies", func() {
 		istioCR := helpers.GetIstioCR(expectedImage.Tag)
 		actionRestarter := restart.NewActionRestarter(failClient, &logger)
 		proxyRestarter := sidecars.NewProxyRestarter(failClient, podsListerMock, actionRestarter, &logger)
-		warnings, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, &istioCR)
+		warnings, _, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -87,21 +89,33 @@ var _ = Describe("RestartProxies", func() {
 		Expect(podsListerMock.Called).To(Equal(2))
 
 		Expect(podsListerMock.Predicates).To(HaveLen(2))
-		Expect(podsListerMock.Predicates[0]).To(HaveLen(4))
-		Expect(podsListerMock.Predicates[0][0]).To(BeAssignableToTypeOf(&predicates.CompatibilityRestartPredicate{}))
-		Expect(podsListerMock.Predicates[0][1]).To(BeAssignableToTypeOf(&predicates.PrometheusMergeRestartPredicate{}))
-		Expect(podsListerMock.Predicates[0][2]).To(BeAssignableToTypeOf(&predicates.ImageResourcesPredicate{}))
-		Expect(podsListerMock.Predicates[0][3]).To(BeAssignableToTypeOf(&predicates.KymaWorkloadRestartPredicate{}))
-		Expect(podsListerMock.Predicates[1]).To(HaveLen(4))
-		Expect(podsListerMock.Predicates[0][0]).To(BeAssignableToTypeOf(&predicates.CompatibilityRestartPredicate{}))
-		Expect(podsListerMock.Predicates[0][1]).To(BeAssignableToTypeOf(&predicates.PrometheusMergeRestartPredicate{}))
-		Expect(podsListerMock.Predicates[1][2]).To(BeAssignableToTypeOf(&predicates.ImageResourcesPredicate{}))
-		Expect(podsListerMock.Predicates[1][3]).To(BeAssignableToTypeOf(&predicates.CustomerWorkloadRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0]).To(HaveLen(10))
+		Expect(podsListerMock.Predicates[0][0]).To(BeAssignableToTypeOf(&predicates.AmbientNamespaceRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][1]).To(BeAssignableToTypeOf(&predicates.CanaryUpgradeRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][2]).To(BeAssignableToTypeOf(&predicates.CompatibilityRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][3]).To(BeAssignableToTypeOf(&predicates.PrometheusMergeRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][4]).To(BeAssignableToTypeOf(&predicates.ProxyLifecycleRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][5]).To(BeAssignableToTypeOf(&predicates.ProxyMetadataRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][6]).To(BeAssignableToTypeOf(&predicates.ProxyMetadataRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][7]).To(BeAssignableToTypeOf(&predicates.NativeSidecarRestartPredicate{}))
+		Expect(podsListerMock.Predicates[0][8]).To(BeAssignableToTypeOf(&predicates.ImageResourcesPredicate{}))
+		Expect(podsListerMock.Predicates[0][9]).To(BeAssignableToTypeOf(&predicates.KymaWorkloadRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1]).To(HaveLen(10))
+		Expect(podsListerMock.Predicates[1][0]).To(BeAssignableToTypeOf(&predicates.AmbientNamespaceRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][1]).To(BeAssignableToTypeOf(&predicates.CanaryUpgradeRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][2]).To(BeAssignableToTypeOf(&predicates.CompatibilityRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][3]).To(BeAssignableToTypeOf(&predicates.PrometheusMergeRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][4]).To(BeAssignableToTypeOf(&predicates.ProxyLifecycleRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][5]).To(BeAssignableToTypeOf(&predicates.ProxyMetadataRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][6]).To(BeAssignableToTypeOf(&predicates.ProxyMetadataRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][7]).To(BeAssignableToTypeOf(&predicates.NativeSidecarRestartPredicate{}))
+		Expect(podsListerMock.Predicates[1][8]).To(BeAssignableToTypeOf(&predicates.ImageResourcesPredicate{}))
+		Expect(podsListerMock.Predicates[1][9]).To(BeAssignableToTypeOf(&predicates.CustomerWorkloadRestartPredicate{}))
 
 		Expect(podsListerMock.Limits).To(HaveLen(2))
 		Expect(podsListerMock.Limits[0].PodsToRestartLimit).To(Equal(math.MaxInt))
 		Expect(podsListerMock.Limits[0].PodsToListLimit).To(Equal(math.MaxInt))
-		Expect(podsListerMock.Limits[1].PodsToRestartLimit).To(Equal(30))
+		Expect(podsListerMock.Limits[1].PodsToRestartLimit).To(Equal(math.MaxInt))
 		Expect(podsListerMock.Limits[1].PodsToListLimit).To(Equal(100))
 	})
 
@@ -116,7 +130,7 @@ var _ = Describe("RestartProxies", func() {
 		proxyRestarter := sidecars.NewProxyRestarter(c, podsListerMock, actionRestarter, &logger)
 
 		// when
-		warnings, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, &istioCR)
+		warnings, _, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
 
 		// then
 		Expect(err).To(HaveOccurred())
@@ -136,7 +150,7 @@ var _ = Describe("RestartProxies", func() {
 		proxyRestarter := sidecars.NewProxyRestarter(c, podsListerMock, actionRestarter, &logger)
 
 		// when
-		warnings, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, &istioCR)
+		warnings, _, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
 
 		// then
 		Expect(err).To(HaveOccurred())
@@ -157,7 +171,7 @@ var _ = Describe("RestartProxies", func() {
 		proxyRestarter := sidecars.NewProxyRestarter(c, podsListerMock, actionRestarter, &logger)
 
 		// when
-		warnings, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, &istioCR)
+		warnings, _, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -165,6 +179,7 @@ var _ = Describe("RestartProxies", func() {
 			Name:      "n/a",
 			Namespace: "n/a",
 			Kind:      "n/a",
+			Reason:    restart.ReasonRestartFailed,
 			Message:   "failed to restart Customer proxies",
 		}))
 		Expect(hasMorePods).To(BeFalse())
@@ -183,7 +198,7 @@ var _ = Describe("RestartProxies", func() {
 		istioCR := helpers.GetIstioCR(expectedImage.Tag)
 		actionRestarter := NewActionRestartMock([]restart.Warning{{Name: "test-pod", Namespace: "kyma-system", Kind: "Pod", Message: "failed to restart"}}, nil)
 		proxyRestarter := sidecars.NewProxyRestarter(c, podsLister, actionRestarter, &logger)
-		warnings, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, &istioCR)
+		warnings, _, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
 
 		// then
 		Expect(err).To(HaveOccurred())
@@ -209,13 +224,35 @@ var _ = Describe("RestartProxies", func() {
 		istioCR := helpers.GetIstioCR(expectedImage.Tag)
 		actionRestarter := restart.NewActionRestarter(failClient, &logger)
 		proxyRestarter := sidecars.NewProxyRestarter(failClient, podsLister, actionRestarter, &logger)
-		warnings, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, &istioCR)
+		warnings, _, hasMorePods, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
 		Expect(warnings).To(BeEmpty())
 		Expect(hasMorePods).To(BeFalse())
 	})
+	It("should return a warning for every Customer workload that must be restarted manually, not only for the restarted batch", func() {
+		// given
+		var objects []client.Object
+		for i := 0; i < 35; i++ {
+			// the ReplicaSet owning the pod does not exist, so the pod must be restarted manually
+			objects = append(objects, getPod(fmt.Sprintf("test-pod-%d", i), "test-namespace", fmt.Sprintf("podOwner-%d", i), "ReplicaSet"))
+		}
+		c := fakeClient(objects...)
+
+		// when
+		podsLister := pods.NewPods(c, &logger)
+		expectedImage := predicates.NewSidecarImage("istio", "1.1.0")
+		istioCR := helpers.GetIstioCR(expectedImage.Tag)
+		actionRestarter := restart.NewActionRestarter(c, &logger)
+		proxyRestarter := sidecars.NewProxyRestarter(c, podsLister, actionRestarter, &logger)
+		warnings, _, _, err := proxyRestarter.RestartProxies(ctx, expectedImage, helpers.DefaultSidecarResources, false, "default", &istioCR)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(HaveLen(35))
+		Expect(warnings[0].Reason).To(Equal(restart.ReasonOwnerNotFound))
+	})
 })
 
 var _ = Describe("RestartWithPredicates", func() {
@@ -410,6 +447,34 @@ var _ = Describe("BuildWarningMessage", func() {
 		Expect(warningMessage).To(ContainSubstring("The sidecars of the following workloads could not be restarted: namespace1/pod1, namespace2/pod2, namespace3/pod3, namespace4/pod4, namespace5/pod5 and 1 additional workload(s)"))
 	})
 
+	It("should report workloads owned by CronJobs separately", func() {
+		// given
+		warnings := []restart.Warning{
+			{Name: "pod1", Namespace: "namespace1", Kind: "Pod", Message: "failed to restart"},
+			{Name: "cronjob1", Namespace: "namespace2", Kind: restart.CronJobKind, Message: "owned by a CronJob"},
+		}
+
+		// when
+		warningMessage := sidecars.BuildWarningMessage(warnings, &logger)
+
+		// then
+		Expect(warningMessage).To(Equal("The sidecars of the following workloads could not be restarted: namespace1/pod1. " +
+			"The sidecars of Jobs created by the following CronJobs are updated with the next scheduled Job: namespace2/cronjob1"))
+	})
+
+	It("should only report CronJobs when there are no other workloads", func() {
+		// given
+		warnings := []restart.Warning{
+			{Name: "cronjob1", Namespace: "namespace1", Kind: restart.CronJobKind, Message: "owned by a CronJob"},
+		}
+
+		// when
+		warningMessage := sidecars.BuildWarningMessage(warnings, &logger)
+
+		// then
+		Expect(warningMessage).To(Equal("The sidecars of Jobs created by the following CronJobs are updated with the next scheduled Job: namespace1/cronjob1"))
+	})
+
 	It("should log each warning message", func() {
 		// given
 		warnings := []restart.Warning{
@@ -568,6 +633,10 @@ func NewActionRestartMock(warnings []restart.Warning, err error) *ActionRestartM
 	}
 }
 
-func (p *ActionRestartMock) Restart(ctx context.Context, podList *v1.PodList, failOnError bool) ([]restart.Warning, error) {
-	return p.warnings, p.err
+func (p *ActionRestartMock) Restart(ctx context.Context, podList *v1.PodList, failOnError bool) ([]restart.Warning, int, error) {
+	return p.warnings, 0, p.err
+}
+
+func (p *ActionRestartMock) RestartWithLimit(_ context.Context, _ *v1.PodList, _ int, _ bool) ([]restart.Warning, int, bool, error) {
+	return p.warnings, 0, false, p.err
 }
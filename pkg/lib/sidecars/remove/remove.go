@@ -17,5 +17,6 @@ func Sidecars(ctx context.Context, k8sclient client.Client, logger *logr.Logger)
 		return nil, err
 	}
 	actionRestarter := restart.NewActionRestarter(k8sclient, logger)
-	return actionRestarter.Restart(ctx, toRestart, false)
+	warnings, _, err := actionRestarter.Restart(ctx, toRestart, false)
+	return warnings, err
 }
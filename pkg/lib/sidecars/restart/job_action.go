@@ -0,0 +1,43 @@
+package restart
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/retry"
+)
+
+const CronJobKind = "CronJob"
+
+// getJobAction returns a warning action for the pod owned by a Job. If the Job was created by a CronJob, the warning is reported
+// for the CronJob, because the sidecar is updated with the next scheduled Job.
+func getJobAction(ctx context.Context, c client.Client, pod v1.Pod, jobRef *metav1.OwnerReference) restartAction {
+	jobKey := client.ObjectKey{
+		Name:      jobRef.Name,
+		Namespace: pod.Namespace,
+	}
+
+	var job = &batchv1.Job{}
+	err := retry.OnError(retry.DefaultRetry, func() error {
+		return c.Get(ctx, jobKey, job)
+	})
+	if err != nil {
+		return newOwnedByJobAction(pod)
+	}
+
+	for _, ownerRef := range job.OwnerReferences {
+		if ownerRef.Kind == CronJobKind {
+			return newOwnedByCronJobAction(actionObject{
+				Name:      ownerRef.Name,
+				Namespace: pod.Namespace,
+				Kind:      CronJobKind,
+			})
+		}
+	}
+
+	return newOwnedByJobAction(pod)
+}
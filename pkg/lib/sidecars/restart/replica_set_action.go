@@ -66,7 +66,8 @@ func getReplicaSetAction(ctx context.Context, c client.Client, pod v1.Pod, repli
 					Namespace: replicaSet.Namespace,
 					Kind:      rsOwnedBy.Kind,
 				},
-				run: logAction{message: notReadyReplicaSetExistsMessage}.run,
+				run:           warningAction{reason: ReasonNotReadyReplicaSetExists, message: notReadyReplicaSetExistsMessage}.run,
+				manualRestart: true,
 			}, nil
 		}
 	}
@@ -3,6 +3,7 @@ package restart
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/go-logr/logr"
 
@@ -11,14 +12,26 @@ import (
 )
 
 const (
-	ownerReferenceNotFoundMessage   = "pod sidecar could not be updated because OwnerReferences was not found."
-	ownedByJobMessage               = "pod sidecar could not be updated because it is owned by a Job."
-	notReadyReplicaSetExistsMessage = "was not restarted because there exists another " +
-		"not ready ReplicaSet for the same object"
+	ownerReferenceNotFoundMessage = "pod sidecar could not be updated because OwnerReferences was not found."
+	ownedByJobMessage             = "pod sidecar could not be updated because it is owned by a Job."
+	ownedByCronJobMessage         = "pod sidecar could not be updated because it is owned by a Job created by a CronJob. " +
+		"The sidecar is updated with the next scheduled Job."
+	notReadyReplicaSetExistsMessage = "pod sidecar was not restarted because there exists another " +
+		"not ready ReplicaSet for the same object."
+)
+
+// Reasons why the sidecar of a workload could not be restarted.
+const (
+	ReasonOwnerNotFound            = "OwnerNotFound"
+	ReasonOwnedByJob               = "OwnedByJob"
+	ReasonOwnedByCronJob           = "OwnedByCronJob"
+	ReasonNotReadyReplicaSetExists = "NotReadyReplicaSetExists"
+	ReasonRestartFailed            = "RestartFailed"
 )
 
 type ActionRestarter interface {
-	Restart(ctx context.Context, podList *v1.PodList, failOnError bool) ([]Warning, error)
+	Restart(ctx context.Context, podList *v1.PodList, failOnError bool) ([]Warning, int, error)
+	RestartWithLimit(ctx context.Context, podList *v1.PodList, restartLimit int, failOnError bool) ([]Warning, int, bool, error)
 }
 
 type actionRestarter struct {
@@ -34,46 +47,67 @@ func NewActionRestarter(c client.Client, logger *logr.Logger) ActionRestarter {
 }
 
 type Warning struct {
-	Name, Namespace, Kind, Message string
+	Name, Namespace, Kind, Reason, Message string
 }
 
-func newRestartWarning(o actionObject, message string) Warning {
+func newRestartWarning(o actionObject, reason, message string) Warning {
 	return Warning{
 		Name:      o.Name,
 		Namespace: o.Namespace,
 		Kind:      o.Kind,
+		Reason:    reason,
 		Message:   message,
 	}
 }
 
-// Restarts pods in the given list through their respective owners by adding an annotation. If failOnError is set to true, the function will return an error if any of the restart actions fail.
-func (s *actionRestarter) Restart(ctx context.Context, podList *v1.PodList, failOnError bool) ([]Warning, error) {
+// Restarts pods in the given list through their respective owners by adding an annotation and returns the number of restarted pods.
+// If failOnError is set to true, the function will return an error if any of the restart actions fail.
+func (s *actionRestarter) Restart(ctx context.Context, podList *v1.PodList, failOnError bool) ([]Warning, int, error) {
+	warnings, restartedPods, _, err := s.RestartWithLimit(ctx, podList, math.MaxInt, failOnError)
+	return warnings, restartedPods, err
+}
+
+// RestartWithLimit restarts pods in the given list like Restart, but stops restarting workloads once restartLimit pods are restarted.
+// The warnings for workloads that must be restarted manually are still returned for every pod in the list. The returned bool is true
+// if pods were left to be restarted because of the limit.
+func (s *actionRestarter) RestartWithLimit(ctx context.Context, podList *v1.PodList, restartLimit int, failOnError bool) ([]Warning, int, bool, error) {
 	warnings := make([]Warning, 0)
-	processedActionObjects := make(map[string]bool)
+	// restartedActionObjects tracks for each processed action object whether its pods were restarted.
+	restartedActionObjects := make(map[string]bool)
+	restartedPods := 0
+	hasMorePods := false
 
 	for _, pod := range podList.Items {
 		action, err := restartActionFactory(ctx, s.k8sClient, pod)
 		if err != nil {
 			s.logger.Error(err, "pod", action.object.getKey(), "Creating pod restart action failed")
 			if failOnError {
-				return warnings, fmt.Errorf("creating pod restart action failed: %w", err)
+				return warnings, restartedPods, hasMorePods, fmt.Errorf("creating pod restart action failed: %w", err)
 			}
 			continue
 		}
 
 		// We want to avoid performing the same action multiple times for a parent if it contains multiple pods that need to be restarted.
-		if _, exists := processedActionObjects[action.object.getKey()]; !exists {
+		if _, exists := restartedActionObjects[action.object.getKey()]; !exists {
+			if !action.manualRestart && restartedPods >= restartLimit {
+				restartedActionObjects[action.object.getKey()] = false
+				hasMorePods = true
+				continue
+			}
 			currentWarnings, actionErr := action.run(ctx, s.k8sClient, action.object, s.logger)
 			if actionErr != nil {
 				s.logger.Error(actionErr, "pod", action.object.getKey(), "Running pod restart action failed")
 				if failOnError {
-					return warnings, fmt.Errorf("running pod restart action failed: %w", actionErr)
+					return warnings, restartedPods, hasMorePods, fmt.Errorf("running pod restart action failed: %w", actionErr)
 				}
 			}
 			warnings = append(warnings, currentWarnings...)
-			processedActionObjects[action.object.getKey()] = true
+			restartedActionObjects[action.object.getKey()] = actionErr == nil && !action.manualRestart
+		}
+		if restartedActionObjects[action.object.getKey()] {
+			restartedPods++
 		}
 	}
 
-	return warnings, nil
+	return warnings, restartedPods, hasMorePods, nil
 }
@@ -19,7 +19,7 @@ func restartActionFactory(ctx context.Context, c client.Client, pod v1.Pod) (res
 
 	switch ownedBy.Kind {
 	case "Job":
-		return newOwnedByJobAction(pod), nil
+		return getJobAction(ctx, c, pod, ownedBy), nil
 	case "ReplicaSet":
 		return getReplicaSetAction(ctx, c, pod, ownedBy)
 	case "ReplicationController":
@@ -45,6 +45,8 @@ func getOwnerReferences(pod v1.Pod) (*metav1.OwnerReference, bool) {
 type restartAction struct {
 	run    func(context.Context, client.Client, actionObject, *logr.Logger) ([]Warning, error)
 	object actionObject
+	// manualRestart is set for actions that only report a warning, because the workload must be restarted manually.
+	manualRestart bool
 }
 
 type actionObject struct {
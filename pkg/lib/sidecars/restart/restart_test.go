@@ -3,7 +3,6 @@ package restart_test
 import (
 	"context"
 	"errors"
-	"fmt"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -17,6 +16,7 @@ import (
 
 	"github.com/kyma-project/istio/operator/pkg/lib/sidecars/restart"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,33 +34,6 @@ var _ = ReportAfterSuite("custom reporter", func(report ginkgotypes.Report) {
 	tests.GenerateGinkgoJunitReport("pods-restart-suite", report)
 })
 
-type mockLogSink struct {
-	infos chan<- string
-	errs  chan<- error
-}
-
-func (m mockLogSink) Init(_ logr.RuntimeInfo) {}
-
-func (m mockLogSink) Enabled(_ int) bool {
-	return true
-}
-
-func (m mockLogSink) Info(_ int, msg string, keysAndValues ...any) {
-	m.infos <- fmt.Sprintf("%s %v", msg, keysAndValues)
-}
-
-func (m mockLogSink) Error(err error, msg string, keysAndValues ...any) {
-	m.errs <- fmt.Errorf("%w %s %v", err, msg, keysAndValues)
-}
-
-func (m mockLogSink) WithValues(_ ...any) logr.LogSink {
-	return m
-}
-
-func (m mockLogSink) WithName(_ string) logr.LogSink {
-	return m
-}
-
 var _ = Describe("Restart Pods", func() {
 	ctx := context.Background()
 	logger := logr.Discard()
@@ -77,11 +50,12 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, restartedPods, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
 		Expect(warnings).NotTo(BeEmpty())
+		Expect(restartedPods).To(BeZero())
 
 		Expect(warnings[0].Name).To(Equal("p1"))
 		Expect(warnings[0].Message).To(ContainSubstring("OwnerReferences was not found"))
@@ -99,7 +73,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -109,6 +83,39 @@ var _ = Describe("Restart Pods", func() {
 		Expect(warnings[0].Message).To(ContainSubstring("owned by a Job"))
 	})
 
+	It("should return warning for the CronJob when pod is owned by a Job created by a CronJob", func() {
+		// given
+		c := fakeClient(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "owningJob",
+				Namespace: "test-ns",
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "CronJob", Name: "owningCronJob"},
+				},
+			},
+		})
+
+		podList := v1.PodList{
+			Items: []v1.Pod{
+				podFixture("p1", "test-ns", "Job", "owningJob"),
+				podFixture("p2", "test-ns", "Job", "owningJob"),
+			},
+		}
+
+		// when
+		actionRestarter := restart.NewActionRestarter(c, &logger)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(HaveLen(1))
+
+		Expect(warnings[0].Name).To(Equal("owningCronJob"))
+		Expect(warnings[0].Namespace).To(Equal("test-ns"))
+		Expect(warnings[0].Kind).To(Equal(restart.CronJobKind))
+		Expect(warnings[0].Message).To(ContainSubstring("created by a CronJob"))
+	})
+
 	It("should rollout restart Deployment if the pod is owned by one", func() {
 		// given
 		c := fakeClient(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "test-ns"}})
@@ -121,7 +128,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -147,11 +154,12 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, restartedPods, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
 		Expect(warnings).To(BeEmpty())
+		Expect(restartedPods).To(Equal(2))
 
 		obj := appsv1.Deployment{}
 		err = c.Get(context.Background(), types.NamespacedName{Namespace: "test-ns", Name: "owner"}, &obj)
@@ -172,7 +180,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -196,7 +204,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -220,7 +228,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -247,7 +255,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -274,7 +282,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -310,7 +318,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -336,7 +344,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -394,31 +402,22 @@ var _ = Describe("Restart Pods", func() {
 				UID:       "1234",
 			}})
 
-		infosChannel := make(chan string)
-		sink := mockLogSink{
-			infos: infosChannel,
-			errs:  make(chan error),
-		}
-
-		l := logr.New(sink)
-
 		// when
-		go func() {
-			actionRestarter := restart.NewActionRestarter(c, &l)
-			warnings, err := actionRestarter.Restart(ctx, &podList, false)
-
-			// then
-			Expect(err).NotTo(HaveOccurred())
-			Expect(warnings).To(BeEmpty())
-			close(sink.infos)
-		}()
+		actionRestarter := restart.NewActionRestarter(c, &logger)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
-		Eventually(infosChannel).Should(Receive(Equal("was not restarted because there exists another not ready " +
-			"ReplicaSet for the same object [kind Deployment name rsOwner namespace test-ns]")))
-		Eventually(infosChannel).Should(BeClosed())
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(restart.Warning{
+			Name:      "rsOwner",
+			Namespace: "test-ns",
+			Kind:      "Deployment",
+			Reason:    restart.ReasonNotReadyReplicaSetExists,
+			Message:   "pod sidecar was not restarted because there exists another not ready ReplicaSet for the same object.",
+		}))
 
 		deployment := appsv1.Deployment{}
-		err := c.Get(context.Background(), types.NamespacedName{Name: "rsOwner", Namespace: "test-ns"}, &deployment)
+		err = c.Get(context.Background(), types.NamespacedName{Name: "rsOwner", Namespace: "test-ns"}, &deployment)
 
 		Expect(err).NotTo(HaveOccurred())
 		Expect(deployment.Spec.Template.Annotations[restartAnnotationName]).To(BeEmpty())
@@ -447,7 +446,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(c, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, false)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, false)
 
 		// then
 		Expect(err).NotTo(HaveOccurred())
@@ -475,7 +474,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(failClient, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, true)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, true)
 
 		// then
 		Expect(err).To(HaveOccurred())
@@ -514,7 +513,7 @@ var _ = Describe("Restart Pods", func() {
 
 		// when
 		actionRestarter := restart.NewActionRestarter(failClient, &logger)
-		warnings, err := actionRestarter.Restart(ctx, &podList, true)
+		warnings, _, err := actionRestarter.Restart(ctx, &podList, true)
 
 		// then
 		Expect(err).To(HaveOccurred())
@@ -529,6 +528,83 @@ var _ = Describe("Restart Pods", func() {
 	})
 })
 
+var _ = Describe("Restart Pods With Limit", func() {
+	ctx := context.Background()
+	logger := logr.Discard()
+
+	It("should return the warnings for all pods and stop restarting workloads at the limit", func() {
+		// given
+		c := fakeClient(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "test-ns"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "second", Namespace: "test-ns"}},
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "owningJob",
+					Namespace: "test-ns",
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "CronJob", Name: "owningCronJob"},
+					},
+				},
+			},
+		)
+
+		podList := v1.PodList{
+			Items: []v1.Pod{
+				podFixture("p1", "test-ns", "Deployment", "first"),
+				podFixture("p2", "test-ns", "Deployment", "first"),
+				podFixture("p3", "test-ns", "Deployment", "second"),
+				podWithoutOwnerFixture("p4", "test-ns"),
+				podFixture("p5", "test-ns", "Job", "owningJob"),
+			},
+		}
+
+		// when
+		actionRestarter := restart.NewActionRestarter(c, &logger)
+		warnings, restartedPods, hasMorePods, err := actionRestarter.RestartWithLimit(ctx, &podList, 2, false)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedPods).To(Equal(2))
+		Expect(hasMorePods).To(BeTrue())
+		Expect(warnings).To(HaveLen(2))
+		Expect(warnings[0].Name).To(Equal("p4"))
+		Expect(warnings[0].Reason).To(Equal(restart.ReasonOwnerNotFound))
+		Expect(warnings[1].Name).To(Equal("owningCronJob"))
+		Expect(warnings[1].Reason).To(Equal(restart.ReasonOwnedByCronJob))
+
+		first := appsv1.Deployment{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: "test-ns", Name: "first"}, &first)).To(Succeed())
+		Expect(first.Spec.Template.Annotations).To(HaveKey(restartAnnotationName))
+
+		second := appsv1.Deployment{}
+		Expect(c.Get(ctx, types.NamespacedName{Namespace: "test-ns", Name: "second"}, &second)).To(Succeed())
+		Expect(second.Spec.Template.Annotations).NotTo(HaveKey(restartAnnotationName))
+	})
+
+	It("should not report more pods when all workloads are restarted within the limit", func() {
+		// given
+		c := fakeClient(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "test-ns"}})
+
+		podList := v1.PodList{
+			Items: []v1.Pod{
+				podFixture("p1", "test-ns", "Deployment", "owner"),
+				podFixture("p2", "test-ns", "Job", "owningJob"),
+			},
+		}
+
+		// when
+		actionRestarter := restart.NewActionRestarter(c, &logger)
+		warnings, restartedPods, hasMorePods, err := actionRestarter.RestartWithLimit(ctx, &podList, 1, false)
+
+		// then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedPods).To(Equal(1))
+		Expect(hasMorePods).To(BeFalse())
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0].Reason).To(Equal(restart.ReasonOwnedByJob))
+	})
+})
+
 func fakeClient(objects ...client.Object) client.Client {
 	err := v1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
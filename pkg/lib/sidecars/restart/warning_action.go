@@ -10,23 +10,34 @@ import (
 )
 
 type warningAction struct {
+	reason  string
 	message string
 }
 
 func (r warningAction) run(_ context.Context, _ client.Client, object actionObject, _ *logr.Logger) ([]Warning, error) {
-	return []Warning{newRestartWarning(object, r.message)}, nil
+	return []Warning{newRestartWarning(object, r.reason, r.message)}, nil
 }
 
 func newOwnerNotFoundAction(pod v1.Pod) restartAction {
 	return restartAction{
-		object: actionObjectFromPod(pod),
-		run:    warningAction{message: ownerReferenceNotFoundMessage}.run,
+		object:        actionObjectFromPod(pod),
+		run:           warningAction{reason: ReasonOwnerNotFound, message: ownerReferenceNotFoundMessage}.run,
+		manualRestart: true,
 	}
 }
 
 func newOwnedByJobAction(pod v1.Pod) restartAction {
 	return restartAction{
-		object: actionObjectFromPod(pod),
-		run:    warningAction{message: ownedByJobMessage}.run,
+		object:        actionObjectFromPod(pod),
+		run:           warningAction{reason: ReasonOwnedByJob, message: ownedByJobMessage}.run,
+		manualRestart: true,
+	}
+}
+
+func newOwnedByCronJobAction(cronJob actionObject) restartAction {
+	return restartAction{
+		object:        cronJob,
+		run:           warningAction{reason: ReasonOwnedByCronJob, message: ownedByCronJobMessage}.run,
+		manualRestart: true,
 	}
 }
@@ -25,10 +25,12 @@ func (s *scenario) aRestartHappens(sidecarImage string) error {
 	actionRestarter := restart.NewActionRestarter(s.Client, &s.logger)
 	pr := sidecars.NewProxyRestarter(s.Client, podsLister, actionRestarter, &s.logger)
 	istioCR := helpers.GetIstioCR(sidecarImage)
-	warnings, hasMorePods, err := pr.RestartProxies(
+	warnings, _, hasMorePods, err := pr.RestartProxies(
 		context.Background(),
 		predicates.SidecarImage{Repository: "istio/proxyv2", Tag: sidecarImage},
 		helpers.DefaultSidecarResources,
+		false,
+		"default",
 		&istioCR)
 	s.restartWarnings = warnings
 	s.hasMorePodsToRestart = hasMorePods
@@ -51,10 +53,12 @@ func (s *scenario) aRestartHappensWithUpdatedResources(sidecarImage string, reso
 	podsLister := pods.NewPods(s.Client, &s.logger)
 	actionRestarter := restart.NewActionRestarter(s.Client, &s.logger)
 	pr := sidecars.NewProxyRestarter(s.Client, podsLister, actionRestarter, &s.logger)
-	warnings, hasMorePods, err := pr.RestartProxies(
+	warnings, _, hasMorePods, err := pr.RestartProxies(
 		context.Background(),
 		predicates.SidecarImage{Repository: "istio/proxyv2", Tag: sidecarImage},
 		resources,
+		false,
+		"default",
 		&istioCR)
 	s.restartWarnings = warnings
 	s.hasMorePodsToRestart = hasMorePods
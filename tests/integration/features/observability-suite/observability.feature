@@ -10,8 +10,8 @@ Feature: Observability
     And Istio injection is "enabled" in namespace "default"
     And Istio CR "istio-sample" in namespace "kyma-system" has status "Ready"
 
-  Scenario: Logs from stdout-json envoyFileAccessLog provider are in correct format
-    Given Access logging is enabled for the mesh using "stdout-json" provider
+  Scenario: Logs from kyma-default-logger envoyFileAccessLog provider are in correct format
+    Given Access logging is enabled for the mesh using "kyma-default-logger" provider
     And Istio gateway "test-gateway" is configured in namespace "default"
     And Httpbin application "httpbin" deployment is created in namespace "default"
     And Virtual service "httpbin" exposing service "httpbin.default.svc.cluster.local" with port "8000" by gateway "default/test-gateway" is configured in namespace "default"